@@ -0,0 +1,113 @@
+package testutil
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+)
+
+const (
+	// DevImage is the Docker image of the Vault dev server the acceptance
+	// tests run against by default.
+	DevImage = "hashicorp/vault:latest"
+
+	// EnterpriseImage is the Docker image of the Vault Enterprise dev server.
+	// It requires a license in the VAULT_LICENSE environment variable.
+	EnterpriseImage = "hashicorp/vault-enterprise:latest"
+
+	// RootToken is the root token of the dev servers started by StartVault.
+	RootToken = "TEST"
+
+	vaultStartTimeout = 30 * time.Second
+)
+
+// VaultContainer is a Vault dev server running in Docker.
+type VaultContainer struct {
+	ID      string
+	Address string
+	Token   string
+}
+
+// StartVault starts a Vault dev server from image in Docker, with the root
+// token RootToken, and waits for it to be unsealed. The license in
+// VAULT_LICENSE, if any, is passed on to the server to run the enterprise
+// images.
+func StartVault(image string) (*VaultContainer, error) {
+	args := []string{
+		"run", "--detach", "--rm",
+		"--cap-add", "IPC_LOCK",
+		"--publish", "127.0.0.1::8200",
+		"--env", "VAULT_DEV_ROOT_TOKEN_ID=" + RootToken,
+		"--env", "VAULT_DEV_LISTEN_ADDRESS=0.0.0.0:8200",
+	}
+	if os.Getenv("VAULT_LICENSE") != "" {
+		args = append(args, "--env", "VAULT_LICENSE")
+	}
+	args = append(args, image)
+
+	log.Printf("[DEBUG] Starting Vault from %s", image)
+	id, err := docker(args...)
+	if err != nil {
+		return nil, fmt.Errorf("error starting Vault from %s: %s", image, err)
+	}
+	c := &VaultContainer{ID: id, Token: RootToken}
+
+	port, err := docker("port", id, "8200/tcp")
+	if err != nil {
+		c.Stop()
+		return nil, fmt.Errorf("error reading the port of Vault: %s", err)
+	}
+	// docker port lists one address per line, IPv4 first.
+	c.Address = "http://" + strings.Split(port, "\n")[0]
+
+	if err := c.wait(); err != nil {
+		c.Stop()
+		return nil, err
+	}
+	log.Printf("[DEBUG] Started Vault %s at %s", id, c.Address)
+	return c, nil
+}
+
+// Stop stops and removes the container.
+func (c *VaultContainer) Stop() error {
+	log.Printf("[DEBUG] Stopping Vault %s", c.ID)
+	if _, err := docker("stop", c.ID); err != nil {
+		return fmt.Errorf("error stopping Vault %s: %s", c.ID, err)
+	}
+	return nil
+}
+
+// wait polls sys/health until Vault is unsealed.
+func (c *VaultContainer) wait() error {
+	config := api.DefaultConfig()
+	config.Address = c.Address
+	client, err := api.NewClient(config)
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(vaultStartTimeout)
+	for {
+		health, err := client.Sys().Health()
+		if err == nil && health.Initialized && !health.Sealed {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("Vault at %s wasn't ready after %s: %v", c.Address, vaultStartTimeout, err)
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+}
+
+func docker(args ...string) (string, error) {
+	out, err := exec.Command("docker", args...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("%s: %s", err, strings.TrimSpace(string(out)))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
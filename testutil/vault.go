@@ -0,0 +1,72 @@
+package testutil
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/vault/api"
+)
+
+// testNameRegex matches the names the acceptance tests give to the mounts,
+// policies and identity objects they create: those with a tf-test or test
+// prefix, and those generated by acctest.RandomWithPrefix.
+var testNameRegex = regexp.MustCompile(`^(tf-test|test)-|-\d{6,}$`)
+
+// IsTestName returns whether name, e.g. the path of a mount, was created by
+// the acceptance tests and can be swept.
+func IsTestName(name string) bool {
+	return testNameRegex.MatchString(strings.Trim(name, "/"))
+}
+
+// NewClient returns a client of the Vault in VAULT_ADDR, with the token in
+// VAULT_TOKEN.
+func NewClient() (*api.Client, error) {
+	config := api.DefaultConfig()
+	if config.Error != nil {
+		return nil, config.Error
+	}
+	client, err := api.NewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the Vault client: %s", err)
+	}
+	if client.Token() == "" {
+		return nil, fmt.Errorf("VAULT_TOKEN must be set")
+	}
+	return client, nil
+}
+
+// TestMountSecretBackend mounts a secret backend of mountType at a random
+// path and returns the path.
+func TestMountSecretBackend(t *testing.T, client *api.Client, mountType string) string {
+	path := acctest.RandomWithPrefix("tf-test-" + mountType)
+	if err := client.Sys().Mount(path, &api.MountInput{Type: mountType}); err != nil {
+		t.Fatalf("error mounting %s backend at %q: %s", mountType, path, err)
+	}
+	return path
+}
+
+// TestEnableAuthBackend enables an auth backend of authType at a random path
+// and returns the path.
+func TestEnableAuthBackend(t *testing.T, client *api.Client, authType string) string {
+	path := acctest.RandomWithPrefix("tf-test-" + authType)
+	if err := client.Sys().EnableAuth(path, authType, ""); err != nil {
+		t.Fatalf("error enabling %s auth backend at %q: %s", authType, path, err)
+	}
+	return path
+}
+
+// TestCreateToken creates a child token of the client token, valid for an
+// hour, with policies and returns it.
+func TestCreateToken(t *testing.T, client *api.Client, policies ...string) string {
+	secret, err := client.Auth().Token().Create(&api.TokenCreateRequest{
+		Policies: policies,
+		TTL:      "1h",
+	})
+	if err != nil {
+		t.Fatalf("error creating token with policies %v: %s", policies, err)
+	}
+	return secret.Auth.ClientToken
+}
@@ -0,0 +1,26 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+)
+
+func TestIsTestName(t *testing.T) {
+	cases := map[string]bool{
+		"tf-test-aws/":                  true,
+		"test-role":                     true,
+		acctest.RandomWithPrefix("aws"): true,
+		acctest.RandomWithPrefix("kv"):  true,
+		"secret/":                       false,
+		"token/":                        false,
+		"default":                       false,
+		"aws-prod":                      false,
+		"contest-role":                  false,
+	}
+	for name, expected := range cases {
+		if actual := IsTestName(name); actual != expected {
+			t.Errorf("expected IsTestName(%q) to be %t, got %t", name, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// tokenCapabilities returns the capabilities of the token of client on each
+// of paths, as reported by sys/capabilities-self.
+func tokenCapabilities(client *api.Client, paths []string) (map[string][]string, error) {
+	log.Printf("[DEBUG] Reading the token capabilities on %d paths", len(paths))
+	secret, err := client.Logical().Write("sys/capabilities-self", map[string]interface{}{
+		"paths": paths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading the token capabilities: %s", err)
+	}
+	log.Printf("[DEBUG] Read the token capabilities on %d paths", len(paths))
+	if secret == nil {
+		return nil, fmt.Errorf("no token capabilities returned by Vault")
+	}
+
+	capabilities := map[string][]string{}
+	for _, path := range paths {
+		v, ok := secret.Data[path].([]interface{})
+		if !ok {
+			// Vault returns the capabilities of a single path in the
+			// capabilities field.
+			v, _ = secret.Data["capabilities"].([]interface{})
+		}
+		caps := jsonStringArrayToStringArray(v)
+		sort.Strings(caps)
+		capabilities[path] = caps
+	}
+	return capabilities, nil
+}
+
+// checkTokenCapabilities returns an error listing each path of required on
+// which the token of client lacks some of the required capabilities.
+func checkTokenCapabilities(client *api.Client, required map[string][]string) error {
+	paths := make([]string, 0, len(required))
+	for path := range required {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	capabilities, err := tokenCapabilities(client, paths)
+	if err != nil {
+		return err
+	}
+
+	var report []string
+	for _, path := range paths {
+		has := map[string]bool{}
+		for _, c := range capabilities[path] {
+			has[c] = true
+		}
+		if has["root"] {
+			continue
+		}
+
+		var missing []string
+		for _, c := range required[path] {
+			if !has[c] || has["deny"] {
+				missing = append(missing, c)
+			}
+		}
+		if len(missing) > 0 {
+			report = append(report, fmt.Sprintf("  %s: missing %s, has %s", path, strings.Join(missing, ", "), strings.Join(capabilities[path], ", ")))
+		}
+	}
+	if len(report) > 0 {
+		return fmt.Errorf("the Vault token lacks the required capabilities:\n%s", strings.Join(report, "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+)
+
+func TestCheckTokenCapabilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/sys/capabilities-self" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {
+			"secret/data/app": ["read", "update"],
+			"secret/data/admin": ["deny"],
+			"sys/mounts/kv": ["root"]
+		}}`))
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		required map[string][]string
+		missing  []string
+	}{
+		{
+			required: map[string][]string{
+				"secret/data/app": {"read"},
+				"sys/mounts/kv":   {"create", "sudo"},
+			},
+		},
+		{
+			required: map[string][]string{
+				"secret/data/app":   {"read", "delete"},
+				"secret/data/admin": {"read"},
+			},
+			missing: []string{
+				"secret/data/admin: missing read, has deny",
+				"secret/data/app: missing delete, has read, update",
+			},
+		},
+	} {
+		err := checkTokenCapabilities(client, tc.required)
+		if len(tc.missing) == 0 {
+			if err != nil {
+				t.Errorf("expected no error for %v, got %s", tc.required, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("expected an error for %v", tc.required)
+			continue
+		}
+		for _, m := range tc.missing {
+			if !strings.Contains(err.Error(), m) {
+				t.Errorf("expected %q in the error, got %s", m, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package vault
+
+import (
+	"net/http"
+	"sync"
+)
+
+const vaultIndexHeaderName = "X-Vault-Index"
+
+// consistencyTransport sends the replication state of the last response
+// with every request, so that a performance standby or secondary only
+// answers once it has caught up with the writes made by Terraform. It
+// answers 412 until then, which the wrapped transport retries.
+type consistencyTransport struct {
+	transport http.RoundTripper
+
+	l     sync.Mutex
+	state string
+}
+
+func (t *consistencyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.l.Lock()
+	state := t.state
+	t.l.Unlock()
+
+	// Vault only returns its state when the header is sent, even if empty
+	r := req.WithContext(req.Context())
+	r.Header = make(http.Header, len(req.Header)+1)
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+	r.Header[vaultIndexHeaderName] = []string{state}
+
+	resp, err := t.transport.RoundTrip(r)
+	if err != nil {
+		return resp, err
+	}
+
+	if v := resp.Header.Get(vaultIndexHeaderName); v != "" {
+		t.l.Lock()
+		t.state = v
+		t.l.Unlock()
+	}
+
+	return resp, nil
+}
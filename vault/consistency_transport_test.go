@@ -0,0 +1,70 @@
+package vault
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestConsistencyTransport(t *testing.T) {
+	reads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		index, ok := r.Header[vaultIndexHeaderName]
+		if !ok {
+			t.Errorf("no %s header sent to %s %s", vaultIndexHeaderName, r.Method, r.URL.Path)
+		}
+
+		switch r.Method {
+		case "PUT":
+			w.Header().Set(vaultIndexHeaderName, "state-1")
+		case "GET":
+			if len(index) != 1 || index[0] != "state-1" {
+				t.Errorf("bad %s header: %q", vaultIndexHeaderName, index)
+			}
+			// the standby hasn't caught up on the first read
+			reads++
+			if reads == 1 {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &consistencyTransport{
+			transport: &retryTransport{
+				transport:   http.DefaultTransport,
+				statusCodes: map[int]bool{http.StatusPreconditionFailed: true},
+				maxRetries:  2,
+				waitMin:     time.Millisecond,
+				waitMax:     2 * time.Millisecond,
+			},
+		},
+	}
+
+	req, err := http.NewRequest("PUT", server.URL+"/v1/secret/foo", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	resp, err = client.Get(server.URL + "/v1/secret/foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("bad status: want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if reads != 2 {
+		t.Errorf("bad number of reads: want %d, got %d", 2, reads)
+	}
+}
@@ -46,20 +46,34 @@ func awsAccessCredentialsDataSource() *schema.Resource {
 					return
 				},
 			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ARN of the AWS role to assume, required if the Vault role allows more than one.",
+			},
+			"ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "User-specified TTL of the STS credentials, e.g. \"1h\". Only used if type is 'sts'.",
+				ValidateFunc: validateDuration,
+			},
 			"access_key": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "AWS access key ID read from Vault.",
 			},
 
 			"secret_key": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "AWS secret key read from Vault.",
 			},
 
 			"security_token": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "AWS security token read from Vault. (Only returned if type is 'sts'.)",
 			},
@@ -99,8 +113,16 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 	role := d.Get("role").(string)
 	path := backend + "/" + credType + "/" + role
 
+	data := map[string][]string{}
+	if v, ok := d.GetOk("role_arn"); ok {
+		data["role_arn"] = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = []string{v.(string)}
+	}
+
 	log.Printf("[DEBUG] Reading %q from Vault", path)
-	secret, err := client.Logical().Read(path)
+	secret, err := client.Logical().ReadWithData(path, data)
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
@@ -125,6 +147,7 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 	d.Set("lease_duration", secret.LeaseDuration)
 	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
 	d.Set("lease_renewable", secret.Renewable)
+	renewLease(client, secret)
 
 	awsConfig := &aws.Config{
 		Credentials: credentials.NewStaticCredentials(accessKey, secretKey, securityToken),
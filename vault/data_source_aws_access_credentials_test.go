@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"os"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -60,6 +61,31 @@ func TestAccDataSourceAWSAccessCredentials_sts(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAWSAccessCredentials_assumedRole(t *testing.T) {
+	mountPath := acctest.RandomWithPrefix("aws")
+	accessKey, secretKey := getTestAWSCreds(t)
+	roleARN := os.Getenv("AWS_ROLE_ARN")
+	if roleARN == "" {
+		t.Skip("AWS_ROLE_ARN not set")
+	}
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSAccessCredentialsConfig_assumedRole(mountPath, accessKey, secretKey, roleARN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_aws_access_credentials.test", "access_key"),
+					resource.TestCheckResourceAttrSet("data.vault_aws_access_credentials.test", "secret_key"),
+					resource.TestCheckResourceAttrSet("data.vault_aws_access_credentials.test", "security_token"),
+					resource.TestCheckResourceAttr("data.vault_aws_access_credentials.test", "lease_duration", "900"),
+					testAccDataSourceAWSAccessCredentialsCheck_tokenWorks(mountPath),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceAWSAccessCredentialsConfig_basic(mountPath, accessKey, secretKey string) string {
 	return fmt.Sprintf(`
 resource "vault_aws_secret_backend" "aws" {
@@ -104,6 +130,31 @@ data "vault_aws_access_credentials" "test" {
 }`, mountPath, accessKey, secretKey)
 }
 
+func testAccDataSourceAWSAccessCredentialsConfig_assumedRole(mountPath, accessKey, secretKey, roleARN string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "aws" {
+    path = "%s"
+    description = "Obtain AWS credentials."
+    access_key = "%s"
+    secret_key = "%s"
+}
+
+resource "vault_aws_secret_backend_role" "role" {
+    backend = "${vault_aws_secret_backend.aws.path}"
+    name = "test"
+    credential_type = "assumed_role"
+    role_arns = ["%s"]
+}
+
+data "vault_aws_access_credentials" "test" {
+    backend = "${vault_aws_secret_backend.aws.path}"
+    role = "${vault_aws_secret_backend_role.role.name}"
+    type = "sts"
+    role_arn = "%s"
+    ttl = "15m"
+}`, mountPath, accessKey, secretKey, roleARN, roleARN)
+}
+
 func testAccDataSourceAWSAccessCredentialsCheck_tokenWorks(mountPath string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resourceState := s.Modules[0].Resources["data.vault_aws_access_credentials.test"]
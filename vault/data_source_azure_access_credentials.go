@@ -0,0 +1,205 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// azureLoginEndpoints maps Azure environments to their Azure AD endpoints.
+var azureLoginEndpoints = map[string]string{
+	"AzurePublicCloud":       "https://login.microsoftonline.com",
+	"AzureUSGovernmentCloud": "https://login.microsoftonline.us",
+	"AzureChinaCloud":        "https://login.chinacloudapi.cn",
+	"AzureGermanCloud":       "https://login.microsoftonline.de",
+}
+
+func azureAccessCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: azureAccessCredentialsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Azure Secret Backend to read credentials from.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Azure Secret Role to read credentials from.",
+			},
+			"validate_creds": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to wait until Azure AD accepts the credentials before returning them.",
+			},
+			"num_sequential_successes": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     8,
+				Description: "The number of sequential successful logins required before the credentials are considered valid.",
+			},
+			"num_seconds_between_tests": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "The number of seconds to wait between login attempts.",
+			},
+			"max_cred_validation_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     300,
+				Description: "The maximum number of seconds to wait for the credentials to become valid.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The client ID of the service principal read from Vault.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The client secret of the service principal read from Vault.",
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by vault.",
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds relative to the time in lease_start_time.",
+			},
+			"lease_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease was read, using the clock of the system where Terraform was running",
+			},
+			"lease_renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
+		},
+	}
+}
+
+func azureAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	role := d.Get("role").(string)
+	path := backend + "/creds/" + role
+
+	log.Printf("[DEBUG] Reading %q from Vault", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read %q from Vault", path)
+
+	if secret == nil {
+		return fmt.Errorf("no role found at path %q", path)
+	}
+
+	clientID, _ := secret.Data["client_id"].(string)
+	clientSecret, _ := secret.Data["client_secret"].(string)
+
+	d.SetId(secret.LeaseID)
+	d.Set("client_id", clientID)
+	d.Set("client_secret", clientSecret)
+	d.Set("lease_id", secret.LeaseID)
+	d.Set("lease_duration", secret.LeaseDuration)
+	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
+	d.Set("lease_renewable", secret.Renewable)
+	renewLease(client, secret)
+
+	if !d.Get("validate_creds").(bool) {
+		return nil
+	}
+
+	// the backend configuration tells us which tenant to log in to.
+	configPath := azureSecretBackendConfigPath(backend)
+	log.Printf("[DEBUG] Reading Azure configuration from %q", configPath)
+	config, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading Azure configuration for %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read Azure configuration from %q", configPath)
+	if config == nil {
+		return fmt.Errorf("no Azure configuration found for %q", backend)
+	}
+	tenantID, _ := config.Data["tenant_id"].(string)
+	environment, _ := config.Data["environment"].(string)
+	if environment == "" {
+		environment = "AzurePublicCloud"
+	}
+	loginEndpoint, ok := azureLoginEndpoints[environment]
+	if !ok {
+		return fmt.Errorf("unsupported Azure environment %q", environment)
+	}
+
+	httpClient := cleanhttp.DefaultClient()
+	successes := d.Get("num_sequential_successes").(int)
+	delay := time.Duration(d.Get("num_seconds_between_tests").(int)) * time.Second
+	timeout := time.Duration(d.Get("max_cred_validation_seconds").(int)) * time.Second
+
+	// Azure AD replicates new service principals slowly, a login that
+	// succeeded once may still fail against another replica, so only
+	// sequential successes count.
+	deadline := time.Now().Add(timeout)
+	for count := 0; count < successes; {
+		err := resource.Retry(time.Until(deadline), func() *resource.RetryError {
+			log.Printf("[DEBUG] Checking if Azure creds %q are valid", secret.LeaseID)
+			if err := azureAccessCredentialsCheck(httpClient, loginEndpoint, tenantID, clientID, clientSecret); err != nil {
+				log.Printf("[DEBUG] Azure creds %q are not valid yet: %s", secret.LeaseID, err)
+				count = 0
+				time.Sleep(delay)
+				return resource.RetryableError(err)
+			}
+			log.Printf("[DEBUG] Checked if Azure creds %q are valid", secret.LeaseID)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error checking if credentials are valid: %s", err)
+		}
+		count++
+		if count < successes {
+			time.Sleep(delay)
+		}
+	}
+
+	return nil
+}
+
+// azureAccessCredentialsCheck logs in to Azure AD with the client
+// credentials grant, returning an error unless a token is issued.
+func azureAccessCredentialsCheck(httpClient *http.Client, loginEndpoint, tenantID, clientID, clientSecret string) error {
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+		"scope":         {"https://management.azure.com/.default"},
+	}
+	resp, err := httpClient.PostForm(loginEndpoint+"/"+url.PathEscape(tenantID)+"/oauth2/v2.0/token", form)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("login returned status %d", resp.StatusCode)
+	}
+	return nil
+}
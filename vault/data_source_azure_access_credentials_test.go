@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureAccessCredentials_basic(t *testing.T) {
+	mountPath := acctest.RandomWithPrefix("tf-test-azure")
+	subscriptionID, tenantID, clientID, clientSecret := getTestAzureCreds(t)
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureAccessCredentialsConfig_basic(mountPath, subscriptionID, tenantID, clientID, clientSecret),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_azure_access_credentials.test", "client_id"),
+					resource.TestCheckResourceAttrSet("data.vault_azure_access_credentials.test", "client_secret"),
+					resource.TestCheckResourceAttrSet("data.vault_azure_access_credentials.test", "lease_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureAccessCredentialsConfig_basic(mountPath, subscriptionID, tenantID, clientID, clientSecret string) string {
+	return fmt.Sprintf(`
+resource "vault_azure_secret_backend" "azure" {
+    path = "%s"
+    subscription_id = "%s"
+    tenant_id = "%s"
+    client_id = "%s"
+    client_secret = "%s"
+}
+
+resource "vault_azure_secret_backend_role" "role" {
+    backend = "${vault_azure_secret_backend.azure.path}"
+    name = "test"
+
+    azure_roles {
+        role_name = "Reader"
+        scope = "/subscriptions/%s"
+    }
+}
+
+data "vault_azure_access_credentials" "test" {
+    backend = "${vault_azure_secret_backend.azure.path}"
+    role = "${vault_azure_secret_backend_role.role.name}"
+    validate_creds = true
+    num_sequential_successes = 3
+}`, mountPath, subscriptionID, tenantID, clientID, clientSecret, subscriptionID)
+}
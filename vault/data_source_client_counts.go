@@ -0,0 +1,164 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const clientCountsPath = "sys/internal/counters/activity"
+
+func clientCountsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: clientCountsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"start_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Start of the billing period, as an RFC3339 timestamp. Defaults to the start of the current billing period.",
+			},
+			"end_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "End of the billing period, as an RFC3339 timestamp. Defaults to the end of the last month.",
+			},
+			"clients": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of clients in the billing period.",
+			},
+			"entity_clients": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of entity clients in the billing period.",
+			},
+			"non_entity_clients": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of non-entity clients in the billing period.",
+			},
+			"by_namespace": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Client counts of each namespace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"namespace_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"clients": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"entity_clients": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"non_entity_clients": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"data_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON-encoded activity data read from Vault.",
+			},
+		},
+	}
+}
+
+func clientCountsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultVersion(client, "1.6.0", "vault_client_counts"); err != nil {
+		return err
+	}
+
+	params := map[string][]string{}
+	for _, k := range []string{"start_time", "end_time"} {
+		if v, ok := d.GetOk(k); ok {
+			params[k] = []string{v.(string)}
+		}
+	}
+
+	log.Printf("[DEBUG] Reading client counts from %q", clientCountsPath)
+	resp, err := client.Logical().ReadWithData(clientCountsPath, params)
+	if err != nil {
+		return fmt.Errorf("error reading client counts from %q: %s", clientCountsPath, err)
+	}
+	log.Printf("[DEBUG] Read client counts from %q", clientCountsPath)
+
+	// Vault responds without any data when no activity was recorded.
+	data := map[string]interface{}{}
+	if resp != nil && resp.Data != nil {
+		data = resp.Data
+	}
+
+	d.SetId(clientCountsPath)
+	if v, ok := data["start_time"]; ok {
+		d.Set("start_time", v)
+	}
+	if v, ok := data["end_time"]; ok {
+		d.Set("end_time", v)
+	}
+
+	total, _ := data["total"].(map[string]interface{})
+	d.Set("clients", clientCountsValue(total, "clients", ""))
+	d.Set("entity_clients", clientCountsValue(total, "entity_clients", "distinct_entities"))
+	d.Set("non_entity_clients", clientCountsValue(total, "non_entity_clients", "non_entity_tokens"))
+
+	var namespaces []map[string]interface{}
+	byNamespace, _ := data["by_namespace"].([]interface{})
+	for _, v := range byNamespace {
+		ns, _ := v.(map[string]interface{})
+		counts, _ := ns["counts"].(map[string]interface{})
+		namespaces = append(namespaces, map[string]interface{}{
+			"namespace_id":       ns["namespace_id"],
+			"namespace_path":     ns["namespace_path"],
+			"clients":            clientCountsValue(counts, "clients", ""),
+			"entity_clients":     clientCountsValue(counts, "entity_clients", "distinct_entities"),
+			"non_entity_clients": clientCountsValue(counts, "non_entity_clients", "non_entity_tokens"),
+		})
+	}
+	if err := d.Set("by_namespace", namespaces); err != nil {
+		return fmt.Errorf("error setting by_namespace in state: %s", err)
+	}
+
+	// Ignoring error because this value came from JSON in the
+	// first place so no reason why it should fail to re-encode.
+	jsonData, _ := json.Marshal(data)
+	d.Set("data_json", string(jsonData))
+
+	return nil
+}
+
+// clientCountsValue returns the count under key, or under the key used by
+// Vault before 1.8 when the former is missing.
+func clientCountsValue(counts map[string]interface{}, key, legacyKey string) int {
+	v, ok := counts[key]
+	if !ok && legacyKey != "" {
+		v = counts[legacyKey]
+	}
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0
+	}
+	i, err := n.Int64()
+	if err != nil {
+		return 0
+	}
+	return int(i)
+}
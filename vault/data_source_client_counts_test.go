@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceClientCounts_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_client_counts" "test" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_client_counts.test", "id", clientCountsPath),
+					resource.TestCheckResourceAttrSet("data.vault_client_counts.test", "clients"),
+					resource.TestCheckResourceAttrSet("data.vault_client_counts.test", "entity_clients"),
+					resource.TestCheckResourceAttrSet("data.vault_client_counts.test", "non_entity_clients"),
+					resource.TestCheckResourceAttrSet("data.vault_client_counts.test", "data_json"),
+				),
+			},
+		},
+	})
+}
+
+func TestClientCountsValue(t *testing.T) {
+	counts := map[string]interface{}{
+		"clients":           json.Number("7"),
+		"distinct_entities": json.Number("4"),
+		"non_entity_tokens": json.Number("3"),
+	}
+
+	tests := []struct {
+		key, legacyKey string
+		want           int
+	}{
+		{"clients", "", 7},
+		{"entity_clients", "distinct_entities", 4},
+		{"non_entity_clients", "non_entity_tokens", 3},
+		{"entity_clients", "", 0},
+	}
+	for _, tt := range tests {
+		if got := clientCountsValue(counts, tt.key, tt.legacyKey); got != tt.want {
+			t.Errorf("clientCountsValue(%q, %q) = %d, want %d", tt.key, tt.legacyKey, got, tt.want)
+		}
+	}
+
+	if got := clientCountsValue(nil, "clients", ""); got != 0 {
+		t.Errorf("clientCountsValue(nil) = %d, want 0", got)
+	}
+}
@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const entityCountsPath = "sys/internal/counters/entities"
+
+func entityCountsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: entityCountsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"entities": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of identity entities.",
+			},
+		},
+	}
+}
+
+func entityCountsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultVersion(client, "1.3.0", "vault_entity_counts"); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading entity counts from %q", entityCountsPath)
+	resp, err := client.Logical().Read(entityCountsPath)
+	if err != nil {
+		return fmt.Errorf("error reading entity counts from %q: %s", entityCountsPath, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no entity counts found at %q", entityCountsPath)
+	}
+	log.Printf("[DEBUG] Read entity counts from %q", entityCountsPath)
+
+	counts, _ := resp.Data["counts"].(map[string]interface{})
+
+	d.SetId(entityCountsPath)
+	d.Set("entities", clientCountsValue(counts, "entities", ""))
+
+	return nil
+}
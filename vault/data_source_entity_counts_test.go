@@ -0,0 +1,36 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceEntityCounts_basic(t *testing.T) {
+	entity := acctest.RandomWithPrefix("test-entity")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_generic_secret" "test" {
+  path         = "identity/entity/name/%s"
+  data_json    = "{}"
+  disable_read = true
+}
+
+data "vault_entity_counts" "test" {
+  depends_on = ["vault_generic_secret.test"]
+}
+`, entity),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_entity_counts.test", "id", entityCountsPath),
+					resource.TestCheckResourceAttrSet("data.vault_entity_counts.test", "entities"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,99 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpAccessTokenDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: gcpAccessTokenDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "GCP Secret Backend to read the access token from.",
+			},
+			"roleset": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Roleset to generate the access token for.",
+				ConflictsWith: []string{"static_account", "impersonated_account"},
+			},
+			"static_account": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Static account to generate the access token for.",
+				ConflictsWith: []string{"roleset", "impersonated_account"},
+			},
+			"impersonated_account": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Impersonated account to generate the access token for.",
+				ConflictsWith: []string{"roleset", "static_account"},
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The OAuth2 access token read from Vault.",
+			},
+			"token_ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of seconds the access token is valid for.",
+			},
+			"expires_at_seconds": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time the access token expires, as a Unix timestamp.",
+			},
+		},
+	}
+}
+
+func gcpAccessTokenDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path, err := gcpSecretCredentialPath(d, []string{"roleset", "static_account", "impersonated_account"}, "token")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading %q from Vault", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read %q from Vault", path)
+
+	if secret == nil {
+		return fmt.Errorf("no access token found at path %q", path)
+	}
+
+	d.SetId(path)
+	d.Set("token", secret.Data["token"])
+	d.Set("token_ttl", secret.Data["token_ttl"])
+	d.Set("expires_at_seconds", secret.Data["expires_at_seconds"])
+
+	return nil
+}
+
+// gcpSecretCredentialPath builds the path of a GCP secrets engine credential
+// endpoint from whichever of the given account fields is set, e.g.
+// gcp/static-account/<name>/token.
+func gcpSecretCredentialPath(d *schema.ResourceData, fields []string, credential string) (string, error) {
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	for _, k := range fields {
+		if v, ok := d.GetOk(k); ok {
+			segment := strings.Replace(k, "_", "-", -1)
+			return backend + "/" + segment + "/" + strings.Trim(v.(string), "/") + "/" + credential, nil
+		}
+	}
+	return "", fmt.Errorf("one of %s must be set", strings.Join(fields, ", "))
+}
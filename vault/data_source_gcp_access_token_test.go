@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGCPAccessToken_roleset(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	name := acctest.RandomWithPrefix("tf-test")
+	credentials, project := getTestGCPCreds(t)
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGCPAccessTokenConfig_roleset(backend, name, credentials, project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_gcp_access_token.test", "token"),
+					resource.TestCheckResourceAttrSet("data.vault_gcp_access_token.test", "token_ttl"),
+					resource.TestCheckResourceAttrSet("data.vault_gcp_access_token.test", "expires_at_seconds"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGCPAccessTokenConfig_roleset(backend, name, credentials, project string) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<EOF
+%s
+EOF
+}
+
+resource "vault_gcp_secret_roleset" "test" {
+  backend      = "${vault_gcp_secret_backend.test.path}"
+  name         = "%s"
+  project      = "%s"
+  secret_type  = "%s"
+  token_scopes = ["https://www.googleapis.com/auth/cloud-platform"]
+
+  binding {
+    resource = "//cloudresourcemanager.googleapis.com/projects/%s"
+    roles    = ["roles/viewer"]
+  }
+}
+
+data "vault_gcp_access_token" "test" {
+  backend = "${vault_gcp_secret_backend.test.path}"
+  roleset = "${vault_gcp_secret_roleset.test.name}"
+}`, backend, credentials, name, project, "access_token", project)
+}
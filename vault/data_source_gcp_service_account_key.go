@@ -0,0 +1,121 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpServiceAccountKeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: gcpServiceAccountKeyDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "GCP Secret Backend to read the service account key from.",
+			},
+			"roleset": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Roleset to generate the service account key for.",
+				ConflictsWith: []string{"static_account"},
+			},
+			"static_account": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Static account to generate the service account key for.",
+				ConflictsWith: []string{"roleset"},
+			},
+			"key_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "KEY_ALG_RSA_2048",
+				Description:  "Key algorithm of the generated key, either \"KEY_ALG_RSA_2048\" or \"KEY_ALG_RSA_1024\".",
+				ValidateFunc: validation.StringInSlice([]string{"KEY_ALG_RSA_2048", "KEY_ALG_RSA_1024"}, false),
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "TYPE_GOOGLE_CREDENTIALS_FILE",
+				Description:  "Private key type of the generated key, either \"TYPE_GOOGLE_CREDENTIALS_FILE\" or \"TYPE_PKCS12_FILE\".",
+				ValidateFunc: validation.StringInSlice([]string{"TYPE_GOOGLE_CREDENTIALS_FILE", "TYPE_PKCS12_FILE"}, false),
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Lease TTL in seconds of the generated key, capped at the backend's max TTL.",
+			},
+			"private_key_data": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64-encoded private key read from Vault.",
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by vault.",
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds relative to the time in lease_start_time.",
+			},
+			"lease_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease was read, using the clock of the system where Terraform was running",
+			},
+			"lease_renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
+		},
+	}
+}
+
+func gcpServiceAccountKeyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path, err := gcpSecretCredentialPath(d, []string{"roleset", "static_account"}, "key")
+	if err != nil {
+		return err
+	}
+
+	data := map[string][]string{
+		"key_algorithm": {d.Get("key_algorithm").(string)},
+		"key_type":      {d.Get("key_type").(string)},
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = []string{strconv.Itoa(v.(int))}
+	}
+
+	log.Printf("[DEBUG] Reading %q from Vault", path)
+	secret, err := client.Logical().ReadWithData(path, data)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read %q from Vault", path)
+
+	if secret == nil {
+		return fmt.Errorf("no service account key found at path %q", path)
+	}
+
+	d.SetId(secret.LeaseID)
+	d.Set("private_key_data", secret.Data["private_key_data"])
+	d.Set("lease_id", secret.LeaseID)
+	d.Set("lease_duration", secret.LeaseDuration)
+	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
+	d.Set("lease_renewable", secret.Renewable)
+	renewLease(client, secret)
+
+	return nil
+}
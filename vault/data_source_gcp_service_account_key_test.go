@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGCPServiceAccountKey_roleset(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	name := acctest.RandomWithPrefix("tf-test")
+	credentials, project := getTestGCPCreds(t)
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGCPServiceAccountKeyConfig_roleset(backend, name, credentials, project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_gcp_service_account_key.test", "private_key_data"),
+					resource.TestCheckResourceAttr("data.vault_gcp_service_account_key.test", "key_type", "TYPE_GOOGLE_CREDENTIALS_FILE"),
+					resource.TestCheckResourceAttrSet("data.vault_gcp_service_account_key.test", "lease_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGCPServiceAccountKeyConfig_roleset(backend, name, credentials, project string) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<EOF
+%s
+EOF
+}
+
+resource "vault_gcp_secret_roleset" "test" {
+  backend      = "${vault_gcp_secret_backend.test.path}"
+  name         = "%s"
+  project      = "%s"
+  secret_type  = "%s"
+  token_scopes = ["https://www.googleapis.com/auth/cloud-platform"]
+
+  binding {
+    resource = "//cloudresourcemanager.googleapis.com/projects/%s"
+    roles    = ["roles/viewer"]
+  }
+}
+
+data "vault_gcp_service_account_key" "test" {
+  backend = "${vault_gcp_secret_backend.test.path}"
+  roleset = "${vault_gcp_secret_roleset.test.name}"
+}`, backend, credentials, name, project, "service_account_key", project)
+}
@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpkmsDecryptDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: gcpkmsDecryptDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the GCP KMS Secret Backend the key belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the decryption key to use.",
+			},
+			"ciphertext": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Base64 encoded ciphertext to be decrypted.",
+			},
+			"additional_authenticated_data": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Additional authenticated data, must match between encryption and decryption.",
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The version of the key to use for decryption, required for asymmetric keys.",
+			},
+			"plaintext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Decrypted plaintext returned from Vault.",
+			},
+		},
+	}
+}
+
+func gcpkmsDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	key := d.Get("key").(string)
+
+	path := backend + "/decrypt/" + strings.Trim(key, "/")
+
+	data := map[string]interface{}{
+		"ciphertext": d.Get("ciphertext").(string),
+	}
+	if v, ok := d.GetOk("additional_authenticated_data"); ok {
+		data["additional_authenticated_data"] = v.(string)
+	}
+	if v, ok := d.GetOk("key_version"); ok {
+		data["key_version"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Decrypting data with key %q on GCP KMS secret backend %q", key, backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error decrypting data with key %q on GCP KMS secret backend %q: %s", key, backend, err)
+	}
+	log.Printf("[DEBUG] Decrypted data with key %q on GCP KMS secret backend %q", key, backend)
+
+	d.SetId(path)
+	d.Set("plaintext", resp.Data["plaintext"])
+
+	return nil
+}
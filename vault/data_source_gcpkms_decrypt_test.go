@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGCPKMSDecryptDataSource_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcpkms")
+	name := acctest.RandomWithPrefix("tf-test")
+	credentials, project := getTestGCPCreds(t)
+	keyRing := fmt.Sprintf("projects/%s/locations/global/keyRings/tf-test-vault", project)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGCPKMSDecryptDataSourceConfig_basic(backend, credentials, name, keyRing),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_gcpkms_encrypt.test", "ciphertext"),
+					resource.TestCheckResourceAttr("data.vault_gcpkms_decrypt.test", "plaintext", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGCPKMSDecryptDataSourceConfig_basic(backend, credentials, name, keyRing string) string {
+	return fmt.Sprintf(`
+resource "vault_gcpkms_secret_backend" "test" {
+  path        = "%s"
+  credentials = <<EOF
+%s
+EOF
+}
+
+resource "vault_gcpkms_secret_backend_key" "test" {
+  backend  = "${vault_gcpkms_secret_backend.test.path}"
+  name     = "%s"
+  key_ring = "%s"
+}
+
+data "vault_gcpkms_encrypt" "test" {
+  backend                       = "${vault_gcpkms_secret_backend.test.path}"
+  key                           = "${vault_gcpkms_secret_backend_key.test.name}"
+  plaintext                     = "foo"
+  additional_authenticated_data = "bar"
+}
+
+data "vault_gcpkms_decrypt" "test" {
+  backend                       = "${vault_gcpkms_secret_backend.test.path}"
+  key                           = "${vault_gcpkms_secret_backend_key.test.name}"
+  ciphertext                    = "${data.vault_gcpkms_encrypt.test.ciphertext}"
+  additional_authenticated_data = "bar"
+}`, backend, credentials, name, keyRing)
+}
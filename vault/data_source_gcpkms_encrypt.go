@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpkmsEncryptDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: gcpkmsEncryptDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the GCP KMS Secret Backend the key belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the encryption key to use.",
+			},
+			"plaintext": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Plaintext to be encrypted.",
+			},
+			"additional_authenticated_data": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Additional authenticated data, must match between encryption and decryption.",
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The version of the key to use for encryption, the primary one if unset.",
+			},
+			"ciphertext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Base64 encoded ciphertext returned from Vault.",
+			},
+		},
+	}
+}
+
+func gcpkmsEncryptDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	key := d.Get("key").(string)
+
+	path := backend + "/encrypt/" + strings.Trim(key, "/")
+
+	data := map[string]interface{}{
+		"plaintext": d.Get("plaintext").(string),
+	}
+	if v, ok := d.GetOk("additional_authenticated_data"); ok {
+		data["additional_authenticated_data"] = v.(string)
+	}
+	if v, ok := d.GetOk("key_version"); ok {
+		data["key_version"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Encrypting data with key %q on GCP KMS secret backend %q", key, backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error encrypting data with key %q on GCP KMS secret backend %q: %s", key, backend, err)
+	}
+	log.Printf("[DEBUG] Encrypted data with key %q on GCP KMS secret backend %q", key, backend)
+
+	d.SetId(path)
+	d.Set("ciphertext", resp.Data["ciphertext"])
+
+	return nil
+}
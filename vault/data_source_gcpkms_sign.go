@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpkmsSignDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: gcpkmsSignDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the GCP KMS Secret Backend the key belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the signing key to use.",
+			},
+			"digest": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Base64 encoded digest of the data to sign, hashed with the algorithm of the key.",
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The version of the key to sign with.",
+			},
+			"signature": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Base64 encoded signature returned from Vault.",
+			},
+		},
+	}
+}
+
+func gcpkmsSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	key := d.Get("key").(string)
+
+	path := backend + "/sign/" + strings.Trim(key, "/")
+
+	data := map[string]interface{}{
+		"digest":      d.Get("digest").(string),
+		"key_version": d.Get("key_version").(int),
+	}
+
+	log.Printf("[DEBUG] Signing data with key %q on GCP KMS secret backend %q", key, backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error signing data with key %q on GCP KMS secret backend %q: %s", key, backend, err)
+	}
+	log.Printf("[DEBUG] Signed data with key %q on GCP KMS secret backend %q", key, backend)
+
+	d.SetId(path)
+	d.Set("signature", resp.Data["signature"])
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGCPKMSSignDataSource_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcpkms")
+	name := acctest.RandomWithPrefix("tf-test")
+	credentials, project := getTestGCPCreds(t)
+	keyRing := fmt.Sprintf("projects/%s/locations/global/keyRings/tf-test-vault", project)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGCPKMSSignDataSourceConfig_basic(backend, credentials, name, keyRing),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_gcpkms_sign.test", "signature"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGCPKMSSignDataSourceConfig_basic(backend, credentials, name, keyRing string) string {
+	return fmt.Sprintf(`
+resource "vault_gcpkms_secret_backend" "test" {
+  path        = "%s"
+  credentials = <<EOF
+%s
+EOF
+}
+
+resource "vault_gcpkms_secret_backend_key" "test" {
+  backend   = "${vault_gcpkms_secret_backend.test.path}"
+  name      = "%s"
+  key_ring  = "%s"
+  purpose   = "asymmetric_sign"
+  algorithm = "ec_sign_p256_sha256"
+}
+
+# the sha256 digest of "foo"
+data "vault_gcpkms_sign" "test" {
+  backend     = "${vault_gcpkms_secret_backend.test.path}"
+  key         = "${vault_gcpkms_secret_backend_key.test.name}"
+  key_version = 1
+  digest      = "LCa0a2j/xo/5m0U8HTBBNBNCLXBkg7+g+YpeiGJm564="
+}`, backend, credentials, name, keyRing)
+}
@@ -0,0 +1,124 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func genericEndpointDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: genericEndpointDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Full path of the Vault endpoint to read.",
+			},
+			"params": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Query parameters sent with the request.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The version to read, sent as the version query parameter when set.",
+			},
+			"fields": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only keep these top-level fields of the response data, instead of all of them.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"sensitive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Set this to false to also export the response data in the non-sensitive attributes, for endpoints that return no secrets.",
+			},
+			"data_json": {
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Computed:    true,
+				Description: "JSON-encoded response data read from Vault.",
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Sensitive:   true,
+				Computed:    true,
+				Description: "Map of strings of the response data read from Vault.",
+			},
+			"nonsensitive_data_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON-encoded response data read from Vault, when sensitive is false.",
+			},
+			"nonsensitive_data": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of strings of the response data read from Vault, when sensitive is false.",
+			},
+		},
+	}
+}
+
+func genericEndpointDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+
+	params := map[string][]string{}
+	for k, v := range d.Get("params").(map[string]interface{}) {
+		params[k] = []string{v.(string)}
+	}
+	if version := d.Get("version").(int); version > 0 {
+		params["version"] = []string{strconv.Itoa(version)}
+	}
+
+	log.Printf("[DEBUG] Reading %s from Vault", path)
+	secret, err := client.Logical().ReadWithData(path, params)
+	if err != nil {
+		return fmt.Errorf("error reading %q from Vault: %s", path, err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no data found at %q", path)
+	}
+	log.Printf("[DEBUG] Read %s from Vault", path)
+
+	data := secret.Data
+	if fields := d.Get("fields").([]interface{}); len(fields) > 0 {
+		data = map[string]interface{}{}
+		for _, f := range fields {
+			if v, ok := secret.Data[f.(string)]; ok {
+				data[f.(string)] = v
+			}
+		}
+	}
+
+	d.SetId(path)
+
+	// Ignoring error because this value came from JSON in the
+	// first place so no reason why it should fail to re-encode.
+	jsonDataBytes, _ := json.Marshal(data)
+	dataMap := flattenSecretData(data)
+	d.Set("data_json", string(jsonDataBytes))
+	d.Set("data", dataMap)
+
+	if d.Get("sensitive").(bool) {
+		d.Set("nonsensitive_data_json", "")
+		d.Set("nonsensitive_data", nil)
+	} else {
+		d.Set("nonsensitive_data_json", string(jsonDataBytes))
+		d.Set("nonsensitive_data", dataMap)
+	}
+
+	return nil
+}
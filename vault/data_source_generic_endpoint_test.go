@@ -0,0 +1,41 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGenericEndpoint_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_generic_endpoint" "test" {
+  path      = "sys/mounts/secret/tune"
+  fields    = ["default_lease_ttl", "max_lease_ttl"]
+  sensitive = false
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_generic_endpoint.test", "data.%", "2"),
+					resource.TestCheckResourceAttrSet("data.vault_generic_endpoint.test", "data.max_lease_ttl"),
+					resource.TestCheckResourceAttr("data.vault_generic_endpoint.test", "nonsensitive_data.%", "2"),
+				),
+			},
+			{
+				Config: `
+data "vault_generic_endpoint" "test" {
+  path = "sys/mounts/secret/tune"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_generic_endpoint.test", "data.max_lease_ttl"),
+					resource.TestCheckResourceAttr("data.vault_generic_endpoint.test", "nonsensitive_data_json", ""),
+				),
+			},
+		},
+	})
+}
@@ -15,7 +15,7 @@ func genericSecretDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: genericSecretDataSourceRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withWrappingSchema(map[string]*schema.Schema{
 			"path": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -31,12 +31,14 @@ func genericSecretDataSource() *schema.Resource {
 
 			"data_json": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "JSON-encoded secret data read from Vault.",
 			},
 
 			"data": {
 				Type:        schema.TypeMap,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "Map of strings read from Vault.",
 			},
@@ -64,7 +66,7 @@ func genericSecretDataSource() *schema.Resource {
 				Computed:    true,
 				Description: "True if the duration of this lease can be extended through renewal.",
 			},
-		},
+		}, false),
 	}
 }
 
@@ -76,7 +78,8 @@ func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error
 	secretVersion := d.Get("version").(int)
 	log.Printf("[DEBUG] Reading %s %d from Vault", path, secretVersion)
 
-	secret, err := versionedSecret(secretVersion, path, client)
+	wrapTTL := d.Get("wrapping_ttl").(string)
+	secret, err := wrappedVersionedSecret(secretVersion, path, wrapTTL, client)
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
@@ -84,6 +87,19 @@ func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("no secret found at %q", path)
 	}
 
+	// Vault doesn't wrap the responses that only hold warnings, e.g. when
+	// the secret doesn't exist.
+	if wrapTTL != "" && secret.WrapInfo == nil {
+		return fmt.Errorf("no wrapping information in the response for %q", path)
+	}
+
+	setWrappingInfo(d, secret)
+	if wrapTTL != "" {
+		// the secret data can only be read by unwrapping the token
+		d.SetId(secret.WrapInfo.Accessor)
+		return nil
+	}
+
 	d.SetId(secret.RequestID)
 
 	// Ignoring error because this value came from JSON in the
@@ -91,13 +107,26 @@ func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error
 	jsonDataBytes, _ := json.Marshal(secret.Data)
 	d.Set("data_json", string(jsonDataBytes))
 
+	d.Set("data", flattenSecretData(secret.Data))
+
+	d.Set("lease_id", secret.LeaseID)
+	d.Set("lease_duration", secret.LeaseDuration)
+	d.Set("lease_start_time", time.Now().Format("RFC3339"))
+	d.Set("lease_renewable", secret.Renewable)
+	renewLease(client, secret)
+
+	return nil
+}
+
+// flattenSecretData converts the data of a secret to a map of strings.
+func flattenSecretData(data map[string]interface{}) map[string]string {
 	// Since our "data" map can only contain string values, we
 	// will take strings from Data and write them in as-is,
 	// and write everything else in as a JSON serialization of
 	// whatever value we get so that complex types can be
 	// passed around and processed elsewhere if desired.
 	dataMap := map[string]string{}
-	for k, v := range secret.Data {
+	for k, v := range data {
 		if vs, ok := v.(string); ok {
 			dataMap[k] = vs
 		} else {
@@ -107,12 +136,5 @@ func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error
 			dataMap[k] = string(vBytes)
 		}
 	}
-	d.Set("data", dataMap)
-
-	d.Set("lease_id", secret.LeaseID)
-	d.Set("lease_duration", secret.LeaseDuration)
-	d.Set("lease_start_time", time.Now().Format("RFC3339"))
-	d.Set("lease_renewable", secret.Renewable)
-
-	return nil
+	return dataMap
 }
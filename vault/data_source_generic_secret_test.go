@@ -2,10 +2,15 @@ package vault
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	r "github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
 )
 
 func TestDataSourceGenericSecret(t *testing.T) {
@@ -38,6 +43,68 @@ func TestV2Secret(t *testing.T) {
 	})
 }
 
+func TestDataSourceGenericSecret_wrapped(t *testing.T) {
+	r.Test(t, r.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []r.TestStep{
+			{
+				Config: testDataSourceGenericSecretWrapped_config,
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttrSet("data.vault_generic_secret.test", "wrapping_token"),
+					r.TestCheckResourceAttrSet("data.vault_generic_secret.test", "wrapping_accessor"),
+					r.TestCheckNoResourceAttr("data.vault_generic_secret.test", "data.zip"),
+				),
+			},
+		},
+	})
+}
+
+func TestGenericSecretDataSourceRead_wrappedWarnings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		if r.URL.Path == "/v1/secret/missing" {
+			w.Write([]byte(`{"warnings": ["Invalid path for a versioned K/V secrets engine."]}`))
+		}
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, genericSecretDataSource().Schema, map[string]interface{}{
+		"path":         "secret/missing",
+		"wrapping_ttl": "60s",
+	})
+	err = genericSecretDataSourceRead(d, client)
+	if err == nil || !strings.Contains(err.Error(), "no wrapping information") {
+		t.Fatalf("expected an error about the missing wrapping information, got %v", err)
+	}
+}
+
+var testDataSourceGenericSecretWrapped_config = `
+
+resource "vault_generic_secret" "test" {
+    path = "secret/wrapped"
+    data_json = <<EOT
+{
+    "zip": "zap"
+}
+EOT
+}
+
+data "vault_generic_secret" "test" {
+    path = "${vault_generic_secret.test.path}"
+    wrapping_ttl = "60s"
+}
+
+`
+
 var testv2DataSourceGenericSecret_config = `
 
 resource "vault_generic_secret" "test" {
@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func hashDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: hashDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path of a Transit Secret Backend to hash the input with, instead of sys/tools.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"input": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The data to hash.",
+			},
+			"algorithm": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "sha2-256",
+				Description: "The hash algorithm to use.",
+				ValidateFunc: validation.StringInSlice([]string{
+					"sha2-224", "sha2-256", "sha2-384", "sha2-512",
+					"sha3-224", "sha3-256", "sha3-384", "sha3-512",
+				}, false),
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "hex",
+				Description:  "The output encoding. Must be either \"hex\" or \"base64\".",
+				ValidateFunc: validation.StringInSlice([]string{"hex", "base64"}, false),
+			},
+			"sum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hash returned from Vault.",
+			},
+		},
+	}
+}
+
+func hashDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := "sys/tools/hash"
+	if v, ok := d.GetOk("backend"); ok {
+		path = strings.Trim(v.(string), "/") + "/hash"
+	}
+	path = path + "/" + d.Get("algorithm").(string)
+
+	data := map[string]interface{}{
+		"input":  base64.StdEncoding.EncodeToString([]byte(d.Get("input").(string))),
+		"format": d.Get("format").(string),
+	}
+
+	log.Printf("[DEBUG] Hashing data with %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error hashing data with %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Hashed data with %q", path)
+
+	d.SetId(path)
+	d.Set("sum", resp.Data["sum"])
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccHashDataSource_basic(t *testing.T) {
+	backend := "transit-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHashDataSourceConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_hash.tools", "sum", "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"),
+					resource.TestCheckResourceAttr("data.vault_hash.transit", "sum", "9/u6bgY2+JDlb7vzKD5STG+jIErimDgtYkdB0NxmODJuKCxBvl5CVNiCB3LFUYosWowMf37aGVlKfrU5RT4e1w=="),
+				),
+			},
+		},
+	})
+}
+
+func testAccHashDataSourceConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transit"
+  path = "%s"
+}
+
+data "vault_hash" "tools" {
+  input = "foo"
+}
+
+data "vault_hash" "transit" {
+  backend   = "${vault_mount.test.path}"
+  input     = "foo"
+  algorithm = "sha2-512"
+  format    = "base64"
+}`, backend)
+}
@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityEntityPath = "/identity/entity"
+
+func identityEntitiesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: identityEntitiesDataSourceRead,
+
+		Schema: withListFilterSchema(map[string]*schema.Schema{
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the entities, in the order of their names.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the entities, sorted.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		}),
+	}
+}
+
+func identityEntitiesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Listing IdentityEntities")
+	ids, names, err := listIdentityFilteredIDs(client, identityEntityPath, d)
+	if err != nil {
+		return fmt.Errorf("error listing IdentityEntities: %s", err)
+	}
+	log.Printf("[DEBUG] Listed %d IdentityEntities", len(ids))
+
+	d.SetId(identityEntityPath)
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids in state: %s", err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names in state: %s", err)
+	}
+
+	return nil
+}
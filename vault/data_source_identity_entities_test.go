@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccDataSourceIdentityEntities_basic(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("test-entity")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_generic_secret" "b" {
+  path         = "identity/entity/name/%s-b"
+  data_json    = "{}"
+  disable_read = true
+}
+
+resource "vault_generic_secret" "a" {
+  path         = "identity/entity/name/%s-a"
+  data_json    = "{}"
+  disable_read = true
+}
+
+data "vault_identity_entities" "test" {
+  prefix = "%s-"
+  regex  = "-[a-z]$"
+
+  depends_on = ["vault_generic_secret.a", "vault_generic_secret.b"]
+}
+`, prefix, prefix, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_identity_entities.test", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_identity_entities.test", "names.0", prefix+"-a"),
+					resource.TestCheckResourceAttr("data.vault_identity_entities.test", "names.1", prefix+"-b"),
+					resource.TestCheckResourceAttr("data.vault_identity_entities.test", "ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestIdentityEntitiesDataSourceRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/identity/entity/id" || r.URL.Query().Get("list") != "true" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {
+			"keys": ["id-b", "id-a", "id-other"],
+			"key_info": {
+				"id-b": {"name": "app-b"},
+				"id-a": {"name": "app-a"},
+				"id-other": {"name": "other"}
+			}
+		}}`))
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, identityEntitiesDataSource().Schema, map[string]interface{}{
+		"prefix": "app-",
+	})
+	if err := identityEntitiesDataSourceRead(d, client); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != identityEntityPath {
+		t.Errorf("expected ID %q, got %q", identityEntityPath, d.Id())
+	}
+	if got, want := d.Get("names"), []interface{}{"app-a", "app-b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected names %v, got %v", want, got)
+	}
+	if got, want := d.Get("ids"), []interface{}{"id-a", "id-b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected ids %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,55 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func identityGroupsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: identityGroupsDataSourceRead,
+
+		Schema: withListFilterSchema(map[string]*schema.Schema{
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the groups, in the order of their names.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the groups, sorted.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		}),
+	}
+}
+
+func identityGroupsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Listing IdentityGroups")
+	ids, names, err := listIdentityFilteredIDs(client, identityGroupPath, d)
+	if err != nil {
+		return fmt.Errorf("error listing IdentityGroups: %s", err)
+	}
+	log.Printf("[DEBUG] Listed %d IdentityGroups", len(ids))
+
+	d.SetId(identityGroupPath)
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids in state: %s", err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names in state: %s", err)
+	}
+
+	return nil
+}
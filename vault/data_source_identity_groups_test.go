@@ -0,0 +1,44 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceIdentityGroups_basic(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("test-group")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_identity_group" "b" {
+  name = "%s-b"
+}
+
+resource "vault_identity_group" "a" {
+  name = "%s-a"
+}
+
+data "vault_identity_groups" "test" {
+  prefix = "%s-"
+  regex  = "-[a-z]$"
+
+  depends_on = ["vault_identity_group.a", "vault_identity_group.b"]
+}
+`, prefix, prefix, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_identity_groups.test", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_identity_groups.test", "names.0", prefix+"-a"),
+					resource.TestCheckResourceAttrPair("data.vault_identity_groups.test", "ids.0", "vault_identity_group.a", "id"),
+					resource.TestCheckResourceAttrPair("data.vault_identity_groups.test", "ids.1", "vault_identity_group.b", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,103 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func kmipSecretCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: kmipSecretCredentialsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "KMIP Secret Backend to generate the credentials from.",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Scope of the role to generate the credentials for.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Role to generate the credentials for.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "pem",
+				Description:  "The format of the generated certificate, either \"pem\", \"der\" or \"pem_bundle\".",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "der", "pem_bundle"}, false),
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The generated client certificate.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key of the generated client certificate.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CA chain of the generated client certificate.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the generated client certificate.",
+			},
+		},
+	}
+}
+
+func kmipSecretCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultEnterprise(client, "1.2.0", "vault_kmip_secret_credentials"); err != nil {
+		return err
+	}
+
+	path := kmipSecretRolePath(d.Get("backend").(string), d.Get("scope").(string), d.Get("role").(string)) + "/credential/generate"
+
+	// every read generates a new client certificate.
+	log.Printf("[DEBUG] Generating KMIP credentials at %q", path)
+	secret, err := client.Logical().Write(path, map[string]interface{}{
+		"format": d.Get("format").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("error generating KMIP credentials at %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Generated KMIP credentials at %q", path)
+
+	if secret == nil {
+		return fmt.Errorf("no credentials generated at path %q", path)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", path, secret.Data["serial_number"]))
+	d.Set("certificate", secret.Data["certificate"])
+	d.Set("private_key", secret.Data["private_key"])
+	d.Set("serial_number", secret.Data["serial_number"])
+
+	caChain := []string{}
+	if v, ok := secret.Data["ca_chain"].([]interface{}); ok {
+		caChain = jsonStringArrayToStringArray(v)
+	}
+	if err := d.Set("ca_chain", caChain); err != nil {
+		return fmt.Errorf("error setting ca_chain in state: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceKMIPSecretCredentials_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-kmip")
+	scope := acctest.RandomWithPrefix("tf-test")
+	role := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t); testAccEnterprisePreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceKMIPSecretCredentialsConfig_basic(backend, scope, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_kmip_secret_credentials.test", "certificate"),
+					resource.TestCheckResourceAttrSet("data.vault_kmip_secret_credentials.test", "private_key"),
+					resource.TestCheckResourceAttrSet("data.vault_kmip_secret_credentials.test", "serial_number"),
+					resource.TestCheckResourceAttrSet("data.vault_kmip_secret_credentials.test", "ca_chain.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceKMIPSecretCredentialsConfig_basic(backend, scope, role string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "test" {
+  path         = "%s"
+  listen_addrs = ["127.0.0.1:5696"]
+}
+
+resource "vault_kmip_secret_scope" "test" {
+  backend = "${vault_kmip_secret_backend.test.path}"
+  name    = "%s"
+  force   = true
+}
+
+resource "vault_kmip_secret_role" "test" {
+  backend       = "${vault_kmip_secret_backend.test.path}"
+  scope         = "${vault_kmip_secret_scope.test.name}"
+  role          = "%s"
+  operation_all = true
+}
+
+data "vault_kmip_secret_credentials" "test" {
+  backend = "${vault_kmip_secret_backend.test.path}"
+  scope   = "${vault_kmip_secret_role.test.scope}"
+  role    = "${vault_kmip_secret_role.test.role}"
+}`, backend, scope, role)
+}
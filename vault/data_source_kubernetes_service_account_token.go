@@ -0,0 +1,132 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kubernetesServiceAccountTokenDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: kubernetesServiceAccountTokenDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Kubernetes Secret Backend to generate the token from.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Kubernetes Secret Backend role to generate the token from.",
+			},
+			"kubernetes_namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Kubernetes namespace to generate the service account token in.",
+			},
+			"cluster_role_binding": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Bind the generated role cluster wide instead of in the namespace only.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "TTL in seconds of the generated token, defaults to the role's token_default_ttl.",
+			},
+			"audiences": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Audiences of the generated token, defaults to the role's token_default_audiences.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"service_account_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the service account the token belongs to.",
+			},
+			"service_account_namespace": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The namespace of the service account the token belongs to.",
+			},
+			"service_account_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The Kubernetes service account token.",
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by vault.",
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds relative to the time in lease_start_time.",
+			},
+			"lease_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease was read, using the clock of the system where Terraform was running",
+			},
+			"lease_renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
+		},
+	}
+}
+
+func kubernetesServiceAccountTokenDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	role := d.Get("role").(string)
+	path := backend + "/creds/" + role
+
+	data := map[string]interface{}{
+		"kubernetes_namespace": d.Get("kubernetes_namespace").(string),
+		"cluster_role_binding": d.Get("cluster_role_binding").(bool),
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("audiences"); ok {
+		data["audiences"] = strings.Join(toStringArray(v.([]interface{})), ",")
+	}
+
+	log.Printf("[DEBUG] Generating Kubernetes service account token at %q", path)
+	secret, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating Kubernetes service account token at %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Generated Kubernetes service account token at %q", path)
+
+	if secret == nil {
+		return fmt.Errorf("no role found at path %q", path)
+	}
+
+	d.SetId(secret.LeaseID)
+	d.Set("service_account_name", secret.Data["service_account_name"])
+	d.Set("service_account_namespace", secret.Data["service_account_namespace"])
+	d.Set("service_account_token", secret.Data["service_account_token"])
+	d.Set("lease_id", secret.LeaseID)
+	d.Set("lease_duration", secret.LeaseDuration)
+	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
+	d.Set("lease_renewable", secret.Renewable)
+	renewLease(client, secret)
+
+	return nil
+}
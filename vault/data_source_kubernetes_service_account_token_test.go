@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceKubernetesServiceAccountToken_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-kubernetes")
+	name := acctest.RandomWithPrefix("tf-test")
+	host, caCert, jwt := getTestKubernetesCreds(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceKubernetesServiceAccountTokenConfig_basic(backend, host, caCert, jwt, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_kubernetes_service_account_token.test", "service_account_namespace", "default"),
+					resource.TestCheckResourceAttrSet("data.vault_kubernetes_service_account_token.test", "service_account_name"),
+					resource.TestCheckResourceAttrSet("data.vault_kubernetes_service_account_token.test", "service_account_token"),
+					resource.TestCheckResourceAttrSet("data.vault_kubernetes_service_account_token.test", "lease_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceKubernetesServiceAccountTokenConfig_basic(backend, host, caCert, jwt, name string) string {
+	return fmt.Sprintf(`
+resource "vault_kubernetes_secret_backend" "test" {
+  path                 = "%s"
+  kubernetes_host      = "%s"
+  kubernetes_ca_cert   = %q
+  service_account_jwt  = "%s"
+  disable_local_ca_jwt = true
+}
+
+resource "vault_kubernetes_secret_backend_role" "test" {
+  backend                       = "${vault_kubernetes_secret_backend.test.path}"
+  name                          = "%s"
+  allowed_kubernetes_namespaces = ["*"]
+  generated_role_rules          = "rules:\n- apiGroups: [\"\"]\n  resources: [\"pods\"]\n  verbs: [\"list\"]\n"
+}
+
+data "vault_kubernetes_service_account_token" "test" {
+  backend              = "${vault_kubernetes_secret_backend.test.path}"
+  role                 = "${vault_kubernetes_secret_backend_role.test.name}"
+  kubernetes_namespace = "default"
+  ttl                  = 600
+}`, backend, host, caCert, jwt, name)
+}
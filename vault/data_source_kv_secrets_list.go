@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kvSecretsListDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: kvSecretsListDataSourceRead,
+
+		Schema: withListFilterSchema(map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Full path of the KV folder to list, e.g. secret/team.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The keys of the secrets and subfolders of the folder, relative to path. The subfolders end with a slash.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		}),
+	}
+}
+
+func kvSecretsListDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("path").(string), "/")
+
+	mountPath, v2, err := isKVv2(path, client)
+	if err != nil {
+		return fmt.Errorf("error reading the KV version of %q: %s", path, err)
+	}
+	listPath := path
+	if v2 {
+		listPath = addPrefixToVKVPath(path, mountPath, "metadata")
+	}
+
+	log.Printf("[DEBUG] Listing KV secrets at %q", path)
+	names, err := listFilteredKeys(client, listPath, true, d)
+	if err != nil {
+		return fmt.Errorf("error listing KV secrets at %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Listed %d KV secrets at %q", len(names), path)
+
+	d.SetId(path)
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names in state: %s", err)
+	}
+
+	return nil
+}
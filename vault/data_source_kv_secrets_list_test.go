@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceKVSecretsList_basic(t *testing.T) {
+	for _, version := range []string{"1", "2"} {
+		mount := acctest.RandomWithPrefix("tf-test-kv")
+		resource.Test(t, resource.TestCase{
+			Providers: testProviders,
+			PreCheck:  func() { testAccPreCheck(t) },
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceKVSecretsListConfig(mount, version),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.vault_kv_secrets_list.all", "names.#", "3"),
+						resource.TestCheckResourceAttr("data.vault_kv_secrets_list.all", "names.0", "api"),
+						resource.TestCheckResourceAttr("data.vault_kv_secrets_list.all", "names.1", "team/"),
+						resource.TestCheckResourceAttr("data.vault_kv_secrets_list.all", "names.2", "web"),
+						resource.TestCheckResourceAttr("data.vault_kv_secrets_list.filtered", "names.#", "1"),
+						resource.TestCheckResourceAttr("data.vault_kv_secrets_list.filtered", "names.0", "team/billing"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceKVSecretsListConfig(mount, version string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kv" {
+  path    = "%s"
+  type    = "kv"
+  options = {
+    version = "%s"
+  }
+}
+
+resource "vault_generic_secret" "secret" {
+  count     = 3
+  path      = "${vault_mount.kv.path}/${element(list("api", "web", "team/billing"), count.index)}"
+  data_json = "{\"foo\": \"bar\"}"
+}
+
+data "vault_kv_secrets_list" "all" {
+  path       = "${vault_mount.kv.path}"
+  depends_on = ["vault_generic_secret.secret"]
+}
+
+data "vault_kv_secrets_list" "filtered" {
+  path       = "${vault_mount.kv.path}"
+  prefix     = "team/b"
+  depends_on = ["vault_generic_secret.secret"]
+}
+`, mount, version)
+}
@@ -0,0 +1,70 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceLDAPDynamicCredentials_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("tf-test")
+	url, binddn, bindpass := getTestLDAPCreds(t)
+	// the base DN the dynamic entries are created under, e.g.
+	// ou=users,dc=example,dc=org
+	userdn := os.Getenv("LDAP_USERDN")
+	if userdn == "" {
+		t.Skip("LDAP_USERDN not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceLDAPDynamicCredentialsConfig_basic(backend, url, binddn, bindpass, name, userdn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_ldap_dynamic_credentials.test", "username"),
+					resource.TestCheckResourceAttrSet("data.vault_ldap_dynamic_credentials.test", "password"),
+					resource.TestCheckResourceAttr("data.vault_ldap_dynamic_credentials.test", "distinguished_names.#", "1"),
+					resource.TestCheckResourceAttrSet("data.vault_ldap_dynamic_credentials.test", "lease_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceLDAPDynamicCredentialsConfig_basic(backend, url, binddn, bindpass, name, userdn string) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path     = "%s"
+  url      = "%s"
+  binddn   = "%s"
+  bindpass = "%s"
+}
+
+resource "vault_ldap_secret_backend_dynamic_role" "test" {
+  backend       = "${vault_ldap_secret_backend.test.path}"
+  name          = "%s"
+  creation_ldif = <<EOT
+dn: cn={{.Username}},%s
+objectClass: person
+objectClass: top
+cn: {{.Username}}
+sn: {{.Username}}
+userPassword: {{.Password}}
+EOT
+  deletion_ldif = <<EOT
+dn: cn={{.Username}},%s
+changetype: delete
+EOT
+}
+
+data "vault_ldap_dynamic_credentials" "test" {
+  backend = "${vault_ldap_secret_backend.test.path}"
+  role    = "${vault_ldap_secret_backend_dynamic_role.test.name}"
+}`, backend, url, binddn, bindpass, name, userdn, userdn)
+}
@@ -0,0 +1,97 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func ldapStaticCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: ldapStaticCredentialsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "LDAP Secret Backend to read credentials from.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "LDAP Secret Backend static role to read credentials from.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The username of the LDAP entry.",
+			},
+			"dn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The distinguished name of the LDAP entry.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The current password of the LDAP entry.",
+			},
+			"last_password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password of the LDAP entry before the last rotation.",
+			},
+			"last_vault_rotation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time Vault last rotated the password.",
+			},
+			"rotation_period": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "How often in seconds Vault rotates the password.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of seconds until the next rotation.",
+			},
+		},
+	}
+}
+
+func ldapStaticCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	role := d.Get("role").(string)
+	path := backend + "/static-cred/" + role
+
+	log.Printf("[DEBUG] Reading %q from Vault", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read %q from Vault", path)
+
+	if secret == nil {
+		return fmt.Errorf("no static role found at path %q", path)
+	}
+
+	// static credentials aren't leased, the path is all that identifies them.
+	d.SetId(path)
+	d.Set("username", secret.Data["username"])
+	d.Set("dn", secret.Data["dn"])
+	d.Set("password", secret.Data["password"])
+	d.Set("last_password", secret.Data["last_password"])
+	d.Set("last_vault_rotation", secret.Data["last_vault_rotation"])
+	d.Set("rotation_period", secret.Data["rotation_period"])
+	d.Set("ttl", secret.Data["ttl"])
+
+	return nil
+}
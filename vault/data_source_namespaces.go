@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func namespacesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: namespacesDataSourceRead,
+
+		Schema: withListFilterSchema(map[string]*schema.Schema{
+			"paths": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The paths of the child namespaces of the provider's namespace.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		}),
+	}
+}
+
+func namespacesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultEnterprise(client, "0.11.0", "vault_namespaces"); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Listing namespaces")
+	keys, err := listFilteredKeys(client, "sys/namespaces", false, d)
+	if err != nil {
+		return fmt.Errorf("error listing namespaces: %s", err)
+	}
+	log.Printf("[DEBUG] Listed %d namespaces", len(keys))
+
+	paths := make([]string, 0, len(keys))
+	for _, k := range keys {
+		paths = append(paths, strings.TrimSuffix(k, "/"))
+	}
+
+	d.SetId("sys/namespaces")
+	if err := d.Set("paths", paths); err != nil {
+		return fmt.Errorf("error setting paths in state: %s", err)
+	}
+
+	return nil
+}
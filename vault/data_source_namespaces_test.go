@@ -0,0 +1,50 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourceNamespaces_basic(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("tf-test")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnterprisePreCheck(t)
+			client, err := testutil.NewClient()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := client.Logical().Write("sys/namespaces/"+namespace, nil); err != nil {
+				t.Fatal(err)
+			}
+		},
+		CheckDestroy: func(*terraform.State) error {
+			client, err := testutil.NewClient()
+			if err != nil {
+				return err
+			}
+			_, err = client.Logical().Delete("sys/namespaces/" + namespace)
+			return err
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "vault_namespaces" "test" {
+  prefix = "%s"
+}
+`, namespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_namespaces.test", "paths.#", "1"),
+					resource.TestCheckResourceAttr("data.vault_namespaces.test", "paths.0", namespace),
+				),
+			},
+		},
+	})
+}
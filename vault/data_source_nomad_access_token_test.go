@@ -0,0 +1,50 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceNomadAccessToken_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-nomad")
+	name := acctest.RandomWithPrefix("tf-test")
+	address, token := getTestNomadCreds(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNomadAccessTokenConfig_basic(backend, address, token, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_nomad_access_token.test", "accessor_id"),
+					resource.TestCheckResourceAttrSet("data.vault_nomad_access_token.test", "secret_id"),
+					resource.TestCheckResourceAttrSet("data.vault_nomad_access_token.test", "lease_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNomadAccessTokenConfig_basic(backend, address, token, name string) string {
+	return fmt.Sprintf(`
+resource "vault_nomad_secret_backend" "test" {
+  path    = "%s"
+  address = "%s"
+  token   = "%s"
+}
+
+resource "vault_nomad_secret_backend_role" "test" {
+  backend = "${vault_nomad_secret_backend.test.path}"
+  name    = "%s"
+  type    = "management"
+}
+
+data "vault_nomad_access_token" "test" {
+  backend = "${vault_nomad_secret_backend.test.path}"
+  role    = "${vault_nomad_secret_backend_role.test.name}"
+}`, backend, address, token, name)
+}
@@ -0,0 +1,112 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendIssuerDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: pkiSecretBackendIssuerDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend the issuer belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Reference to the issuer, either its name or ID, or \"default\".",
+			},
+			"issuer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the issuer.",
+			},
+			"issuer_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the issuer.",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the key used by the issuer.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CA certificate of the issuer.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CA chain of the issuer, starting with its own certificate.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"leaf_not_after_behavior": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Behavior of leaf certificates issued with a validity past the issuer's.",
+			},
+			"usage": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Comma-separated list of allowed usages for the issuer.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendIssuerDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	issuerRef := d.Get("issuer_ref").(string)
+
+	path := pkiSecretBackendIssuerPath(backend, issuerRef)
+
+	log.Printf("[DEBUG] Reading PKI secret backend issuer %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading PKI secret backend issuer %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read PKI secret backend issuer %q", path)
+	if resp == nil {
+		return fmt.Errorf("PKI secret backend issuer %q not found", path)
+	}
+
+	d.SetId(pkiSecretBackendIssuerPath(backend, fmt.Sprintf("%s", resp.Data["issuer_id"])))
+	for _, k := range []string{"issuer_id", "issuer_name", "key_id", "certificate", "leaf_not_after_behavior"} {
+		d.Set(k, resp.Data[k])
+	}
+
+	switch v := resp.Data["usage"].(type) {
+	case string:
+		d.Set("usage", v)
+	case []interface{}:
+		d.Set("usage", strings.Join(jsonStringArrayToStringArray(v), ","))
+	}
+
+	caChain := []string{}
+	if v, ok := resp.Data["ca_chain"].([]interface{}); ok {
+		caChain = jsonStringArrayToStringArray(v)
+	}
+	if err := d.Set("ca_chain", caChain); err != nil {
+		return fmt.Errorf("error setting ca_chain in state: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendIssuerDataSource_basic(t *testing.T) {
+	rootPath := "pki-root-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPkiSecretBackendRootMount(t, rootPath)
+		},
+		CheckDestroy: testAccPkiSecretBackendRootUnmount(rootPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendIssuerDataSourceConfig_basic(rootPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_pki_secret_backend_issuer.test", "backend", rootPath),
+					resource.TestCheckResourceAttrSet("data.vault_pki_secret_backend_issuer.test", "issuer_id"),
+					resource.TestCheckResourceAttrSet("data.vault_pki_secret_backend_issuer.test", "key_id"),
+					resource.TestCheckResourceAttrSet("data.vault_pki_secret_backend_issuer.test", "certificate"),
+					resource.TestCheckResourceAttr("data.vault_pki_secret_backend_issuer.test", "ca_chain.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendIssuerDataSourceConfig_basic(rootPath string) string {
+	return fmt.Sprintf(`
+data "vault_pki_secret_backend_issuer" "test" {
+  backend    = "%s"
+  issuer_ref = "default"
+}`, rootPath)
+}
@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendIssuersDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: pkiSecretBackendIssuersDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend to list the issuers of.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the issuers on the backend.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"key_info": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of issuer IDs to their names.",
+			},
+			"key_info_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON-encoded details of each issuer, keyed by issuer ID.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendIssuersDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+
+	path := backend + "/issuers"
+
+	log.Printf("[DEBUG] Listing PKI secret backend issuers on %q", backend)
+	resp, err := client.Logical().List(path)
+	if err != nil {
+		return fmt.Errorf("error listing PKI secret backend issuers on %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Listed PKI secret backend issuers on %q", backend)
+
+	d.SetId(path)
+
+	// a backend without any issuer lists as not found.
+	keys := []string{}
+	keyInfo := map[string]interface{}{}
+	keyInfoJSON := "{}"
+	if resp != nil {
+		if v, ok := resp.Data["keys"].([]interface{}); ok {
+			keys = jsonStringArrayToStringArray(v)
+		}
+		if v, ok := resp.Data["key_info"].(map[string]interface{}); ok {
+			for id, info := range v {
+				if m, ok := info.(map[string]interface{}); ok {
+					keyInfo[id] = fmt.Sprintf("%s", m["issuer_name"])
+				}
+			}
+			b, err := json.Marshal(v)
+			if err != nil {
+				return fmt.Errorf("error marshaling key_info of PKI secret backend issuers on %q: %s", backend, err)
+			}
+			keyInfoJSON = string(b)
+		}
+	}
+
+	if err := d.Set("keys", keys); err != nil {
+		return fmt.Errorf("error setting keys in state: %s", err)
+	}
+	if err := d.Set("key_info", keyInfo); err != nil {
+		return fmt.Errorf("error setting key_info in state: %s", err)
+	}
+	d.Set("key_info_json", keyInfoJSON)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendIssuersDataSource_basic(t *testing.T) {
+	rootPath := "pki-root-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPkiSecretBackendRootMount(t, rootPath)
+		},
+		CheckDestroy: testAccPkiSecretBackendRootUnmount(rootPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendIssuersDataSourceConfig_basic(rootPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_pki_secret_backend_issuers.test", "backend", rootPath),
+					resource.TestCheckResourceAttr("data.vault_pki_secret_backend_issuers.test", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.vault_pki_secret_backend_issuers.test", "key_info.%", "1"),
+					resource.TestCheckResourceAttrSet("data.vault_pki_secret_backend_issuers.test", "key_info_json"),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendIssuersDataSourceConfig_basic(rootPath string) string {
+	return fmt.Sprintf(`
+data "vault_pki_secret_backend_issuers" "test" {
+  backend = "%s"
+}`, rootPath)
+}
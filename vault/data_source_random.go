@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func randomDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: randomDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path of a Transit Secret Backend to generate the bytes with, instead of sys/tools.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      32,
+				Description:  "The number of random bytes to generate.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "base64",
+				Description:  "The output encoding. Must be either \"base64\" or \"hex\".",
+				ValidateFunc: validation.StringInSlice([]string{"base64", "hex"}, false),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "platform",
+				Description:  "The source of the random bytes. Must be one of \"platform\", \"seal\" or \"all\".",
+				ValidateFunc: validation.StringInSlice([]string{"platform", "seal", "all"}, false),
+			},
+			"random_bytes": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The encoded random bytes returned from Vault.",
+			},
+		},
+	}
+}
+
+func randomDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := "sys/tools/random"
+	if v, ok := d.GetOk("backend"); ok {
+		path = strings.Trim(v.(string), "/") + "/random"
+	}
+	path = path + "/" + d.Get("source").(string) + "/" + strconv.Itoa(d.Get("bytes").(int))
+
+	data := map[string]interface{}{
+		"format": d.Get("format").(string),
+	}
+
+	log.Printf("[DEBUG] Generating random bytes from %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating random bytes from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Generated random bytes from %q", path)
+
+	d.SetId(path)
+	d.Set("random_bytes", resp.Data["random_bytes"])
+
+	return nil
+}
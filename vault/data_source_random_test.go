@@ -0,0 +1,44 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccRandomDataSource_basic(t *testing.T) {
+	backend := "transit-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRandomDataSourceConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_random.tools", "random_bytes", regexp.MustCompile("^[A-Za-z0-9+/]{43}=$")),
+					resource.TestMatchResourceAttr("data.vault_random.transit", "random_bytes", regexp.MustCompile("^[0-9a-f]{32}$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccRandomDataSourceConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transit"
+  path = "%s"
+}
+
+data "vault_random" "tools" {}
+
+data "vault_random" "transit" {
+  backend = "${vault_mount.test.path}"
+  bytes   = 16
+  format  = "hex"
+}`, backend)
+}
@@ -0,0 +1,123 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func sshSecretBackendSignDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: sshSecretBackendSignDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the SSH Secret Backend to sign the key with.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the role to sign the key against.",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The SSH public key to sign.",
+			},
+			"cert_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "user",
+				Description:  "The type of certificate to create. Must be either \"user\" or \"host\".",
+				ValidateFunc: validation.StringInSlice([]string{"user", "host"}, false),
+			},
+			"valid_principals": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The usernames or hostnames the certificate is valid for.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The key ID of the certificate, if allowed by the role.",
+			},
+			"ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The requested TTL of the certificate.",
+				ValidateFunc: validateDuration,
+			},
+			"critical_options": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Critical options the certificate should be signed with.",
+			},
+			"extensions": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Extensions the certificate should be signed with.",
+			},
+			"signed_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The signed SSH certificate.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the signed certificate.",
+			},
+		},
+	}
+}
+
+func sshSecretBackendSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	name := d.Get("name").(string)
+
+	path := backend + "/sign/" + strings.Trim(name, "/")
+
+	data := map[string]interface{}{
+		"public_key":       d.Get("public_key").(string),
+		"cert_type":        d.Get("cert_type").(string),
+		"valid_principals": strings.Join(toStringArray(d.Get("valid_principals").([]interface{})), ","),
+	}
+	for _, k := range []string{"key_id", "ttl"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	for _, k := range []string{"critical_options", "extensions"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(map[string]interface{})
+		}
+	}
+
+	log.Printf("[DEBUG] Signing SSH key with role %q on SSH secret backend %q", name, backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error signing SSH key with role %q on SSH secret backend %q: %s", name, backend, err)
+	}
+	log.Printf("[DEBUG] Signed SSH key with role %q on SSH secret backend %q", name, backend)
+
+	d.SetId(fmt.Sprintf("%s/%s", path, resp.Data["serial_number"]))
+	d.Set("signed_key", resp.Data["signed_key"])
+	d.Set("serial_number", resp.Data["serial_number"])
+
+	return nil
+}
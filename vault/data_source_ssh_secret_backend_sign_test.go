@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testSSHSecretBackendSignPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIPAwDFMormqg4gG8Ly2PiU0Gu+aPG3PZ/xmF3RdkXWfd test@terraform-vault-provider.local"
+
+func TestAccSSHSecretBackendSignDataSource_basic(t *testing.T) {
+	backend := "ssh-" + acctest.RandString(10)
+	name := "role-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendSignDataSourceConfig_basic(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_ssh_secret_backend_sign.test", "signed_key", regexp.MustCompile("^ssh-ed25519-cert-v01@openssh.com ")),
+					resource.TestCheckResourceAttrSet("data.vault_ssh_secret_backend_sign.test", "serial_number"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSSHSecretBackendSignDataSourceConfig_basic(backend string, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "ssh"
+  path = "%s"
+}
+
+resource "vault_ssh_secret_backend_ca" "test" {
+  backend              = "${vault_mount.test.path}"
+  generate_signing_key = true
+}
+
+resource "vault_ssh_secret_backend_role" "test" {
+  backend                 = "${vault_ssh_secret_backend_ca.test.backend}"
+  name                    = "%s"
+  key_type                = "ca"
+  allow_user_certificates = true
+  allowed_users           = "ubuntu"
+}
+
+data "vault_ssh_secret_backend_sign" "test" {
+  backend          = "${vault_mount.test.path}"
+  name             = "${vault_ssh_secret_backend_role.test.name}"
+  public_key       = "%s"
+  valid_principals = ["ubuntu"]
+}`, backend, name, testSSHSecretBackendSignPublicKey)
+}
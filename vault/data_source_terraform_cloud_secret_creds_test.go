@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceTerraformCloudSecretCreds_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-terraform-cloud")
+	name := acctest.RandomWithPrefix("tf-test")
+	token, organization := getTestTFCCreds(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTerraformCloudSecretCredsConfig_basic(backend, token, name, organization),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_terraform_cloud_secret_creds.test", "token"),
+					resource.TestCheckResourceAttrSet("data.vault_terraform_cloud_secret_creds.test", "token_id"),
+					resource.TestCheckResourceAttr("data.vault_terraform_cloud_secret_creds.test", "organization", organization),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTerraformCloudSecretCredsConfig_basic(backend, token, name, organization string) string {
+	return fmt.Sprintf(`
+resource "vault_terraform_cloud_secret_backend" "test" {
+  path  = "%s"
+  token = "%s"
+}
+
+resource "vault_terraform_cloud_secret_role" "test" {
+  backend      = "${vault_terraform_cloud_secret_backend.test.path}"
+  name         = "%s"
+  organization = "%s"
+}
+
+data "vault_terraform_cloud_secret_creds" "test" {
+  backend = "${vault_terraform_cloud_secret_backend.test.path}"
+  role    = "${vault_terraform_cloud_secret_role.test.name}"
+}`, backend, token, name, organization)
+}
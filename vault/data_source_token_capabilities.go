@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func tokenCapabilitiesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: tokenCapabilitiesDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"paths": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The paths to read the capabilities of the provider's token on.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"capabilities": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of the paths to the comma-separated capabilities of the token on them.",
+			},
+		},
+	}
+}
+
+func tokenCapabilitiesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	var paths []string
+	for _, p := range d.Get("paths").(*schema.Set).List() {
+		paths = append(paths, p.(string))
+	}
+	sort.Strings(paths)
+
+	capabilities, err := tokenCapabilities(client, paths)
+	if err != nil {
+		return err
+	}
+
+	result := map[string]interface{}{}
+	for path, caps := range capabilities {
+		result[path] = strings.Join(caps, ",")
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(paths, ","))))
+	if err := d.Set("capabilities", result); err != nil {
+		return fmt.Errorf("error setting capabilities in state: %s", err)
+	}
+
+	return nil
+}
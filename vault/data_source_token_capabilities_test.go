@@ -0,0 +1,27 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceTokenCapabilities_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_token_capabilities" "test" {
+  paths = ["sys/mounts", "secret/data/app"]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_token_capabilities.test", "capabilities.%", "2"),
+					resource.TestCheckResourceAttr("data.vault_token_capabilities.test", "capabilities.sys/mounts", "root"),
+				),
+			},
+		},
+	})
+}
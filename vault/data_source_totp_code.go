@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func totpCodeDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: totpCodeDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "TOTP Secret Backend to generate the code from.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to generate the code for.",
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The current code of the key.",
+			},
+		},
+	}
+}
+
+func totpCodeDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := totpSecretCodePath(d.Get("backend").(string), d.Get("name").(string))
+
+	log.Printf("[DEBUG] Reading %q from Vault", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read %q from Vault", path)
+
+	if secret == nil {
+		return fmt.Errorf("no key found at path %q", path)
+	}
+
+	d.SetId(path)
+	d.Set("code", secret.Data["code"])
+
+	return nil
+}
+
+func totpSecretCodePath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/code/" + strings.Trim(name, "/")
+}
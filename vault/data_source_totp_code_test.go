@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceTOTPCode_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+	name := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTOTPCodeConfig_basic(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_totp_code.test", "code", regexp.MustCompile("^[0-9]{6}$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTOTPCodeConfig_basic(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  backend      = "${vault_mount.test.path}"
+  name         = "%s"
+  generate     = true
+  issuer       = "Vault"
+  account_name = "test@example.com"
+}
+
+data "vault_totp_code" "test" {
+  backend = "${vault_mount.test.path}"
+  name    = "${vault_totp_secret_backend_key.test.name}"
+}`, backend, name)
+}
@@ -0,0 +1,63 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func totpCodeValidationDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: totpCodeValidationDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "TOTP Secret Backend to validate the code with.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to validate the code against.",
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The code to validate.",
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the code is valid.",
+			},
+		},
+	}
+}
+
+func totpCodeValidationDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := totpSecretCodePath(d.Get("backend").(string), d.Get("name").(string))
+
+	log.Printf("[DEBUG] Validating TOTP code at %q", path)
+	secret, err := client.Logical().Write(path, map[string]interface{}{
+		"code": d.Get("code").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("error validating TOTP code at %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Validated TOTP code at %q", path)
+
+	if secret == nil {
+		return fmt.Errorf("no key found at path %q", path)
+	}
+
+	d.SetId(path)
+	d.Set("valid", secret.Data["valid"])
+
+	return nil
+}
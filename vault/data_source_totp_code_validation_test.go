@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceTOTPCodeValidation_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+	name := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTOTPCodeValidationConfig_basic(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_totp_code_validation.invalid", "valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+// a valid code is consumed when validated, so validating it again on the
+// refresh after apply would fail; only invalid codes can be tested here.
+func testAccDataSourceTOTPCodeValidationConfig_basic(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  backend      = "${vault_mount.test.path}"
+  name         = "%s"
+  generate     = true
+  issuer       = "Vault"
+  account_name = "test@example.com"
+}
+
+data "vault_totp_code_validation" "invalid" {
+  backend = "${vault_mount.test.path}"
+  name    = "${vault_totp_secret_backend_key.test.name}"
+  code    = "000000"
+}`, backend, name)
+}
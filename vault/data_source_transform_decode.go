@@ -0,0 +1,110 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func transformDecodeDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transformDecodeDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path the transform secret backend is mounted at.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the role to decode with.",
+			},
+			"value": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The value to decode.",
+				ConflictsWith: []string{"batch_input"},
+			},
+			"transformation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The transformation to use, required if the role has more than one.",
+			},
+			"tweak": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The tweak value, required by fpe transformations with a supplied tweak source.",
+			},
+			"decode_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the template decode format to use. Requires Vault 1.9 or later.",
+			},
+			"batch_input": transformBatchInputSchema(map[string]*schema.Schema{
+				"decode_format": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The name of the template decode format to use.",
+				},
+			}),
+			"decoded_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The decoded value.",
+			},
+			"batch_results": transformBatchResultsSchema("decoded_value"),
+		},
+	}
+}
+
+func transformDecodeDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultEnterprise(client, "1.4.0", "vault_transform_decode"); err != nil {
+		return err
+	}
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	role := d.Get("role_name").(string)
+
+	path := backend + "/decode/" + strings.Trim(role, "/")
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("batch_input"); ok {
+		data["batch_input"] = transformExpandBatchInput(v.([]interface{}))
+	} else {
+		data["value"] = d.Get("value").(string)
+	}
+	for _, k := range []string{"transformation", "tweak"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("decode_format"); ok {
+		path = path + "/" + v.(string)
+	}
+
+	log.Printf("[DEBUG] Decoding data with role %q on transform secret backend %q", role, backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error decoding data with role %q on transform secret backend %q: %s", role, backend, err)
+	}
+	log.Printf("[DEBUG] Decoded data with role %q on transform secret backend %q", role, backend)
+
+	d.SetId(path)
+	d.Set("decoded_value", resp.Data["decoded_value"])
+	if err := d.Set("batch_results", transformFlattenBatchResults(resp.Data["batch_results"], "decoded_value")); err != nil {
+		return fmt.Errorf("error setting batch_results in state: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTransformDecodeDataSource_basic(t *testing.T) {
+	backend := "transform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t); testAccEnterprisePreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformDecodeDataSourceConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_transform_decode.single", "decoded_value", "1111-2222-3333-4444"),
+					resource.TestCheckResourceAttr("data.vault_transform_decode.batch", "batch_results.#", "1"),
+					resource.TestCheckResourceAttr("data.vault_transform_decode.batch", "batch_results.0.decoded_value", "1111-2222-3333-4444"),
+					resource.TestCheckResourceAttr("data.vault_transform_decode.batch", "batch_results.0.reference", "first"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransformDecodeDataSourceConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transform"
+  path = "%s"
+}
+
+resource "vault_transform_transformation" "test" {
+  backend       = "${vault_mount.test.path}"
+  name          = "ccn"
+  type          = "fpe"
+  template      = "builtin/creditcardnumber"
+  tweak_source  = "internal"
+  allowed_roles = ["payments"]
+}
+
+resource "vault_transform_role" "test" {
+  backend         = "${vault_mount.test.path}"
+  name            = "payments"
+  transformations = ["${vault_transform_transformation.test.name}"]
+}
+
+data "vault_transform_encode" "test" {
+  backend   = "${vault_mount.test.path}"
+  role_name = "${vault_transform_role.test.name}"
+  value     = "1111-2222-3333-4444"
+}
+
+data "vault_transform_decode" "single" {
+  backend   = "${vault_mount.test.path}"
+  role_name = "${vault_transform_role.test.name}"
+  value     = "${data.vault_transform_encode.test.encoded_value}"
+}
+
+data "vault_transform_decode" "batch" {
+  backend   = "${vault_mount.test.path}"
+  role_name = "${vault_transform_role.test.name}"
+
+  batch_input {
+    value     = "${data.vault_transform_encode.test.encoded_value}"
+    reference = "first"
+  }
+}`, backend)
+}
@@ -0,0 +1,199 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// transformBatchInputSchema returns the schema of the batch_input blocks
+// shared by the encode and decode data sources.
+func transformBatchInputSchema(extra map[string]*schema.Schema) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"value": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The value to transform.",
+		},
+		"transformation": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The transformation to use, required if the role has more than one.",
+		},
+		"tweak": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The tweak value, required by fpe transformations with a supplied tweak source.",
+		},
+		"reference": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "A user-supplied string returned unchanged with the matching result.",
+		},
+	}
+	for k, v := range extra {
+		s[k] = v
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "A list of items to transform in a single request.",
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+// transformBatchResultsSchema returns the schema of the batch_results
+// attribute, each result holding valueKey and the input's reference.
+func transformBatchResultsSchema(valueKey string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The results of the batch_input items, in order.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				valueKey: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"reference": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// transformExpandBatchInput converts batch_input blocks into the request
+// format, leaving out unset keys.
+func transformExpandBatchInput(raw []interface{}) []map[string]interface{} {
+	items := make([]map[string]interface{}, 0, len(raw))
+	for _, r := range raw {
+		item := map[string]interface{}{}
+		for k, v := range r.(map[string]interface{}) {
+			if s, ok := v.(string); ok && s != "" {
+				item[k] = s
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// transformFlattenBatchResults converts batch_results returned by Vault into
+// blocks holding valueKey and the reference.
+func transformFlattenBatchResults(raw interface{}, valueKey string) []interface{} {
+	results, ok := raw.([]interface{})
+	if !ok {
+		return nil
+	}
+	flattened := make([]interface{}, 0, len(results))
+	for _, r := range results {
+		result, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		item := map[string]interface{}{
+			valueKey:    "",
+			"reference": "",
+		}
+		if v, ok := result[valueKey].(string); ok {
+			item[valueKey] = v
+		}
+		if v, ok := result["reference"].(string); ok {
+			item["reference"] = v
+		}
+		flattened = append(flattened, item)
+	}
+	return flattened
+}
+
+func transformEncodeDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transformEncodeDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path the transform secret backend is mounted at.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the role to encode with.",
+			},
+			"value": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The value to encode.",
+				ConflictsWith: []string{"batch_input"},
+			},
+			"transformation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The transformation to use, required if the role has more than one.",
+			},
+			"tweak": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The tweak value, required by fpe transformations with a supplied tweak source.",
+			},
+			"batch_input": transformBatchInputSchema(nil),
+			"encoded_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The encoded value.",
+			},
+			"batch_results": transformBatchResultsSchema("encoded_value"),
+		},
+	}
+}
+
+func transformEncodeDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultEnterprise(client, "1.4.0", "vault_transform_encode"); err != nil {
+		return err
+	}
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	role := d.Get("role_name").(string)
+
+	path := backend + "/encode/" + strings.Trim(role, "/")
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("batch_input"); ok {
+		data["batch_input"] = transformExpandBatchInput(v.([]interface{}))
+	} else {
+		data["value"] = d.Get("value").(string)
+	}
+	for _, k := range []string{"transformation", "tweak"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+
+	log.Printf("[DEBUG] Encoding data with role %q on transform secret backend %q", role, backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error encoding data with role %q on transform secret backend %q: %s", role, backend, err)
+	}
+	log.Printf("[DEBUG] Encoded data with role %q on transform secret backend %q", role, backend)
+
+	d.SetId(path)
+	d.Set("encoded_value", resp.Data["encoded_value"])
+	if err := d.Set("batch_results", transformFlattenBatchResults(resp.Data["batch_results"], "encoded_value")); err != nil {
+		return fmt.Errorf("error setting batch_results in state: %s", err)
+	}
+
+	return nil
+}
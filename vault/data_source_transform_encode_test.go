@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTransformEncodeDataSource_basic(t *testing.T) {
+	backend := "transform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t); testAccEnterprisePreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformEncodeDataSourceConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transform_encode.single", "encoded_value", regexp.MustCompile(`^\d{4}-\d{4}-\d{4}-\d{4}$`)),
+					resource.TestCheckResourceAttr("data.vault_transform_encode.batch", "batch_results.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_transform_encode.batch", "batch_results.1.reference", "second"),
+					resource.TestMatchResourceAttr("data.vault_transform_encode.batch", "batch_results.1.encoded_value", regexp.MustCompile(`^\d{4}-\d{4}-\d{4}-\d{4}$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransformEncodeDataSourceConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transform"
+  path = "%s"
+}
+
+resource "vault_transform_transformation" "test" {
+  backend       = "${vault_mount.test.path}"
+  name          = "ccn"
+  type          = "fpe"
+  template      = "builtin/creditcardnumber"
+  tweak_source  = "internal"
+  allowed_roles = ["payments"]
+}
+
+resource "vault_transform_role" "test" {
+  backend         = "${vault_mount.test.path}"
+  name            = "payments"
+  transformations = ["${vault_transform_transformation.test.name}"]
+}
+
+data "vault_transform_encode" "single" {
+  backend   = "${vault_mount.test.path}"
+  role_name = "${vault_transform_role.test.name}"
+  value     = "1111-2222-3333-4444"
+}
+
+data "vault_transform_encode" "batch" {
+  backend   = "${vault_mount.test.path}"
+  role_name = "${vault_transform_role.test.name}"
+
+  batch_input {
+    value     = "1111-2222-3333-4444"
+    reference = "first"
+  }
+
+  batch_input {
+    value     = "5555-6666-7777-8888"
+    reference = "second"
+  }
+}`, backend)
+}
@@ -0,0 +1,108 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitDatakeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitDatakeyDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the Transit Secret Backend the key belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the encryption key to wrap the data key with.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "wrapped",
+				Description:  "Whether the plaintext data key is returned as well. Must be either \"wrapped\" or \"plaintext\".",
+				ValidateFunc: validation.StringInSlice([]string{"wrapped", "plaintext"}, false),
+			},
+			"bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     256,
+				Description: "The number of bits in the data key. Must be one of 128, 256 or 512.",
+			},
+			"context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Context for key derivation, required if the key is derived.",
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The version of the key to wrap the data key with, the latest one if unset.",
+			},
+			"ciphertext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The data key wrapped by the encryption key.",
+			},
+			"plaintext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64-encoded data key. Only returned if type is \"plaintext\".",
+			},
+			"key_version_used": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the key the data key was wrapped with.",
+			},
+		},
+	}
+}
+
+func transitDatakeyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	key := d.Get("key").(string)
+	keyType := d.Get("type").(string)
+
+	path := backend + "/datakey/" + keyType + "/" + strings.Trim(key, "/")
+
+	data := map[string]interface{}{
+		"bits": d.Get("bits").(int),
+	}
+	if v, ok := d.GetOk("context"); ok {
+		data["context"] = base64.StdEncoding.EncodeToString([]byte(v.(string)))
+	}
+	if v, ok := d.GetOk("key_version"); ok {
+		data["key_version"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Generating data key with key %q on transit secret backend %q", key, backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating data key with key %q on transit secret backend %q: %s", key, backend, err)
+	}
+	log.Printf("[DEBUG] Generated data key with key %q on transit secret backend %q", key, backend)
+
+	d.SetId(path)
+	d.Set("ciphertext", resp.Data["ciphertext"])
+	d.Set("plaintext", resp.Data["plaintext"])
+	d.Set("key_version_used", resp.Data["key_version"])
+
+	return nil
+}
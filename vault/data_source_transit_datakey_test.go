@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTransitDatakeyDataSource_basic(t *testing.T) {
+	backend := "transit-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitDatakeyDataSourceConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_datakey.wrapped", "ciphertext", regexp.MustCompile("^vault:v1:")),
+					resource.TestCheckResourceAttr("data.vault_transit_datakey.wrapped", "plaintext", ""),
+					resource.TestCheckResourceAttr("data.vault_transit_datakey.wrapped", "key_version_used", "1"),
+					resource.TestMatchResourceAttr("data.vault_transit_datakey.plaintext", "ciphertext", regexp.MustCompile("^vault:v1:")),
+					resource.TestMatchResourceAttr("data.vault_transit_datakey.plaintext", "plaintext", regexp.MustCompile("^[A-Za-z0-9+/]{22}==$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitDatakeyDataSourceConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transit"
+  path = "%s"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = "${vault_mount.test.path}"
+  name             = "test"
+  deletion_allowed = true
+}
+
+data "vault_transit_datakey" "wrapped" {
+  backend = "${vault_mount.test.path}"
+  key     = "${vault_transit_secret_backend_key.test.name}"
+}
+
+data "vault_transit_datakey" "plaintext" {
+  backend = "${vault_mount.test.path}"
+  key     = "${vault_transit_secret_backend_key.test.name}"
+  type    = "plaintext"
+  bits    = 128
+}`, backend)
+}
@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitDecryptDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitDecryptDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the Transit Secret Backend the key belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the decryption key to use.",
+			},
+			"ciphertext": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Ciphertext to be decrypted.",
+			},
+			"context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Context for key derivation, required if the key is derived.",
+			},
+			"plaintext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Decrypted plaintext returned from Vault.",
+			},
+		},
+	}
+}
+
+func transitDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	key := d.Get("key").(string)
+
+	path := backend + "/decrypt/" + strings.Trim(key, "/")
+
+	data := map[string]interface{}{
+		"ciphertext": d.Get("ciphertext").(string),
+	}
+	if v, ok := d.GetOk("context"); ok {
+		data["context"] = base64.StdEncoding.EncodeToString([]byte(v.(string)))
+	}
+
+	log.Printf("[DEBUG] Decrypting data with key %q on transit secret backend %q", key, backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error decrypting data with key %q on transit secret backend %q: %s", key, backend, err)
+	}
+	log.Printf("[DEBUG] Decrypted data with key %q on transit secret backend %q", key, backend)
+
+	plaintext, err := base64.StdEncoding.DecodeString(fmt.Sprintf("%s", resp.Data["plaintext"]))
+	if err != nil {
+		return fmt.Errorf("error decoding plaintext returned by key %q on transit secret backend %q: %s", key, backend, err)
+	}
+
+	d.SetId(path)
+	d.Set("plaintext", string(plaintext))
+
+	return nil
+}
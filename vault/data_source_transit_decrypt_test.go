@@ -0,0 +1,55 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTransitDecryptDataSource_basic(t *testing.T) {
+	backend := "transit-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitDecryptDataSourceConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "plaintext", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitDecryptDataSourceConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transit"
+  path = "%s"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = "${vault_mount.test.path}"
+  name             = "test"
+  derived          = true
+  deletion_allowed = true
+}
+
+data "vault_transit_encrypt" "test" {
+  backend   = "${vault_mount.test.path}"
+  key       = "${vault_transit_secret_backend_key.test.name}"
+  plaintext = "foo"
+  context   = "bar"
+}
+
+data "vault_transit_decrypt" "test" {
+  backend    = "${vault_mount.test.path}"
+  key        = "${vault_transit_secret_backend_key.test.name}"
+  ciphertext = "${data.vault_transit_encrypt.test.ciphertext}"
+  context    = "bar"
+}`, backend)
+}
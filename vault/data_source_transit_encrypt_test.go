@@ -0,0 +1,47 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTransitEncryptDataSource_basic(t *testing.T) {
+	backend := "transit-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitEncryptDataSourceConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_encrypt.test", "ciphertext", regexp.MustCompile("^vault:v1:")),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitEncryptDataSourceConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transit"
+  path = "%s"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = "${vault_mount.test.path}"
+  name             = "test"
+  deletion_allowed = true
+}
+
+data "vault_transit_encrypt" "test" {
+  backend   = "${vault_mount.test.path}"
+  key       = "${vault_transit_secret_backend_key.test.name}"
+  plaintext = "foo"
+}`, backend)
+}
@@ -0,0 +1,112 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitExportDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitExportDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the transit secret backend.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the key to export.",
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The type of the exported material, one of encryption-key, signing-key, hmac-key or public-key.",
+				ValidateFunc: validation.StringInSlice([]string{"encryption-key", "signing-key", "hmac-key", "public-key"}, false),
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The version of the key to export, or latest. Defaults to every version.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the key, e.g. aes256-gcm96 or ecdsa-p256.",
+			},
+			"keys": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The exported material of each version of the key.",
+			},
+		},
+	}
+}
+
+func transitExportDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	key := strings.Trim(d.Get("key").(string), "/")
+	keyType := d.Get("key_type").(string)
+
+	// only the public keys of asymmetric keys can be exported from keys
+	// that weren't created as exportable.
+	if keyType == "public-key" {
+		if err := checkVaultVersion(client, "1.15.0", "key_type public-key"); err != nil {
+			return err
+		}
+	} else {
+		keyPath := backend + "/keys/" + key
+		log.Printf("[DEBUG] Reading key %q from transit secret backend %q", key, backend)
+		resp, err := client.Logical().Read(keyPath)
+		if err != nil {
+			return fmt.Errorf("error reading key %q from transit secret backend %q: %s", key, backend, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("key %q not found on transit secret backend %q", key, backend)
+		}
+		log.Printf("[DEBUG] Read key %q from transit secret backend %q", key, backend)
+		if exportable, _ := resp.Data["exportable"].(bool); !exportable {
+			return fmt.Errorf("key %q on transit secret backend %q is not exportable", key, backend)
+		}
+	}
+
+	path := backend + "/export/" + keyType + "/" + key
+	if version := d.Get("version").(string); version != "" {
+		path += "/" + version
+	}
+
+	log.Printf("[DEBUG] Exporting %s of key %q from transit secret backend %q", keyType, key, backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error exporting %s of key %q from transit secret backend %q: %s", keyType, key, backend, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no %s found for key %q on transit secret backend %q", keyType, key, backend)
+	}
+	log.Printf("[DEBUG] Exported %s of key %q from transit secret backend %q", keyType, key, backend)
+
+	keys := map[string]string{}
+	if m, ok := resp.Data["keys"].(map[string]interface{}); ok {
+		for k, v := range m {
+			keys[k] = fmt.Sprint(v)
+		}
+	}
+
+	d.SetId(path)
+	d.Set("type", resp.Data["type"])
+	if err := d.Set("keys", keys); err != nil {
+		return fmt.Errorf("error setting keys in state: %s", err)
+	}
+
+	return nil
+}
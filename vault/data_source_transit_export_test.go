@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTransitExportDataSource_basic(t *testing.T) {
+	backend := "transit-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitExportDataSourceConfig(backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_transit_export.test", "type", "ecdsa-p256"),
+					resource.TestCheckResourceAttr("data.vault_transit_export.test", "keys.%", "1"),
+					resource.TestCheckResourceAttrSet("data.vault_transit_export.test", "keys.1"),
+				),
+			},
+			{
+				Config:      testAccTransitExportDataSourceConfig(backend, false),
+				ExpectError: regexp.MustCompile("is not exportable"),
+			},
+		},
+	})
+}
+
+func testAccTransitExportDataSourceConfig(backend string, exportable bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transit"
+  path = "%s"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = "${vault_mount.test.path}"
+  name             = "test-%t"
+  type             = "ecdsa-p256"
+  exportable       = %t
+  deletion_allowed = true
+}
+
+data "vault_transit_export" "test" {
+  backend  = "${vault_mount.test.path}"
+  key      = "${vault_transit_secret_backend_key.test.name}"
+  key_type = "signing-key"
+  version  = "latest"
+}
+`, backend, exportable, exportable)
+}
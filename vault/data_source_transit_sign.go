@@ -0,0 +1,141 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+// transitSignatureSchema returns the arguments shared by the sign and
+// verify data sources.
+func transitSignatureSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The path of the Transit Secret Backend the key belongs to.",
+			// standardise on no beginning or trailing slashes
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the signing key to use.",
+		},
+		"input": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The base64-encoded input data, or hash of it when prehashed is true.",
+		},
+		"context": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Context for key derivation, required if the key is derived.",
+		},
+		"hash_algorithm": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "sha2-256",
+			Description: "The hash algorithm to use.",
+			ValidateFunc: validation.StringInSlice([]string{
+				"sha1", "sha2-224", "sha2-256", "sha2-384", "sha2-512",
+				"sha3-224", "sha3-256", "sha3-384", "sha3-512", "none",
+			}, false),
+		},
+		"prehashed": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Set to true when the input is already hashed.",
+		},
+		"signature_algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "pss",
+			Description:  "The signature algorithm to use with RSA keys. Must be either \"pss\" or \"pkcs1v15\".",
+			ValidateFunc: validation.StringInSlice([]string{"pss", "pkcs1v15"}, false),
+		},
+		"marshaling_algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "asn1",
+			Description:  "The way in which the signature is marshaled with ECDSA keys. Must be either \"asn1\" or \"jws\".",
+			ValidateFunc: validation.StringInSlice([]string{"asn1", "jws"}, false),
+		},
+		"salt_length": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "auto",
+			Description: "The salt length used to sign with the \"pss\" signature algorithm, either \"auto\", \"hash\" or a number of bytes.",
+		},
+	}
+}
+
+// transitSignatureRequestData builds the request body shared by the sign
+// and verify endpoints.
+func transitSignatureRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"input":                d.Get("input").(string),
+		"hash_algorithm":       d.Get("hash_algorithm").(string),
+		"prehashed":            d.Get("prehashed").(bool),
+		"signature_algorithm":  d.Get("signature_algorithm").(string),
+		"marshaling_algorithm": d.Get("marshaling_algorithm").(string),
+		"salt_length":          d.Get("salt_length").(string),
+	}
+	if v, ok := d.GetOk("context"); ok {
+		data["context"] = base64.StdEncoding.EncodeToString([]byte(v.(string)))
+	}
+	return data
+}
+
+func transitSignDataSource() *schema.Resource {
+	s := transitSignatureSchema()
+	s["key_version"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "The version of the key to sign with, the latest one if unset.",
+	}
+	s["signature"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The signature returned from Vault.",
+	}
+
+	return &schema.Resource{
+		Read:   transitSignDataSourceRead,
+		Schema: s,
+	}
+}
+
+func transitSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	key := d.Get("key").(string)
+
+	path := backend + "/sign/" + strings.Trim(key, "/")
+
+	data := transitSignatureRequestData(d)
+	if v, ok := d.GetOk("key_version"); ok {
+		data["key_version"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Signing data with key %q on transit secret backend %q", key, backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error signing data with key %q on transit secret backend %q: %s", key, backend, err)
+	}
+	log.Printf("[DEBUG] Signed data with key %q on transit secret backend %q", key, backend)
+
+	d.SetId(path)
+	d.Set("signature", resp.Data["signature"])
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTransitSignDataSource_basic(t *testing.T) {
+	backend := "transit-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitSignDataSourceConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_sign.test", "signature", regexp.MustCompile("^vault:v1:")),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitSignDataSourceConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transit"
+  path = "%s"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = "${vault_mount.test.path}"
+  name             = "test"
+  type             = "rsa-2048"
+  deletion_allowed = true
+}
+
+data "vault_transit_sign" "test" {
+  backend             = "${vault_mount.test.path}"
+  key                 = "${vault_transit_secret_backend_key.test.name}"
+  input               = "${base64encode("foo")}"
+  hash_algorithm      = "sha2-512"
+  signature_algorithm = "pkcs1v15"
+}`, backend)
+}
@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitVerifyDataSource() *schema.Resource {
+	s := transitSignatureSchema()
+	s["signature"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The signature to verify.",
+	}
+	s["valid"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the signature is valid for the input.",
+	}
+
+	return &schema.Resource{
+		Read:   transitVerifyDataSourceRead,
+		Schema: s,
+	}
+}
+
+func transitVerifyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	key := d.Get("key").(string)
+
+	path := backend + "/verify/" + strings.Trim(key, "/")
+
+	data := transitSignatureRequestData(d)
+	data["signature"] = d.Get("signature").(string)
+
+	log.Printf("[DEBUG] Verifying signature with key %q on transit secret backend %q", key, backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error verifying signature with key %q on transit secret backend %q: %s", key, backend, err)
+	}
+	log.Printf("[DEBUG] Verified signature with key %q on transit secret backend %q", key, backend)
+
+	d.SetId(path)
+	d.Set("valid", resp.Data["valid"])
+
+	return nil
+}
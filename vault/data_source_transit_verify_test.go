@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTransitVerifyDataSource_basic(t *testing.T) {
+	backend := "transit-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitVerifyDataSourceConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_transit_verify.valid", "valid", "true"),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.invalid", "valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitVerifyDataSourceConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transit"
+  path = "%s"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = "${vault_mount.test.path}"
+  name             = "test"
+  type             = "ecdsa-p256"
+  deletion_allowed = true
+}
+
+data "vault_transit_sign" "test" {
+  backend              = "${vault_mount.test.path}"
+  key                  = "${vault_transit_secret_backend_key.test.name}"
+  input                = "${base64encode("foo")}"
+  marshaling_algorithm = "jws"
+}
+
+data "vault_transit_verify" "valid" {
+  backend              = "${vault_mount.test.path}"
+  key                  = "${vault_transit_secret_backend_key.test.name}"
+  input                = "${base64encode("foo")}"
+  signature            = "${data.vault_transit_sign.test.signature}"
+  marshaling_algorithm = "jws"
+}
+
+data "vault_transit_verify" "invalid" {
+  backend              = "${vault_mount.test.path}"
+  key                  = "${vault_transit_secret_backend_key.test.name}"
+  input                = "${base64encode("bar")}"
+  signature            = "${data.vault_transit_sign.test.signature}"
+  marshaling_algorithm = "jws"
+}`, backend)
+}
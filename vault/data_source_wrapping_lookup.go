@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func wrappingLookupDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: wrappingLookupDataSourceRead,
+
+		Schema: withWrappingCheckSchema(map[string]*schema.Schema{
+			"token": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The wrapping token to look up.",
+			},
+			"creation_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The path of the request that created the wrapping token.",
+			},
+			"creation_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the wrapping token was created.",
+			},
+			"creation_ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The TTL of the wrapping token, in seconds.",
+			},
+		}),
+	}
+}
+
+func wrappingLookupDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Looking up a wrapping token")
+	info, err := lookupWrappingToken(client, d.Get("token").(string))
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Looked up a wrapping token created by %q", info.CreationPath)
+
+	if err := checkWrappingToken(d, info); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(info.CreationPath+info.CreationTime)))
+	d.Set("creation_path", info.CreationPath)
+	d.Set("creation_time", info.CreationTime)
+	d.Set("creation_ttl", info.CreationTTL)
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func wrappingUnwrapDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: wrappingUnwrapDataSourceRead,
+
+		Schema: withWrappingCheckSchema(map[string]*schema.Schema{
+			"token": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The single-use wrapping token to unwrap.",
+			},
+			"data_json": {
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Computed:    true,
+				Description: "JSON-encoded data of the wrapped response.",
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Sensitive:   true,
+				Computed:    true,
+				Description: "Map of strings of the data of the wrapped response.",
+			},
+			"client_token": {
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Computed:    true,
+				Description: "The token of the wrapped response, if it wrapped a login or a token.",
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by vault.",
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds relative to the time in lease_start_time.",
+			},
+			"lease_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease was read, using the clock of the system where Terraform was running",
+			},
+			"lease_renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
+		}),
+	}
+}
+
+func wrappingUnwrapDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	token := d.Get("token").(string)
+
+	// the token can only be unwrapped once, so it is checked before
+	if d.Get("expected_creation_path").(string) != "" || d.Get("max_creation_ttl").(int) > 0 {
+		info, err := lookupWrappingToken(client, token)
+		if err != nil {
+			return err
+		}
+		if err := checkWrappingToken(d, info); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Unwrapping a wrapping token")
+	secret, err := client.Logical().Unwrap(token)
+	if err != nil {
+		return fmt.Errorf("error unwrapping the wrapping token: %s", err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no response found in the wrapping token")
+	}
+	log.Printf("[DEBUG] Unwrapped a wrapping token")
+
+	d.SetId(secret.RequestID)
+
+	jsonDataBytes, _ := json.Marshal(secret.Data)
+	d.Set("data_json", string(jsonDataBytes))
+	d.Set("data", flattenSecretData(secret.Data))
+
+	var clientToken string
+	if secret.Auth != nil {
+		clientToken = secret.Auth.ClientToken
+	}
+	d.Set("client_token", clientToken)
+
+	d.Set("lease_id", secret.LeaseID)
+	d.Set("lease_duration", secret.LeaseDuration)
+	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
+	d.Set("lease_renewable", secret.Renewable)
+	renewLease(client, secret)
+
+	return nil
+}
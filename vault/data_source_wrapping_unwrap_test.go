@@ -0,0 +1,50 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceWrappingUnwrap_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceWrappingUnwrap_config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_wrapping_lookup.test", "creation_path"),
+					resource.TestCheckResourceAttr("data.vault_wrapping_lookup.test", "creation_ttl", "60"),
+					resource.TestCheckResourceAttr("data.vault_wrapping_unwrap.test", "data.zip", "zap"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceWrappingUnwrap_config = `
+resource "vault_generic_secret" "test" {
+  path = "secret/wrapped-handoff"
+  data_json = <<EOT
+{
+  "zip": "zap"
+}
+EOT
+}
+
+data "vault_generic_secret" "test" {
+  path         = "${vault_generic_secret.test.path}"
+  wrapping_ttl = "60s"
+}
+
+data "vault_wrapping_lookup" "test" {
+  token            = "${data.vault_generic_secret.test.wrapping_token}"
+  max_creation_ttl = 60
+}
+
+data "vault_wrapping_unwrap" "test" {
+  token            = "${data.vault_wrapping_lookup.test.token}"
+  max_creation_ttl = 60
+}
+`
@@ -0,0 +1,24 @@
+package vault
+
+import (
+	"net/http"
+)
+
+// headerTransport adds headers to every request. It is wrapped by the
+// logging transport, so that the values of the headers are never logged.
+type headerTransport struct {
+	transport http.RoundTripper
+	headers   http.Header
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.WithContext(req.Context())
+	r.Header = make(http.Header, len(req.Header)+len(t.headers))
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+	for k, v := range t.headers {
+		r.Header[k] = v
+	}
+	return t.transport.RoundTrip(r)
+}
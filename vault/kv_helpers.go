@@ -10,6 +10,13 @@ import (
 )
 
 func versionedSecret(requestedVersion int, path string, client *api.Client) (*api.Secret, error) {
+	return wrappedVersionedSecret(requestedVersion, path, "", client)
+}
+
+// wrappedVersionedSecret reads a secret like versionedSecret, but asks Vault
+// to response-wrap it when wrapTTL isn't empty. The data of a wrapped secret
+// is only available by unwrapping its wrapping token.
+func wrappedVersionedSecret(requestedVersion int, path string, wrapTTL string, client *api.Client) (*api.Secret, error) {
 	mountPath, v2, err := isKVv2(path, client)
 	if err != nil {
 		return nil, err
@@ -30,7 +37,7 @@ func versionedSecret(requestedVersion int, path string, client *api.Client) (*ap
 		}
 	}
 
-	secret, err := kvReadRequest(client, path, versionParam)
+	secret, err := kvReadRequest(client, path, versionParam, wrapTTL)
 
 	if err != nil {
 		return nil, err
@@ -48,8 +55,9 @@ func versionedSecret(requestedVersion int, path string, client *api.Client) (*ap
 	return secret, nil
 }
 
-func kvReadRequest(client *api.Client, path string, params map[string]string) (*api.Secret, error) {
+func kvReadRequest(client *api.Client, path string, params map[string]string, wrapTTL string) (*api.Secret, error) {
 	r := client.NewRequest("GET", "/v1/"+path)
+	r.WrapTTL = wrapTTL
 	for k, v := range params {
 		r.Params.Set(k, v)
 	}
@@ -128,10 +136,17 @@ func kvPreflightVersionRequest(client *api.Client, path string) (string, int, er
 }
 
 func isKVv2(path string, client *api.Client) (string, bool, error) {
+	if mountPath, version, ok := cachedKVVersion(client, path); ok {
+		return mountPath, version == 2, nil
+	}
+
 	mountPath, version, err := kvPreflightVersionRequest(client, path)
 	if err != nil {
 		return "", false, err
 	}
+	if mountPath != "" {
+		setKVVersion(client, mountPath, version)
+	}
 
 	return mountPath, version == 2, nil
 }
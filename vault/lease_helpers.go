@@ -0,0 +1,67 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// leaseRevocation holds the revoke_leases_on_destroy setting of the
+// configured providers. Clients of providers that aren't in it revoke their
+// leases, which is the provider's default.
+var leaseRevocation = struct {
+	sync.Mutex
+	clients map[*api.Client]bool
+}{clients: map[*api.Client]bool{}}
+
+func setRevokeLeasesOnDestroy(client *api.Client, revoke bool) {
+	leaseRevocation.Lock()
+	leaseRevocation.clients[client] = revoke
+	leaseRevocation.Unlock()
+}
+
+// withRevokeLeaseSchema adds the revoke_lease field to the schema of a
+// resource whose creation results in a lease or a token.
+func withRevokeLeaseSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["revoke_lease"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Revoke the lease, or token, when the resource is destroyed or replaced. Defaults to the provider's revoke_leases_on_destroy.",
+	}
+	return s
+}
+
+// leaseRevokeOnDestroy reports whether the lease of the resource is to be
+// revoked when it is destroyed: its revoke_lease field, if set, takes
+// precedence over the setting of the provider.
+func leaseRevokeOnDestroy(d *schema.ResourceData, client *api.Client) bool {
+	if v, ok := d.GetOkExists("revoke_lease"); ok {
+		return v.(bool)
+	}
+
+	leaseRevocation.Lock()
+	defer leaseRevocation.Unlock()
+	if revoke, ok := leaseRevocation.clients[client]; ok {
+		return revoke
+	}
+	return true
+}
+
+// revokeLease revokes the lease recorded in lease_id, if there is one and
+// it is to be revoked on destroy. It reports whether it was revoked.
+func revokeLease(d *schema.ResourceData, client *api.Client) (bool, error) {
+	leaseID := d.Get("lease_id").(string)
+	if leaseID == "" || !leaseRevokeOnDestroy(d, client) {
+		return false, nil
+	}
+
+	log.Printf("[DEBUG] Revoking lease %q", leaseID)
+	if err := client.Sys().Revoke(leaseID); err != nil {
+		return false, fmt.Errorf("error revoking lease %q: %s", leaseID, err)
+	}
+	log.Printf("[DEBUG] Revoked lease %q", leaseID)
+	return true, nil
+}
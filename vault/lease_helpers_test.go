@@ -0,0 +1,32 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestLeaseRevokeOnDestroy(t *testing.T) {
+	s := withRevokeLeaseSchema(map[string]*schema.Schema{})
+
+	defaultClient := &api.Client{}
+	keepingClient := &api.Client{}
+	setRevokeLeasesOnDestroy(keepingClient, false)
+
+	for _, tc := range []struct {
+		client *api.Client
+		raw    map[string]interface{}
+		want   bool
+	}{
+		{defaultClient, map[string]interface{}{}, true},
+		{defaultClient, map[string]interface{}{"revoke_lease": false}, false},
+		{keepingClient, map[string]interface{}{}, false},
+		{keepingClient, map[string]interface{}{"revoke_lease": true}, true},
+	} {
+		d := schema.TestResourceDataRaw(t, s, tc.raw)
+		if got := leaseRevokeOnDestroy(d, tc.client); got != tc.want {
+			t.Errorf("bad revocation for %#v: want %t, got %t", tc.raw, tc.want, got)
+		}
+	}
+}
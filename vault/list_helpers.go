@@ -0,0 +1,124 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+// withListFilterSchema adds the fields used to filter the keys listed by a
+// data source to its schema. The keys of a large folder are narrowed down
+// before they are stored in the state, so that the plans don't hold all of
+// them.
+func withListFilterSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["prefix"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only list the keys starting with this prefix.",
+	}
+	s["regex"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Only list the keys matching this regular expression.",
+		ValidateFunc: validation.ValidateRegexp,
+	}
+	return s
+}
+
+// listFilteredKeys lists the keys at path, keeping those that match the
+// prefix and regex of d. With folders, as for KV secrets, the subfolders in
+// the prefix are appended to path, so that Vault only returns the keys of the
+// deepest folder, and the keys are returned relative to path.
+func listFilteredKeys(client *api.Client, path string, folders bool, d *schema.ResourceData) ([]string, error) {
+	prefix := d.Get("prefix").(string)
+	match, err := listFilter(d)
+	if err != nil {
+		return nil, err
+	}
+
+	folder := ""
+	if i := strings.LastIndex(prefix, "/"); folders && i >= 0 {
+		folder = prefix[:i+1]
+		path = strings.TrimSuffix(path, "/") + "/" + strings.TrimSuffix(folder, "/")
+	}
+
+	resp, err := client.Logical().List(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// a folder without any key lists as not found.
+	keys := []string{}
+	if resp == nil {
+		return keys, nil
+	}
+	v, ok := resp.Data["keys"].([]interface{})
+	if !ok {
+		return keys, nil
+	}
+	for _, key := range jsonStringArrayToStringArray(v) {
+		key = folder + key
+		if !match(key) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// listFilter returns a function that tells whether a key matches the prefix
+// and regex of d.
+func listFilter(d *schema.ResourceData) (func(string) bool, error) {
+	prefix := d.Get("prefix").(string)
+	var re *regexp.Regexp
+	if v := d.Get("regex").(string); v != "" {
+		var err error
+		if re, err = regexp.Compile(v); err != nil {
+			return nil, fmt.Errorf("error compiling regex %q: %s", v, err)
+		}
+	}
+	return func(key string) bool {
+		return strings.HasPrefix(key, prefix) && (re == nil || re.MatchString(key))
+	}, nil
+}
+
+// listIdentityFilteredIDs lists the IDs of the identity entities or groups at
+// path, keeping those whose name matches the prefix and regex of d. The IDs
+// and names are returned in the order of the names.
+func listIdentityFilteredIDs(client *api.Client, path string, d *schema.ResourceData) ([]string, []string, error) {
+	match, err := listFilter(d)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := client.Logical().List(path + "/id")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, names := []string{}, []string{}
+	if resp == nil {
+		return ids, names, nil
+	}
+	keyInfo, _ := resp.Data["key_info"].(map[string]interface{})
+	byName := map[string]string{}
+	for id, info := range keyInfo {
+		info, _ := info.(map[string]interface{})
+		name, _ := info["name"].(string)
+		if !match(name) {
+			continue
+		}
+		byName[name] = id
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		ids = append(ids, byName[name])
+	}
+	return ids, names, nil
+}
@@ -0,0 +1,115 @@
+package vault
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestListFilteredKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/secret/metadata/apps":
+			w.Write([]byte(`{"data": {"keys": ["api", "api-v2", "web", "team/"]}}`))
+		case "/v1/secret/metadata/apps/team":
+			w.Write([]byte(`{"data": {"keys": ["billing", "search", "shared/"]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := withListFilterSchema(map[string]*schema.Schema{})
+	for _, tc := range []struct {
+		path     string
+		folders  bool
+		prefix   string
+		regex    string
+		expected []string
+	}{
+		{path: "secret/metadata/apps", expected: []string{"api", "api-v2", "web", "team/"}},
+		{path: "secret/metadata/apps", prefix: "api", expected: []string{"api", "api-v2"}},
+		{path: "secret/metadata/apps", regex: "^[a-z]+$", expected: []string{"api", "web"}},
+		{path: "secret/metadata/apps", folders: true, prefix: "team/s", expected: []string{"team/search", "team/shared/"}},
+		{path: "secret/metadata/apps", folders: true, prefix: "team/", regex: "/$", expected: []string{"team/shared/"}},
+		{path: "secret/metadata/apps", prefix: "team/s", expected: []string{}},
+		{path: "secret/metadata/empty", expected: []string{}},
+	} {
+		d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+			"prefix": tc.prefix,
+			"regex":  tc.regex,
+		})
+		keys, err := listFilteredKeys(client, tc.path, tc.folders, d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(keys, tc.expected) {
+			t.Errorf("expected %v with prefix %q and regex %q, got %v", tc.expected, tc.prefix, tc.regex, keys)
+		}
+	}
+}
+
+func TestListIdentityFilteredIDs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/identity/entity/id":
+			w.Write([]byte(`{"data": {
+				"keys": ["id-bob", "id-alice", "id-svc"],
+				"key_info": {
+					"id-bob": {"name": "bob"},
+					"id-alice": {"name": "alice"},
+					"id-svc": {"name": "svc-deploy"}
+				}
+			}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := withListFilterSchema(map[string]*schema.Schema{})
+	for _, tc := range []struct {
+		path          string
+		prefix        string
+		regex         string
+		expectedIDs   []string
+		expectedNames []string
+	}{
+		{path: "identity/entity", expectedIDs: []string{"id-alice", "id-bob", "id-svc"}, expectedNames: []string{"alice", "bob", "svc-deploy"}},
+		{path: "identity/entity", prefix: "svc-", expectedIDs: []string{"id-svc"}, expectedNames: []string{"svc-deploy"}},
+		{path: "identity/entity", regex: "^[a-z]+$", expectedIDs: []string{"id-alice", "id-bob"}, expectedNames: []string{"alice", "bob"}},
+		{path: "identity/group", expectedIDs: []string{}, expectedNames: []string{}},
+	} {
+		d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+			"prefix": tc.prefix,
+			"regex":  tc.regex,
+		})
+		ids, names, err := listIdentityFilteredIDs(client, tc.path, d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(ids, tc.expectedIDs) || !reflect.DeepEqual(names, tc.expectedNames) {
+			t.Errorf("expected %v and %v with prefix %q and regex %q, got %v and %v", tc.expectedIDs, tc.expectedNames, tc.prefix, tc.regex, ids, names)
+		}
+	}
+}
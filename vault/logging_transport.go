@@ -0,0 +1,70 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// loggingTransport logs each request to Vault as key=value fields: the
+// method, the path, the mount it was sent to, the status, the duration and
+// the request ID, which Vault also records in its audit log. It logs the
+// warnings of the responses too, such as the deprecation of a parameter,
+// which the resources would drop otherwise. Unlike the logging transport of
+// the SDK, it never logs the headers, query or body of the requests and
+// responses, which hold the token and the secrets.
+type loggingTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := strings.TrimPrefix(req.URL.Path, "/v1/")
+	fields := fmt.Sprintf("method=%s path=%s mount=%s", req.Method, path, requestMount(path))
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	duration := time.Since(start)
+	if err != nil {
+		log.Printf("[DEBUG] Vault request failed: %s duration=%s error=%q", fields, duration, err)
+		return resp, err
+	}
+
+	var body struct {
+		RequestID string   `json:"request_id"`
+		Warnings  []string `json:"warnings"`
+	}
+	if resp.Body != nil && strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		data, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+		json.Unmarshal(data, &body)
+	}
+
+	if body.RequestID != "" {
+		fields += " request_id=" + body.RequestID
+	}
+	log.Printf("[DEBUG] Vault request: %s status=%d duration=%s", fields, resp.StatusCode, duration)
+	for _, w := range body.Warnings {
+		log.Printf("[WARN] Vault warning: %s warning=%q", fields, w)
+	}
+	return resp, nil
+}
+
+// requestMount returns the mount a Vault path is most likely sent to: its
+// first segment, or the first two for the auth backends. Mounts nested
+// deeper can't be told apart from their paths without listing the mounts.
+func requestMount(path string) string {
+	segments := strings.SplitN(path, "/", 3)
+	if segments[0] == "auth" && len(segments) > 1 {
+		return segments[0] + "/" + segments[1]
+	}
+	return segments[0]
+}
@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"request_id": "8a2c1c5e", "data": {"password": "hunter2"}, "warnings": ["parameter \"ttl\" is deprecated"]}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	client := &http.Client{
+		Transport: &loggingTransport{
+			transport: http.DefaultTransport,
+		},
+	}
+	req, err := http.NewRequest("GET", server.URL+"/v1/secret/foo?version=2", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Vault-Token", "s.secret-token")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(body), `"password": "hunter2"`) {
+		t.Errorf("bad body: %q", body)
+	}
+	if !strings.Contains(logs.String(), `[DEBUG] Vault request: method=GET path=secret/foo mount=secret request_id=8a2c1c5e status=200 duration=`) {
+		t.Errorf("request not logged: %q", logs.String())
+	}
+	if !strings.Contains(logs.String(), `[WARN] Vault warning: method=GET path=secret/foo mount=secret request_id=8a2c1c5e warning="parameter \"ttl\" is deprecated"`) {
+		t.Errorf("warning not logged: %q", logs.String())
+	}
+	for _, secret := range []string{"hunter2", "s.secret-token", "version=2"} {
+		if strings.Contains(logs.String(), secret) {
+			t.Errorf("%q logged: %q", secret, logs.String())
+		}
+	}
+}
+
+func TestRequestMount(t *testing.T) {
+	cases := map[string]string{
+		"secret/data/foo":        "secret",
+		"sys/mounts":             "sys",
+		"auth/userpass/login/al": "auth/userpass",
+		"auth/token/create":      "auth/token",
+		"auth":                   "auth",
+	}
+	for path, expected := range cases {
+		if actual := requestMount(path); actual != expected {
+			t.Errorf("bad mount for %q: want %q, got %q", path, expected, actual)
+		}
+	}
+}
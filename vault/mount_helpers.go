@@ -0,0 +1,223 @@
+package vault
+
+import (
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// mountCatalogs holds the secret and auth mounts read by the configured
+// providers, so that the resources of a large configuration don't each
+// list them again on refresh. The mounts of a client are listed once, and
+// again after the provider mounts, tunes or unmounts a backend.
+var mountCatalogs = struct {
+	sync.Mutex
+	clients map[*api.Client]*mountCatalog
+}{clients: map[*api.Client]*mountCatalog{}}
+
+type mountCatalog struct {
+	sync.Mutex
+	mounts map[string]*api.MountOutput
+	auths  map[string]*api.AuthMount
+	// kvVersions holds the version of the KV mounts by their path, as
+	// resolved by the KV preflight request.
+	kvVersions map[string]int
+}
+
+func getMountCatalog(client *api.Client) *mountCatalog {
+	mountCatalogs.Lock()
+	defer mountCatalogs.Unlock()
+
+	catalog, ok := mountCatalogs.clients[client]
+	if !ok {
+		catalog = &mountCatalog{kvVersions: map[string]int{}}
+		mountCatalogs.clients[client] = catalog
+	}
+	return catalog
+}
+
+// listMounts returns the secret mounts of client, keyed by their path with a
+// trailing slash, like Sys().ListMounts does.
+func listMounts(client *api.Client) (map[string]*api.MountOutput, error) {
+	catalog := getMountCatalog(client)
+	catalog.Lock()
+	defer catalog.Unlock()
+
+	if catalog.mounts == nil {
+		log.Printf("[DEBUG] Listing secret mounts")
+		mounts, err := client.Sys().ListMounts()
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[DEBUG] Listed secret mounts")
+		catalog.mounts = mounts
+	}
+	return catalog.mounts, nil
+}
+
+// listAuthMounts returns the auth mounts of client, keyed by their path with
+// a trailing slash, like Sys().ListAuth does.
+func listAuthMounts(client *api.Client) (map[string]*api.AuthMount, error) {
+	catalog := getMountCatalog(client)
+	catalog.Lock()
+	defer catalog.Unlock()
+
+	if catalog.auths == nil {
+		log.Printf("[DEBUG] Listing auth mounts")
+		auths, err := client.Sys().ListAuth()
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[DEBUG] Listed auth mounts")
+		catalog.auths = auths
+	}
+	return catalog.auths, nil
+}
+
+// invalidateMounts drops the secret mounts of client from the catalog. It is
+// called after each change to a secret mount.
+func invalidateMounts(client *api.Client) {
+	catalog := getMountCatalog(client)
+	catalog.Lock()
+	catalog.mounts = nil
+	catalog.kvVersions = map[string]int{}
+	catalog.Unlock()
+}
+
+// invalidateAuthMounts drops the auth mounts of client from the catalog. It
+// is called after each change to an auth mount.
+func invalidateAuthMounts(client *api.Client) {
+	catalog := getMountCatalog(client)
+	catalog.Lock()
+	catalog.auths = nil
+	catalog.Unlock()
+}
+
+// cachedKVVersion returns the mount path and version of the KV mount of
+// path, if it was resolved before.
+func cachedKVVersion(client *api.Client, path string) (string, int, bool) {
+	catalog := getMountCatalog(client)
+	catalog.Lock()
+	defer catalog.Unlock()
+
+	for mountPath, version := range catalog.kvVersions {
+		if strings.HasPrefix(path, mountPath) || path == strings.TrimSuffix(mountPath, "/") {
+			return mountPath, version, true
+		}
+	}
+	return "", 0, false
+}
+
+func setKVVersion(client *api.Client, mountPath string, version int) {
+	catalog := getMountCatalog(client)
+	catalog.Lock()
+	catalog.kvVersions[mountPath] = version
+	catalog.Unlock()
+}
+
+// mountLocks serializes the changes to each mount: Terraform applies
+// resources in parallel, and concurrent tune or remount calls against the
+// same mount race in Vault. The locks are keyed by the path of the mount,
+// auth mounts by their path prefixed with auth/, and shared by the clients
+// of all providers as they may point to the same Vault.
+var mountLocks = struct {
+	sync.Mutex
+	paths map[string]*sync.Mutex
+}{paths: map[string]*sync.Mutex{}}
+
+// lockMounts locks the mounts at paths, in a fixed order so that operations
+// on several mounts don't deadlock, and returns the function unlocking them.
+func lockMounts(paths ...string) func() {
+	keys := make([]string, 0, len(paths))
+	for _, p := range paths {
+		keys = append(keys, strings.Trim(p, "/"))
+	}
+	sort.Strings(keys)
+
+	var locks []*sync.Mutex
+	mountLocks.Lock()
+	for i, k := range keys {
+		if i > 0 && k == keys[i-1] {
+			continue
+		}
+		l, ok := mountLocks.paths[k]
+		if !ok {
+			l = &sync.Mutex{}
+			mountLocks.paths[k] = l
+		}
+		locks = append(locks, l)
+	}
+	mountLocks.Unlock()
+
+	for _, l := range locks {
+		l.Lock()
+	}
+	return func() {
+		for i := len(locks) - 1; i >= 0; i-- {
+			locks[i].Unlock()
+		}
+	}
+}
+
+// mountSecretBackend, tuneMount, remountSecretBackend, unmountSecretBackend,
+// enableAuthBackend, tuneAuthBackend and disableAuthBackend change a mount
+// while holding its lock, then drop the mounts of the client from the mount
+// catalog.
+func mountSecretBackend(client *api.Client, path string, input *api.MountInput) error {
+	defer lockMounts(path)()
+	defer invalidateMounts(client)
+	return client.Sys().Mount(path, input)
+}
+
+func tuneMount(client *api.Client, path string, config api.MountConfigInput) error {
+	defer lockMounts(path)()
+	defer invalidateMounts(client)
+	return client.Sys().TuneMount(path, config)
+}
+
+func remountSecretBackend(client *api.Client, from, to string) error {
+	defer lockMounts(from, to)()
+	defer invalidateMounts(client)
+	return client.Sys().Remount(from, to)
+}
+
+func unmountSecretBackend(client *api.Client, path string) error {
+	defer lockMounts(path)()
+	defer invalidateMounts(client)
+	return client.Sys().Unmount(path)
+}
+
+func enableAuthBackend(client *api.Client, path, authType, desc string) error {
+	defer lockMounts("auth/" + strings.Trim(path, "/"))()
+	defer invalidateAuthMounts(client)
+	return client.Sys().EnableAuth(path, authType, desc)
+}
+
+func tuneAuthBackend(client *api.Client, path string, config api.MountConfigInput) error {
+	path = "auth/" + strings.Trim(path, "/")
+	defer lockMounts(path)()
+	defer invalidateAuthMounts(client)
+	return client.Sys().TuneMount(path, config)
+}
+
+func disableAuthBackend(client *api.Client, path string) error {
+	defer lockMounts("auth/" + strings.Trim(path, "/"))()
+	defer invalidateAuthMounts(client)
+	return client.Sys().DisableAuth(path)
+}
+
+// writeBackendConfigOnChange calls write if any of fields, the fields a
+// secret backend writes to its config endpoint, has changed. The endpoint
+// takes the whole configuration, so a change to any of them rewrites all.
+func writeBackendConfigOnChange(d *schema.ResourceData, fields []string, write func() error) error {
+	for _, k := range fields {
+		if d.HasChange(k) {
+			return write()
+		}
+	}
+	return nil
+}
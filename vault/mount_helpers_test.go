@@ -0,0 +1,146 @@
+package vault
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestMountCatalog(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/sys/mounts":
+			w.Write([]byte(`{"data": {"secret/": {"type": "kv", "options": {"version": "2"}}}}`))
+		case "/v1/sys/auth":
+			w.Write([]byte(`{"data": {"userpass/": {"type": "userpass"}}}`))
+		case "/v1/sys/internal/ui/mounts/secret/foo", "/v1/sys/internal/ui/mounts/secret/bar":
+			w.Write([]byte(`{"data": {"path": "secret/", "type": "kv", "options": {"version": "2"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		mounts, err := listMounts(client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mounts["secret/"] == nil || mounts["secret/"].Type != "kv" {
+			t.Fatalf("unexpected mounts %#v", mounts)
+		}
+		auths, err := listAuthMounts(client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if auths["userpass/"] == nil || auths["userpass/"].Type != "userpass" {
+			t.Fatalf("unexpected auth mounts %#v", auths)
+		}
+	}
+	if requests["/v1/sys/mounts"] != 1 || requests["/v1/sys/auth"] != 1 {
+		t.Fatalf("expected the mounts to be listed once, got %v", requests)
+	}
+
+	for _, path := range []string{"secret/foo", "secret/bar"} {
+		mountPath, v2, err := isKVv2(path, client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mountPath != "secret/" || !v2 {
+			t.Fatalf("expected %q to be on the KV v2 mount secret/, got %q, %t", path, mountPath, v2)
+		}
+	}
+	if requests["/v1/sys/internal/ui/mounts/secret/bar"] != 0 {
+		t.Fatalf("expected the KV version of secret/ to be cached, got %v", requests)
+	}
+
+	invalidateMounts(client)
+	invalidateAuthMounts(client)
+	if _, err := listMounts(client); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := listAuthMounts(client); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := isKVv2("secret/bar", client); err != nil {
+		t.Fatal(err)
+	}
+	if requests["/v1/sys/mounts"] != 2 || requests["/v1/sys/auth"] != 2 || requests["/v1/sys/internal/ui/mounts/secret/bar"] != 1 {
+		t.Fatalf("expected the mounts to be listed again after invalidation, got %v", requests)
+	}
+}
+
+func TestLockMounts(t *testing.T) {
+	var l sync.Mutex
+	active := map[string]int{}
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		paths := [][]string{{"secret/"}, {"/secret", "kv"}, {"kv/", "secret"}, {"other"}}[i%4]
+		wg.Add(1)
+		go func(paths []string) {
+			defer wg.Done()
+			unlock := lockMounts(paths...)
+			defer unlock()
+
+			l.Lock()
+			for _, p := range paths {
+				p = strings.Trim(p, "/")
+				active[p]++
+				if active[p] > 1 {
+					t.Errorf("mount %q changed concurrently", p)
+				}
+			}
+			l.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			l.Lock()
+			for _, p := range paths {
+				active[strings.Trim(p, "/")]--
+			}
+			l.Unlock()
+		}(paths)
+	}
+	wg.Wait()
+}
+
+func TestWriteBackendConfigOnChange(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, consulSecretBackendResource().Schema, map[string]interface{}{
+		"path":    "consul",
+		"address": "127.0.0.1:8500",
+	})
+
+	writes := 0
+	write := func() error {
+		writes++
+		return nil
+	}
+	if err := writeBackendConfigOnChange(d, []string{"address", "scheme"}, write); err != nil {
+		t.Fatal(err)
+	}
+	if writes != 1 {
+		t.Errorf("expected the configuration to be written once, got %d writes", writes)
+	}
+	if err := writeBackendConfigOnChange(d, []string{"ca_cert", "client_cert"}, write); err != nil {
+		t.Fatal(err)
+	}
+	if writes != 1 {
+		t.Errorf("expected the configuration not to be written without changes, got %d writes", writes)
+	}
+}
@@ -71,10 +71,12 @@ func deleteOktaUser(client *api.Client, path, username string) error {
 	return err
 }
 
-func isOktaAuthBackendPresent(client *api.Client, path string) (bool, error) {
-	auths, err := client.Sys().ListAuth()
+// readOktaAuthBackend returns the Okta auth backend mounted at the path, or
+// nil if there is none.
+func readOktaAuthBackend(client *api.Client, path string) (*api.AuthMount, error) {
+	auths, err := listAuthMounts(client)
 	if err != nil {
-		return false, fmt.Errorf("error reading from Vault: %s", err)
+		return nil, fmt.Errorf("error reading from Vault: %s", err)
 	}
 
 	configuredPath := path + "/"
@@ -82,11 +84,11 @@ func isOktaAuthBackendPresent(client *api.Client, path string) (bool, error) {
 	for authBackendPath, auth := range auths {
 
 		if auth.Type == "okta" && authBackendPath == configuredPath {
-			return true, nil
+			return auth, nil
 		}
 	}
 
-	return false, nil
+	return nil, nil
 }
 
 func isOktaGroupPresent(client *api.Client, path, name string) (bool, error) {
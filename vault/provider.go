@@ -1,16 +1,25 @@
 package vault
 
 import (
+	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"log"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
-	"github.com/hashicorp/terraform/helper/logging"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/command/config"
+	"golang.org/x/time/rate"
 )
 
 func Provider() terraform.ResourceProvider {
@@ -20,11 +29,18 @@ func Provider() terraform.ResourceProvider {
 				Type:        schema.TypeString,
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("VAULT_ADDR", nil),
-				Description: "URL of the root of the target Vault server.",
+				Description: "URL of the root of the target Vault server, or unix:// followed by the path of its socket.",
+			},
+			"proxy_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VAULT_PROXY_ADDR", ""),
+				Description: "URL of the HTTP proxy to send requests through, instead of the one set by HTTPS_PROXY.",
 			},
 			"token": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Sensitive:   true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("VAULT_TOKEN", ""),
 				Description: "Token to use to authenticate to Vault.",
 			},
@@ -48,19 +64,36 @@ func Provider() terraform.ResourceProvider {
 					Schema: map[string]*schema.Schema{
 						"cert_file": {
 							Type:        schema.TypeString,
-							Required:    true,
+							Optional:    true,
 							DefaultFunc: schema.EnvDefaultFunc("VAULT_CLIENT_CERT", ""),
 							Description: "Path to a file containing the client certificate.",
 						},
 						"key_file": {
 							Type:        schema.TypeString,
-							Required:    true,
+							Optional:    true,
 							DefaultFunc: schema.EnvDefaultFunc("VAULT_CLIENT_KEY", ""),
 							Description: "Path to a file containing the private key that the certificate was issued for.",
 						},
+						"cert_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM-encoded client certificate, used instead of cert_file.",
+						},
+						"key_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "PEM-encoded private key that the certificate was issued for, used instead of key_file.",
+						},
 					},
 				},
 			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VAULT_TLS_SERVER_NAME", ""),
+				Description: "Name to use as the SNI host when connecting via TLS.",
+			},
 			"skip_tls_verify": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -79,63 +112,565 @@ func Provider() terraform.ResourceProvider {
 
 				Description: "Maximum TTL for secret leases requested by this provider",
 			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VAULT_NAMESPACE", ""),
+				Description: "The Vault Enterprise namespace to send every request to.",
+			},
+			"set_namespace_from_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Send every request to the namespace the token was issued in, when namespace is not set.",
+			},
+			"required_capabilities": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Capabilities the token must have on paths, checked when the provider is configured.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The path to check the capabilities of the token on.",
+						},
+						"capabilities": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Description: "The capabilities the token must have on the path.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"create", "read", "update", "patch", "delete", "list", "sudo"}, false),
+							},
+						},
+					},
+				},
+			},
+			"token_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VAULT_TOKEN_NAME", "terraform"),
+				Description: "Display name of the child token Terraform issues itself.",
+			},
+			"skip_child_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TERRAFORM_VAULT_SKIP_CHILD_TOKEN", false),
+				Description: "Use the given token directly, instead of issuing a limited child token.",
+			},
+			"renew_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TERRAFORM_VAULT_RENEW_TOKEN", false),
+				Description: "Renew the token, and the leases read by data sources, in the background while Terraform runs.",
+			},
+			"headers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Headers to send with every request to Vault.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the header.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "The value of the header.",
+						},
+						"sensitive": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Keep the value of the header out of the debug logs.",
+						},
+					},
+				},
+			},
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VAULT_MAX_RETRIES", 2),
+				Description: "Maximum number of retries of a request that failed with a 5xx, 429, 472 or 473 error, or one of retry_status_codes.",
+			},
+			"retry_wait_min_ms": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1000,
+				Description: "Number of milliseconds to wait before the first retry of a request, the wait doubles with each retry.",
+			},
+			"retry_wait_max_ms": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30000,
+				Description: "Maximum number of milliseconds to wait before retrying a request.",
+			},
+			"retry_status_codes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Additional status codes that requests are retried on, besides 5xx, 429, 472 and 473. 5xx codes other than 501 are always retried by the Vault client, so listing them has no effect.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"rate_limit": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum number of requests per second sent to Vault, 0 for no limit.",
+			},
+			"rate_limit_burst": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Number of requests that may be sent at once, in spite of rate_limit. Defaults to rate_limit rounded up.",
+			},
+			"replication_consistency": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Make reads wait for the preceding writes on performance standbys and secondaries, using the X-Vault-Index header.",
+			},
+			"revoke_leases_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Revoke the leases and tokens created by resources when they are destroyed or replaced, unless the resource's revoke_lease says otherwise.",
+			},
+			"skip_get_vault_version": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip detecting the version of the Vault server from sys/seal-status.",
+			},
+			"vault_version_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateVaultVersion,
+				Description:  "Use this Vault server version instead of detecting it, for the features that depend on it.",
+			},
+			"auth_login_userpass": authLoginSchema("Log in with the userpass auth backend instead of using a token.", "userpass", map[string]*schema.Schema{
+				"username": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The username to log in with.",
+				},
+				"password": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The password to log in with.",
+				},
+				"password_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path to a file containing the password to log in with.",
+				},
+			}),
+			"auth_login_okta": authLoginSchema("Log in with the Okta auth backend instead of using a token.", "okta", map[string]*schema.Schema{
+				"username": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The Okta username to log in with.",
+				},
+				"password": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					Description: "The Okta password to log in with.",
+				},
+				"totp": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The Okta Verify TOTP passcode, instead of a push.",
+				},
+				"provider": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The MFA provider of the TOTP passcode.",
+				},
+			}),
+			"auth_login_aws": authLoginSchema("Log in with the AWS auth backend, using the ambient AWS credentials, instead of using a token.", "aws", map[string]*schema.Schema{
+				"role": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The AWS auth backend role to log in to.",
+				},
+				"header_value": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The value of the X-Vault-AWS-IAM-Server-ID header, if the backend requires one.",
+				},
+				"region": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "us-east-1",
+					Description: "The AWS region of the STS endpoint to sign the request for.",
+				},
+				"profile": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The profile in the shared AWS config files to take the credentials from.",
+				},
+				"web_identity_token_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path to a web identity token to exchange for the credentials of web_identity_role_arn, instead of AWS_WEB_IDENTITY_TOKEN_FILE.",
+				},
+				"web_identity_role_arn": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The role to assume with the web identity token, instead of AWS_ROLE_ARN.",
+				},
+				"role_arn": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "A role to assume with the credentials, whose credentials then sign the request.",
+				},
+				"role_session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The session name of the assumed roles, instead of AWS_ROLE_SESSION_NAME.",
+				},
+				"external_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The external ID required to assume role_arn.",
+				},
+			}),
+			"auth_login_gcp": authLoginSchema("Log in with the GCP auth backend, using a service account signed JWT, instead of using a token.", "gcp", map[string]*schema.Schema{
+				"role": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The GCP auth backend role to log in to.",
+				},
+				"credentials": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "JSON-encoded service account key to sign the JWT with, for iam type roles.",
+				},
+				"service_account": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Email of the service account to have the IAM credentials API sign the JWT for, for iam type roles.",
+				},
+				"jwt_ttl": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      900,
+					Description:  "Number of seconds the JWT of iam type roles is valid for.",
+					ValidateFunc: validation.IntBetween(1, 900),
+				},
+			}),
+			"auth_login_azure": authLoginSchema("Log in with the Azure auth backend, using a managed or workload identity, instead of using a token.", "azure", map[string]*schema.Schema{
+				"role": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The Azure auth backend role to log in to.",
+				},
+				"resource": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "https://management.azure.com/",
+					Description: "The resource the Azure AD token is requested for, which must match the backend's resource.",
+				},
+				"client_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The client ID of the user assigned or workload identity.",
+				},
+				"tenant_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The tenant ID of the workload identity.",
+				},
+				"subscription_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The subscription ID of the machine.",
+				},
+				"resource_group_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The resource group of the machine.",
+				},
+				"vm_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The name of the virtual machine.",
+				},
+				"vmss_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The name of the virtual machine scale set.",
+				},
+			}),
+			"auth_login_jwt": authLoginSchema("Log in with the JWT or Kubernetes auth backend instead of using a token.", "jwt", map[string]*schema.Schema{
+				"role": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The role to log in to.",
+				},
+				"jwt": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The JWT to log in with.",
+				},
+				"jwt_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path to a file containing the JWT to log in with.",
+				},
+				"audience": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The audience of the GitHub Actions OIDC token.",
+				},
+			}),
+			"auth_login_cert": authLoginSchema("Log in with the TLS certificate auth backend, using the client_auth certificate, instead of using a token.", "cert", map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The certificate role to log in to. If not set, the backend picks the role matching the certificate.",
+				},
+			}),
+			"auth_login_oidc": authLoginSchema("Log in interactively with the OIDC auth backend in a browser instead of using a token.", "oidc", map[string]*schema.Schema{
+				"role": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The role to log in to. If not set, the backend's default role is used.",
+				},
+				"redirect_uri": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "http://localhost:8250/oidc/callback",
+					Description: "The redirect URI the provider sends the browser back to, which must be allowed by the role.",
+				},
+				"listen_address": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "localhost:8250",
+					Description: "The address the callback listener listens on.",
+				},
+				"skip_browser": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Don't open the browser, the auth URL is only logged.",
+				},
+				"timeout": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     300,
+					Description: "Number of seconds to wait for the login to complete.",
+				},
+			}),
+			"auth_login_token_file": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Read the token from a file, such as a Vault Agent sink, instead of using token.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filename": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Path to the file containing the token.",
+						},
+					},
+				},
+			},
 		},
 
 		ConfigureFunc: providerConfigure,
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"vault_approle_auth_backend_role_id":   approleAuthBackendRoleIDDataSource(),
-			"vault_kubernetes_auth_backend_config": kubernetesAuthBackendConfigDataSource(),
-			"vault_kubernetes_auth_backend_role":   kubernetesAuthBackendRoleDataSource(),
-			"vault_aws_access_credentials":         awsAccessCredentialsDataSource(),
-			"vault_generic_secret":                 genericSecretDataSource(),
+			"vault_approle_auth_backend_role_id":     approleAuthBackendRoleIDDataSource(),
+			"vault_kubernetes_auth_backend_config":   kubernetesAuthBackendConfigDataSource(),
+			"vault_kubernetes_auth_backend_role":     kubernetesAuthBackendRoleDataSource(),
+			"vault_aws_access_credentials":           awsAccessCredentialsDataSource(),
+			"vault_client_counts":                    clientCountsDataSource(),
+			"vault_entity_counts":                    entityCountsDataSource(),
+			"vault_generic_endpoint":                 genericEndpointDataSource(),
+			"vault_generic_secret":                   genericSecretDataSource(),
+			"vault_identity_entities":                identityEntitiesDataSource(),
+			"vault_identity_groups":                  identityGroupsDataSource(),
+			"vault_kv_secrets_list":                  kvSecretsListDataSource(),
+			"vault_namespaces":                       namespacesDataSource(),
+			"vault_token_capabilities":               tokenCapabilitiesDataSource(),
+			"vault_wrapping_lookup":                  wrappingLookupDataSource(),
+			"vault_wrapping_unwrap":                  wrappingUnwrapDataSource(),
+			"vault_pki_secret_backend_issuer":        pkiSecretBackendIssuerDataSource(),
+			"vault_pki_secret_backend_issuers":       pkiSecretBackendIssuersDataSource(),
+			"vault_ssh_secret_backend_sign":          sshSecretBackendSignDataSource(),
+			"vault_transit_encrypt":                  transitEncryptDataSource(),
+			"vault_transit_decrypt":                  transitDecryptDataSource(),
+			"vault_transit_sign":                     transitSignDataSource(),
+			"vault_transit_verify":                   transitVerifyDataSource(),
+			"vault_transit_datakey":                  transitDatakeyDataSource(),
+			"vault_transit_export":                   transitExportDataSource(),
+			"vault_random":                           randomDataSource(),
+			"vault_hash":                             hashDataSource(),
+			"vault_transform_encode":                 transformEncodeDataSource(),
+			"vault_transform_decode":                 transformDecodeDataSource(),
+			"vault_azure_access_credentials":         azureAccessCredentialsDataSource(),
+			"vault_gcp_access_token":                 gcpAccessTokenDataSource(),
+			"vault_gcp_service_account_key":          gcpServiceAccountKeyDataSource(),
+			"vault_ldap_static_credentials":          ldapStaticCredentialsDataSource(),
+			"vault_ldap_dynamic_credentials":         ldapDynamicCredentialsDataSource(),
+			"vault_nomad_access_token":               nomadAccessTokenDataSource(),
+			"vault_terraform_cloud_secret_creds":     terraformCloudSecretCredsDataSource(),
+			"vault_kmip_secret_credentials":          kmipSecretCredentialsDataSource(),
+			"vault_kubernetes_service_account_token": kubernetesServiceAccountTokenDataSource(),
+			"vault_totp_code":                        totpCodeDataSource(),
+			"vault_totp_code_validation":             totpCodeValidationDataSource(),
+			"vault_gcpkms_encrypt":                   gcpkmsEncryptDataSource(),
+			"vault_gcpkms_decrypt":                   gcpkmsDecryptDataSource(),
+			"vault_gcpkms_sign":                      gcpkmsSignDataSource(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"vault_approle_auth_backend_login":          approleAuthBackendLoginResource(),
-			"vault_approle_auth_backend_role":           approleAuthBackendRoleResource(),
-			"vault_approle_auth_backend_role_secret_id": approleAuthBackendRoleSecretIDResource(),
-			"vault_auth_backend":                        authBackendResource(),
-			"vault_token_auth_backend_role":             tokenAuthBackendRoleResource(),
-			"vault_aws_auth_backend_cert":               awsAuthBackendCertResource(),
-			"vault_aws_auth_backend_client":             awsAuthBackendClientResource(),
-			"vault_aws_auth_backend_identity_whitelist": awsAuthBackendIdentityWhitelistResource(),
-			"vault_aws_auth_backend_login":              awsAuthBackendLoginResource(),
-			"vault_aws_auth_backend_role":               awsAuthBackendRoleResource(),
-			"vault_aws_auth_backend_role_tag":           awsAuthBackendRoleTagResource(),
-			"vault_aws_auth_backend_roletag_blacklist":  awsAuthBackendRoleTagBlacklistResource(),
-			"vault_aws_auth_backend_sts_role":           awsAuthBackendSTSRoleResource(),
-			"vault_aws_secret_backend":                  awsSecretBackendResource(),
-			"vault_aws_secret_backend_role":             awsSecretBackendRoleResource(),
-			"vault_consul_secret_backend":               consulSecretBackendResource(),
-			"vault_database_secret_backend_connection":  databaseSecretBackendConnectionResource(),
-			"vault_database_secret_backend_role":        databaseSecretBackendRoleResource(),
-			"vault_gcp_auth_backend":                    gcpAuthBackendResource(),
-			"vault_gcp_auth_backend_role":               gcpAuthBackendRoleResource(),
-			"vault_gcp_secret_backend":                  gcpSecretBackendResource(),
-			"vault_cert_auth_backend_role":              certAuthBackendRoleResource(),
-			"vault_generic_secret":                      genericSecretResource(),
-			"vault_jwt_auth_backend_role":               jwtAuthBackendRoleResource(),
-			"vault_kubernetes_auth_backend_config":      kubernetesAuthBackendConfigResource(),
-			"vault_kubernetes_auth_backend_role":        kubernetesAuthBackendRoleResource(),
-			"vault_okta_auth_backend":                   oktaAuthBackendResource(),
-			"vault_okta_auth_backend_user":              oktaAuthBackendUserResource(),
-			"vault_okta_auth_backend_group":             oktaAuthBackendGroupResource(),
-			"vault_ldap_auth_backend":                   ldapAuthBackendResource(),
-			"vault_ldap_auth_backend_user":              ldapAuthBackendUserResource(),
-			"vault_ldap_auth_backend_group":             ldapAuthBackendGroupResource(),
-			"vault_policy":                              policyResource(),
-			"vault_mount":                               mountResource(),
-			"vault_audit":                               auditResource(),
-			"vault_ssh_secret_backend_ca":               sshSecretBackendCAResource(),
-			"vault_identity_group":                      identityGroupResource(),
-			"vault_identity_group_alias":                identityGroupAliasResource(),
-			"vault_rabbitmq_secret_backend":             rabbitmqSecretBackendResource(),
-			"vault_rabbitmq_secret_backend_role":        rabbitmqSecretBackendRoleResource(),
+			"vault_approle_auth_backend_login":                         approleAuthBackendLoginResource(),
+			"vault_approle_auth_backend_role":                          approleAuthBackendRoleResource(),
+			"vault_approle_auth_backend_role_secret_id":                approleAuthBackendRoleSecretIDResource(),
+			"vault_auth_backend":                                       authBackendResource(),
+			"vault_token_auth_backend_role":                            tokenAuthBackendRoleResource(),
+			"vault_aws_auth_backend_cert":                              awsAuthBackendCertResource(),
+			"vault_aws_auth_backend_client":                            awsAuthBackendClientResource(),
+			"vault_aws_auth_backend_identity_whitelist":                awsAuthBackendIdentityWhitelistResource(),
+			"vault_aws_auth_backend_login":                             awsAuthBackendLoginResource(),
+			"vault_aws_auth_backend_role":                              awsAuthBackendRoleResource(),
+			"vault_aws_auth_backend_role_tag":                          awsAuthBackendRoleTagResource(),
+			"vault_aws_auth_backend_roletag_blacklist":                 awsAuthBackendRoleTagBlacklistResource(),
+			"vault_aws_auth_backend_sts_role":                          awsAuthBackendSTSRoleResource(),
+			"vault_aws_secret_backend":                                 awsSecretBackendResource(),
+			"vault_aws_secret_backend_role":                            awsSecretBackendRoleResource(),
+			"vault_consul_secret_backend":                              consulSecretBackendResource(),
+			"vault_database_secret_backend_connection":                 databaseSecretBackendConnectionResource(),
+			"vault_database_secret_backend_role":                       databaseSecretBackendRoleResource(),
+			"vault_gcp_auth_backend":                                   gcpAuthBackendResource(),
+			"vault_gcp_auth_backend_role":                              gcpAuthBackendRoleResource(),
+			"vault_gcp_secret_backend":                                 gcpSecretBackendResource(),
+			"vault_cert_auth_backend_role":                             certAuthBackendRoleResource(),
+			"vault_generic_secret":                                     genericSecretResource(),
+			"vault_jwt_auth_backend_role":                              jwtAuthBackendRoleResource(),
+			"vault_kubernetes_auth_backend_config":                     kubernetesAuthBackendConfigResource(),
+			"vault_kubernetes_auth_backend_role":                       kubernetesAuthBackendRoleResource(),
+			"vault_okta_auth_backend":                                  oktaAuthBackendResource(),
+			"vault_okta_auth_backend_user":                             oktaAuthBackendUserResource(),
+			"vault_okta_auth_backend_group":                            oktaAuthBackendGroupResource(),
+			"vault_ldap_auth_backend":                                  ldapAuthBackendResource(),
+			"vault_ldap_auth_backend_user":                             ldapAuthBackendUserResource(),
+			"vault_ldap_auth_backend_group":                            ldapAuthBackendGroupResource(),
+			"vault_policy":                                             policyResource(),
+			"vault_quota_rate_limit":                                   quotaRateLimitResource(),
+			"vault_mount":                                              mountResource(),
+			"vault_audit":                                              auditResource(),
+			"vault_ssh_secret_backend_ca":                              sshSecretBackendCAResource(),
+			"vault_identity_group":                                     identityGroupResource(),
+			"vault_identity_group_alias":                               identityGroupAliasResource(),
+			"vault_rabbitmq_secret_backend":                            rabbitmqSecretBackendResource(),
+			"vault_rabbitmq_secret_backend_role":                       rabbitmqSecretBackendRoleResource(),
+			"vault_pki_secret_backend_intermediate_cert_request":       pkiSecretBackendIntermediateCertRequestResource(),
+			"vault_pki_secret_backend_intermediate_set_signed":         pkiSecretBackendIntermediateSetSignedResource(),
+			"vault_pki_secret_backend_root_sign_intermediate":          pkiSecretBackendRootSignIntermediateResource(),
+			"vault_pki_secret_backend_role":                            pkiSecretBackendRoleResource(),
+			"vault_pki_secret_backend_cert":                            pkiSecretBackendCertResource(),
+			"vault_pki_secret_backend_sign":                            pkiSecretBackendSignResource(),
+			"vault_pki_secret_backend_config_urls":                     pkiSecretBackendConfigUrlsResource(),
+			"vault_pki_secret_backend_config_cluster":                  pkiSecretBackendConfigClusterResource(),
+			"vault_pki_secret_backend_crl_config":                      pkiSecretBackendCrlConfigResource(),
+			"vault_pki_secret_backend_config_ca":                       pkiSecretBackendConfigCAResource(),
+			"vault_pki_secret_backend_issuer":                          pkiSecretBackendIssuerResource(),
+			"vault_pki_secret_backend_key":                             pkiSecretBackendKeyResource(),
+			"vault_pki_secret_backend_config_issuers":                  pkiSecretBackendConfigIssuersResource(),
+			"vault_pki_secret_backend_config_acme":                     pkiSecretBackendConfigAcmeResource(),
+			"vault_pki_secret_backend_config_est":                      pkiSecretBackendConfigEstResource(),
+			"vault_pki_secret_backend_config_scep":                     pkiSecretBackendConfigScepResource(),
+			"vault_pki_secret_backend_config_auto_tidy":                pkiSecretBackendConfigAutoTidyResource(),
+			"vault_pki_secret_backend_issuers_import":                  pkiSecretBackendIssuersImportResource(),
+			"vault_pki_secret_backend_intermediate_cross_sign_request": pkiSecretBackendIntermediateCrossSignRequestResource(),
+			"vault_ssh_secret_backend_role":                            sshSecretBackendRoleResource(),
+			"vault_transit_secret_backend_key":                         transitSecretBackendKeyResource(),
+			"vault_transit_secret_cache_config":                        transitSecretCacheConfigResource(),
+			"vault_transit_secret_backend_key_import":                  transitSecretBackendKeyImportResource(),
+			"vault_transform_alphabet":                                 transformAlphabetResource(),
+			"vault_transform_template":                                 transformTemplateResource(),
+			"vault_transform_transformation":                           transformTransformationResource(),
+			"vault_transform_role":                                     transformRoleResource(),
+			"vault_aws_secret_backend_static_role":                     awsSecretBackendStaticRoleResource(),
+			"vault_azure_secret_backend":                               azureSecretBackendResource(),
+			"vault_azure_secret_backend_role":                          azureSecretBackendRoleResource(),
+			"vault_gcp_secret_roleset":                                 gcpSecretRolesetResource(),
+			"vault_gcp_secret_static_account":                          gcpSecretStaticAccountResource(),
+			"vault_gcp_secret_impersonated_account":                    gcpSecretImpersonatedAccountResource(),
+			"vault_ad_secret_backend":                                  adSecretBackendResource(),
+			"vault_ad_secret_library":                                  adSecretLibraryResource(),
+			"vault_ldap_secret_backend":                                ldapSecretBackendResource(),
+			"vault_ldap_secret_backend_static_role":                    ldapSecretBackendStaticRoleResource(),
+			"vault_ldap_secret_backend_dynamic_role":                   ldapSecretBackendDynamicRoleResource(),
+			"vault_consul_secret_backend_role":                         consulSecretBackendRoleResource(),
+			"vault_nomad_secret_backend":                               nomadSecretBackendResource(),
+			"vault_nomad_secret_backend_role":                          nomadSecretBackendRoleResource(),
+			"vault_terraform_cloud_secret_backend":                     terraformCloudSecretBackendResource(),
+			"vault_terraform_cloud_secret_role":                        terraformCloudSecretRoleResource(),
+			"vault_kmip_secret_backend":                                kmipSecretBackendResource(),
+			"vault_kmip_secret_scope":                                  kmipSecretScopeResource(),
+			"vault_kmip_secret_role":                                   kmipSecretRoleResource(),
+			"vault_kubernetes_secret_backend":                          kubernetesSecretBackendResource(),
+			"vault_kubernetes_secret_backend_role":                     kubernetesSecretBackendRoleResource(),
+			"vault_totp_secret_backend_key":                            totpSecretBackendKeyResource(),
+			"vault_gcpkms_secret_backend":                              gcpkmsSecretBackendResource(),
+			"vault_gcpkms_secret_backend_key":                          gcpkmsSecretBackendKeyResource(),
+			"vault_secrets_sync_aws_destination":                       secretsSyncAWSDestinationResource(),
+			"vault_secrets_sync_azure_destination":                     secretsSyncAzureDestinationResource(),
+			"vault_secrets_sync_gcp_destination":                       secretsSyncGCPDestinationResource(),
+			"vault_secrets_sync_gh_destination":                        secretsSyncGHDestinationResource(),
+			"vault_secrets_sync_vercel_destination":                    secretsSyncVercelDestinationResource(),
+			"vault_secrets_sync_association":                           secretsSyncAssociationResource(),
+			"vault_secrets_sync_config":                                secretsSyncConfigResource(),
 		},
 	}
 }
 
+// providerConfigureAddress sets the address of the Vault server and the
+// proxy requests are sent through. Following the Vault CLI, the requests to
+// a unix socket, such as the listener of a local Vault Agent, are sent to
+// http://localhost over the socket.
+func providerConfigureAddress(d *schema.ResourceData, clientConfig *api.Config) error {
+	transport := clientConfig.HttpClient.Transport.(*http.Transport)
+
+	address := d.Get("address").(string)
+	if strings.HasPrefix(address, "unix://") {
+		socket := strings.TrimPrefix(address, "unix://")
+		if socket == "" {
+			return fmt.Errorf("the unix:// address must include the path of the socket")
+		}
+		dialer := &net.Dialer{}
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "unix", socket)
+		}
+		transport.Proxy = nil
+		address = "http://localhost"
+	}
+	clientConfig.Address = address
+
+	if v := d.Get("proxy_address").(string); v != "" && transport.Proxy != nil {
+		proxyURL, err := url.Parse(v)
+		if err != nil {
+			return fmt.Errorf("failed to parse proxy_address: %s", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	return nil
+}
+
 func providerToken(d *schema.ResourceData) (string, error) {
 	if token := d.Get("token").(string); token != "" {
 		return token, nil
@@ -154,7 +689,16 @@ func providerToken(d *schema.ResourceData) (string, error) {
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	clientConfig := api.DefaultConfig()
-	clientConfig.Address = d.Get("address").(string)
+	// The request timeout is applied to the context of each request rather
+	// than by the HTTP client, so that resources with configurable timeouts
+	// can extend it.
+	if clientConfig.Timeout == 0 {
+		clientConfig.Timeout = clientConfig.HttpClient.Timeout
+	}
+	clientConfig.HttpClient.Timeout = 0
+	if err := providerConfigureAddress(d, clientConfig); err != nil {
+		return nil, err
+	}
 
 	clientAuthI := d.Get("client_auth").([]interface{})
 	if len(clientAuthI) > 1 {
@@ -163,16 +707,28 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	clientAuthCert := ""
 	clientAuthKey := ""
+	clientAuthCertPEM := ""
+	clientAuthKeyPEM := ""
 	if len(clientAuthI) == 1 {
 		clientAuth := clientAuthI[0].(map[string]interface{})
-		clientAuthCert = clientAuth["cert_file"].(string)
-		clientAuthKey = clientAuth["key_file"].(string)
+		clientAuthCertPEM = clientAuth["cert_pem"].(string)
+		clientAuthKeyPEM = clientAuth["key_pem"].(string)
+		// the inline certificate takes precedence over the files, which
+		// may be set through the environment.
+		if clientAuthCertPEM == "" && clientAuthKeyPEM == "" {
+			clientAuthCert = clientAuth["cert_file"].(string)
+			clientAuthKey = clientAuth["key_file"].(string)
+		}
+	}
+	if len(d.Get("auth_login_cert").([]interface{})) > 0 && clientAuthCert == "" && clientAuthCertPEM == "" {
+		return nil, fmt.Errorf("auth_login_cert requires the client_auth block to be set")
 	}
 
 	err := clientConfig.ConfigureTLS(&api.TLSConfig{
-		CACert:   d.Get("ca_cert_file").(string),
-		CAPath:   d.Get("ca_cert_dir").(string),
-		Insecure: d.Get("skip_tls_verify").(bool),
+		CACert:        d.Get("ca_cert_file").(string),
+		CAPath:        d.Get("ca_cert_dir").(string),
+		Insecure:      d.Get("skip_tls_verify").(bool),
+		TLSServerName: d.Get("tls_server_name").(string),
 
 		ClientCert: clientAuthCert,
 		ClientKey:  clientAuthKey,
@@ -181,17 +737,118 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, fmt.Errorf("failed to configure TLS for Vault API: %s", err)
 	}
 
-	clientConfig.HttpClient.Transport = logging.NewTransport("Vault", clientConfig.HttpClient.Transport)
+	if clientAuthCertPEM != "" || clientAuthKeyPEM != "" {
+		if clientAuthCertPEM == "" || clientAuthKeyPEM == "" {
+			return nil, fmt.Errorf("both cert_pem and key_pem must be set in the client_auth block")
+		}
+		clientCert, err := tls.X509KeyPair([]byte(clientAuthCertPEM), []byte(clientAuthKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client_auth certificate: %s", err)
+		}
+		// like the Vault API, this ignores the server's list of CAs, which
+		// need not include the ones trusted by the cert auth backend.
+		clientConfig.HttpClient.Transport.(*http.Transport).TLSClientConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return &clientCert, nil
+		}
+	}
+
+	headers := http.Header{}
+	sensitiveHeaders := http.Header{}
+	for _, h := range d.Get("headers").([]interface{}) {
+		header := h.(map[string]interface{})
+		if header["sensitive"].(bool) {
+			sensitiveHeaders.Add(header["name"].(string), header["value"].(string))
+		} else {
+			headers.Add(header["name"].(string), header["value"].(string))
+		}
+	}
+	if len(sensitiveHeaders) > 0 {
+		clientConfig.HttpClient.Transport = &headerTransport{
+			transport: clientConfig.HttpClient.Transport,
+			headers:   sensitiveHeaders,
+		}
+	}
+
+	clientConfig.HttpClient.Transport = &loggingTransport{
+		transport: clientConfig.HttpClient.Transport,
+	}
+
+	retryWaitMin := time.Duration(d.Get("retry_wait_min_ms").(int)) * time.Millisecond
+	retryWaitMax := time.Duration(d.Get("retry_wait_max_ms").(int)) * time.Millisecond
+	if retryWaitMax < retryWaitMin {
+		return nil, fmt.Errorf("retry_wait_max_ms must not be less than retry_wait_min_ms")
+	}
+	clientConfig.MaxRetries = d.Get("max_retries").(int)
+	clientConfig.Backoff = func(_, _ time.Duration, attemptNum int, resp *http.Response) time.Duration {
+		return exponentialJitterBackoff(retryWaitMin, retryWaitMax, attemptNum, resp)
+	}
+	statusCodes := map[int]bool{}
+	for _, code := range retryTransportStatusCodes {
+		statusCodes[code] = true
+	}
+	for _, code := range d.Get("retry_status_codes").(*schema.Set).List() {
+		statusCodes[code.(int)] = true
+	}
+	consistency := d.Get("replication_consistency").(bool)
+	if consistency {
+		statusCodes[http.StatusPreconditionFailed] = true
+	}
+	clientConfig.HttpClient.Transport = &retryTransport{
+		transport:   clientConfig.HttpClient.Transport,
+		statusCodes: statusCodes,
+		maxRetries:  clientConfig.MaxRetries,
+		waitMin:     retryWaitMin,
+		waitMax:     retryWaitMax,
+	}
+	if consistency {
+		clientConfig.HttpClient.Transport = &consistencyTransport{
+			transport: clientConfig.HttpClient.Transport,
+		}
+	}
+
+	if rateLimit := d.Get("rate_limit").(float64); rateLimit > 0 {
+		burst := d.Get("rate_limit_burst").(int)
+		if burst <= 0 {
+			burst = int(math.Ceil(rateLimit))
+		}
+		clientConfig.Limiter = rate.NewLimiter(rate.Limit(rateLimit), burst)
+	} else if rateLimit < 0 {
+		return nil, fmt.Errorf("rate_limit must not be negative")
+	}
 
 	client, err := api.NewClient(clientConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to configure Vault API: %s", err)
 	}
 
-	token, err := providerToken(d)
+	if len(headers) > 0 {
+		client.SetHeaders(headers)
+	}
+
+	setRevokeLeasesOnDestroy(client, d.Get("revoke_leases_on_destroy").(bool))
+
+	if err := providerVaultVersion(d, client); err != nil {
+		return nil, err
+	}
+
+	// the namespace is set before the child token is created, so that the
+	// token lives in the namespace the provider works in. A token from a
+	// parent namespace can still be used to create it.
+	namespace := strings.Trim(d.Get("namespace").(string), "/")
+	if namespace != "" {
+		client.SetNamespace(namespace)
+	}
+
+	token, err := providerAuthLogin(d, client)
 	if err != nil {
 		return nil, err
 	}
+	if token == "" {
+		token, err = providerToken(d)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if token == "" {
 		return nil, errors.New("no vault token found")
 	}
@@ -210,12 +867,42 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	// any secrets that are *written* by Terraform to Vault.
 
 	client.SetToken(token)
-	renewable := false
+	if d.Get("set_namespace_from_token").(bool) && namespace == "" {
+		if err := providerNamespaceFromToken(client); err != nil {
+			return nil, err
+		}
+	}
+
+	// the child token inherits the policies of the token, so its
+	// capabilities are checked before it is created.
+	if required := providerRequiredCapabilities(d); len(required) > 0 {
+		if err := checkTokenCapabilities(client, required); err != nil {
+			return nil, err
+		}
+	}
+
+	renew := d.Get("renew_token").(bool)
+	if d.Get("skip_child_token").(bool) {
+		log.Printf("[INFO] Skipping the creation of a child token, using the given Vault token directly")
+		if renew {
+			if err := providerRenewGivenToken(client, token); err != nil {
+				return nil, err
+			}
+		}
+		return client, nil
+	}
+
+	// a renewed token must not be capped by an explicit max TTL, it is
+	// only bounded by the max TTL of its parent and the system.
+	explicitMaxTTL := fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds").(int))
+	if renew {
+		explicitMaxTTL = ""
+	}
 	childTokenLease, err := client.Auth().Token().Create(&api.TokenCreateRequest{
-		DisplayName:    "terraform",
+		DisplayName:    d.Get("token_name").(string),
 		TTL:            fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds").(int)),
-		ExplicitMaxTTL: fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds").(int)),
-		Renewable:      &renewable,
+		ExplicitMaxTTL: explicitMaxTTL,
+		Renewable:      &renew,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create limited child token: %s", err)
@@ -228,5 +915,101 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	client.SetToken(childToken)
 
+	if renew {
+		if err := startTokenRenewal(client, childTokenLease); err != nil {
+			return nil, fmt.Errorf("failed to start renewing the child token: %s", err)
+		}
+	}
+
 	return client, nil
 }
+
+func providerRequiredCapabilities(d *schema.ResourceData) map[string][]string {
+	required := map[string][]string{}
+	for _, r := range d.Get("required_capabilities").([]interface{}) {
+		r := r.(map[string]interface{})
+		path := r["path"].(string)
+		for _, c := range r["capabilities"].(*schema.Set).List() {
+			required[path] = append(required[path], c.(string))
+		}
+	}
+	return required
+}
+
+// providerVaultVersion records the version of the Vault server, so that
+// resources can tell whether it supports the features they use. It is read
+// from the root namespace, before the provider's namespace is set.
+func providerVaultVersion(d *schema.ResourceData, client *api.Client) error {
+	if v := d.Get("vault_version_override").(string); v != "" {
+		vaultVersion, err := version.NewVersion(v)
+		if err != nil {
+			return fmt.Errorf("failed to parse vault_version_override: %s", err)
+		}
+		setVaultVersion(client, vaultVersion)
+		return nil
+	}
+	if d.Get("skip_get_vault_version").(bool) {
+		return nil
+	}
+
+	status, err := client.Sys().SealStatus()
+	if err != nil {
+		return fmt.Errorf("failed to read the Vault server version, set skip_get_vault_version or vault_version_override if sys/seal-status can't be reached: %s", err)
+	}
+	vaultVersion, err := version.NewVersion(status.Version)
+	if err != nil {
+		return fmt.Errorf("failed to parse the Vault server version %q: %s", status.Version, err)
+	}
+	log.Printf("[DEBUG] Vault server version is %s", vaultVersion)
+	setVaultVersion(client, vaultVersion)
+	return nil
+}
+
+// providerNamespaceFromToken sets the namespace of the client to the one
+// the token was issued in, so that the requests aren't denied because they
+// are sent to the root namespace.
+func providerNamespaceFromToken(client *api.Client) error {
+	secret, err := client.Auth().Token().LookupSelf()
+	if err != nil {
+		return fmt.Errorf("failed to look up the namespace of the Vault token: %s", err)
+	}
+	namespace, _ := secret.Data["namespace_path"].(string)
+	if namespace = strings.Trim(namespace, "/"); namespace != "" {
+		log.Printf("[DEBUG] Using the namespace %q of the Vault token", namespace)
+		client.SetNamespace(namespace)
+	}
+	return nil
+}
+
+// providerRenewGivenToken starts renewing the token given to the provider,
+// when it is used directly instead of a child token.
+func providerRenewGivenToken(client *api.Client, token string) error {
+	secret, err := client.Auth().Token().LookupSelf()
+	if err != nil {
+		return fmt.Errorf("failed to look up the Vault token: %s", err)
+	}
+	renewable, err := secret.TokenIsRenewable()
+	if err != nil {
+		return fmt.Errorf("failed to look up the Vault token: %s", err)
+	}
+	if !renewable {
+		log.Printf("[WARN] The Vault token is not renewable, it can't be renewed while Terraform runs")
+		return nil
+	}
+	ttl, err := secret.TokenTTL()
+	if err != nil {
+		return fmt.Errorf("failed to look up the Vault token: %s", err)
+	}
+
+	err = startTokenRenewal(client, &api.Secret{
+		Auth: &api.SecretAuth{
+			ClientToken:   token,
+			Renewable:     true,
+			LeaseDuration: int(ttl.Seconds()),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start renewing the Vault token: %s", err)
+	}
+	return nil
+}
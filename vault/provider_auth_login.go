@@ -0,0 +1,256 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/helper/consts"
+)
+
+// authLoginSchema returns the schema of an auth_login_* provider block, made
+// of the given method specific fields and the ones all login methods share.
+func authLoginSchema(description, defaultMount string, fields map[string]*schema.Schema) *schema.Schema {
+	fields["mount"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     defaultMount,
+		Description: "The path the auth backend is mounted at.",
+	}
+	fields["namespace"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The namespace to log in to, if different from the provider's namespace.",
+	}
+	fields["use_root_namespace"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Log in to the root namespace, even when the provider's namespace is set.",
+	}
+	fields["mfa"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The MFA method and passcode to complete the login with, if the login requires MFA.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"method_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The ID of the MFA method to use, if the login may be completed with several.",
+				},
+				"passcode": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The passcode, such as a TOTP code, for methods that use one.",
+				},
+			},
+		},
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+// providerAuthLogins are the auth_login_* provider blocks, and the functions
+// logging in with them.
+var providerAuthLogins = []struct {
+	name  string
+	login func(*api.Client, map[string]interface{}) (string, error)
+}{
+	{"auth_login_userpass", authLoginUserpass},
+	{"auth_login_okta", authLoginOkta},
+	{"auth_login_aws", authLoginAWS},
+	{"auth_login_gcp", authLoginGCP},
+	{"auth_login_azure", authLoginAzure},
+	{"auth_login_jwt", authLoginJWT},
+	{"auth_login_cert", authLoginCert},
+	{"auth_login_oidc", authLoginOIDC},
+	{"auth_login_token_file", authLoginTokenFile},
+}
+
+// providerAuthLogin logs in with whichever auth_login_* block is configured
+// and returns the resulting token, or an empty string if there is none.
+func providerAuthLogin(d *schema.ResourceData, client *api.Client) (string, error) {
+	var name string
+	var loginFunc func(*api.Client, map[string]interface{}) (string, error)
+	var login map[string]interface{}
+	for _, l := range providerAuthLogins {
+		blocks := d.Get(l.name).([]interface{})
+		if len(blocks) == 0 {
+			continue
+		}
+		if len(blocks) > 1 {
+			return "", fmt.Errorf("%s block may appear only once", l.name)
+		}
+		if name != "" {
+			return "", fmt.Errorf("only one of %s and %s may be set", name, l.name)
+		}
+		name = l.name
+		loginFunc = l.login
+		login = blocks[0].(map[string]interface{})
+	}
+	if name == "" {
+		return "", nil
+	}
+
+	log.Printf("[DEBUG] Logging in to Vault with %s", name)
+	token, err := loginFunc(client, login)
+	if err != nil {
+		return "", fmt.Errorf("failed to log in to Vault with %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Logged in to Vault with %s", name)
+
+	return token, nil
+}
+
+func authLoginUserpass(client *api.Client, login map[string]interface{}) (string, error) {
+	username := login["username"].(string)
+
+	password := login["password"].(string)
+	if file := login["password_file"].(string); file != "" {
+		if password != "" {
+			return "", fmt.Errorf("only one of password and password_file may be set")
+		}
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("error reading password_file %q: %s", file, err)
+		}
+		password = strings.TrimSpace(string(b))
+	}
+	if password == "" {
+		return "", fmt.Errorf("one of password or password_file must be set")
+	}
+
+	path := authLoginPath(login) + "/" + username
+	return authLoginWrite(client, login, path, map[string]interface{}{
+		"password": password,
+	})
+}
+
+// authLoginCert logs in with the client certificate of the client_auth
+// block, which is presented during the TLS handshake of the request.
+func authLoginCert(client *api.Client, login map[string]interface{}) (string, error) {
+	data := map[string]interface{}{}
+	if v := login["name"].(string); v != "" {
+		data["name"] = v
+	}
+	return authLoginWrite(client, login, authLoginPath(login), data)
+}
+
+// authLoginTokenFile reads the token from a file, such as the sink of a
+// Vault Agent auto-auth, instead of logging in.
+func authLoginTokenFile(client *api.Client, login map[string]interface{}) (string, error) {
+	filename := login["filename"].(string)
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", fmt.Errorf("error reading token file %q: %s", filename, err)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("token file %q is empty", filename)
+	}
+	return token, nil
+}
+
+func authLoginMountPath(login map[string]interface{}) string {
+	return "auth/" + strings.Trim(login["mount"].(string), "/")
+}
+
+func authLoginPath(login map[string]interface{}) string {
+	return authLoginMountPath(login) + "/login"
+}
+
+// authLoginWrite sends a login request and returns the client token from
+// its response.
+func authLoginWrite(client *api.Client, login map[string]interface{}, path string, data map[string]interface{}) (string, error) {
+	secret, err := authLoginRequest(client, login, "PUT", path, nil, data)
+	if err != nil {
+		return "", err
+	}
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		return "", fmt.Errorf("no token in the response from %q", path)
+	}
+
+	return secret.Auth.ClientToken, nil
+}
+
+// authLoginRequest sends a request to the auth backend of a login, and
+// completes the MFA the response requires, if any.
+func authLoginRequest(client *api.Client, login map[string]interface{}, method, path string, params url.Values, data map[string]interface{}) (*api.Secret, error) {
+	body, err := authLoginRawRequest(client, login, method, path, params, data)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := api.ParseSecret(bytes.NewReader(body))
+	if err != nil || secret == nil || secret.Auth == nil || secret.Auth.ClientToken != "" {
+		return secret, err
+	}
+
+	// the MFA requirement is not known to this version of the Vault API
+	var resp struct {
+		Auth struct {
+			MFARequirement *authLoginMFARequirement `json:"mfa_requirement"`
+		} `json:"auth"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Auth.MFARequirement == nil {
+		return secret, nil
+	}
+	return authLoginMFAValidate(client, login, resp.Auth.MFARequirement)
+}
+
+// authLoginRawRequest sends a request to the auth backend of a login and
+// returns the body of the response. The namespace is set on the request
+// only, the headers of the client are shared with every other request the
+// provider makes.
+func authLoginRawRequest(client *api.Client, login map[string]interface{}, method, path string, params url.Values, data map[string]interface{}) ([]byte, error) {
+	r := client.NewRequest(method, "/v1/"+path)
+
+	headers := http.Header{}
+	for k, v := range r.Headers {
+		headers[k] = v
+	}
+	if login["use_root_namespace"].(bool) {
+		headers.Del(consts.NamespaceHeaderName)
+	} else if namespace := strings.Trim(login["namespace"].(string), "/"); namespace != "" {
+		headers.Set(consts.NamespaceHeaderName, namespace)
+	}
+	r.Headers = headers
+
+	for k, v := range params {
+		r.Params[k] = v
+	}
+	if data != nil {
+		if err := r.SetJSONBody(data); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := client.RawRequest(r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
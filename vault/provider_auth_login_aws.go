@@ -0,0 +1,166 @@
+package vault
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/vault/api"
+)
+
+func authLoginAWS(client *api.Client, login map[string]interface{}) (string, error) {
+	region := login["region"].(string)
+
+	sessionName := login["role_session_name"].(string)
+	if sessionName == "" {
+		sessionName = os.Getenv("AWS_ROLE_SESSION_NAME")
+	}
+	if sessionName == "" {
+		sessionName = "terraform-provider-vault"
+	}
+
+	creds, err := authLoginAWSCredentials(login["profile"].(string), login["web_identity_token_file"].(string), login["web_identity_role_arn"].(string), sessionName, region)
+	if err != nil {
+		return "", err
+	}
+	if roleARN := login["role_arn"].(string); roleARN != "" {
+		creds, err = authLoginAWSAssumeRoleCredentials(creds, roleARN, sessionName, login["external_id"].(string), region)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: creds,
+		Region:      aws.String(region),
+		HTTPClient:  cleanhttp.DefaultClient(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error creating AWS session: %s", err)
+	}
+
+	stsRequest, _ := sts.New(sess).GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+	if v := login["header_value"].(string); v != "" {
+		stsRequest.HTTPRequest.Header.Add("X-Vault-AWS-IAM-Server-ID", v)
+	}
+	if err := stsRequest.Sign(); err != nil {
+		return "", fmt.Errorf("error signing GetCallerIdentity request: %s", err)
+	}
+
+	headers, err := json.Marshal(stsRequest.HTTPRequest.Header)
+	if err != nil {
+		return "", fmt.Errorf("error encoding GetCallerIdentity request headers: %s", err)
+	}
+	body, err := ioutil.ReadAll(stsRequest.HTTPRequest.Body)
+	if err != nil {
+		return "", fmt.Errorf("error reading GetCallerIdentity request body: %s", err)
+	}
+
+	return authLoginWrite(client, login, authLoginPath(login), map[string]interface{}{
+		"role":                    login["role"].(string),
+		"iam_http_request_method": stsRequest.HTTPRequest.Method,
+		"iam_request_url":         base64.StdEncoding.EncodeToString([]byte(stsRequest.HTTPRequest.URL.String())),
+		"iam_request_headers":     base64.StdEncoding.EncodeToString(headers),
+		"iam_request_body":        base64.StdEncoding.EncodeToString(body),
+	})
+}
+
+// authLoginAWSCredentials returns the AWS credentials to sign the request
+// with: those of the web identity token file and role, which default to
+// AWS_WEB_IDENTITY_TOKEN_FILE and AWS_ROLE_ARN, if they are set, otherwise
+// the environment, the profile in the shared config files and the ECS or
+// EC2 instance role.
+func authLoginAWSCredentials(profile, tokenFile, roleARN, sessionName, region string) (*credentials.Credentials, error) {
+	if tokenFile == "" {
+		tokenFile = os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+	}
+	if roleARN == "" {
+		roleARN = os.Getenv("AWS_ROLE_ARN")
+	}
+	if tokenFile != "" && roleARN != "" {
+		return authLoginAWSWebIdentityCredentials(tokenFile, roleARN, sessionName, region)
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			HTTPClient: cleanhttp.DefaultClient(),
+		},
+		Profile:           profile,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %s", err)
+	}
+	if _, err := sess.Config.Credentials.Get(); err != nil {
+		return nil, fmt.Errorf("error retrieving AWS credentials: %s", err)
+	}
+
+	return sess.Config.Credentials, nil
+}
+
+// authLoginAWSWebIdentityCredentials exchanges the web identity token, e.g.
+// an EKS service account token, for temporary credentials of the role.
+func authLoginAWSWebIdentityCredentials(tokenFile, roleARN, sessionName, region string) (*credentials.Credentials, error) {
+	token, err := ioutil.ReadFile(tokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading web identity token file %q: %s", tokenFile, err)
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Region:      aws.String(region),
+		HTTPClient:  cleanhttp.DefaultClient(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %s", err)
+	}
+
+	resp, err := sts.New(sess).AssumeRoleWithWebIdentity(&sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(roleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(strings.TrimSpace(string(token))),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error assuming role %q with web identity: %s", roleARN, err)
+	}
+
+	return credentials.NewStaticCredentials(
+		*resp.Credentials.AccessKeyId,
+		*resp.Credentials.SecretAccessKey,
+		*resp.Credentials.SessionToken,
+	), nil
+}
+
+// authLoginAWSAssumeRoleCredentials assumes the role with the given
+// credentials, e.g. to log in as a role of another account.
+func authLoginAWSAssumeRoleCredentials(creds *credentials.Credentials, roleARN, sessionName, externalID, region string) (*credentials.Credentials, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: creds,
+		Region:      aws.String(region),
+		HTTPClient:  cleanhttp.DefaultClient(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %s", err)
+	}
+
+	assumed := stscreds.NewCredentials(sess, roleARN, func(p *stscreds.AssumeRoleProvider) {
+		p.RoleSessionName = sessionName
+		if externalID != "" {
+			p.ExternalID = aws.String(externalID)
+		}
+	})
+	if _, err := assumed.Get(); err != nil {
+		return nil, fmt.Errorf("error assuming role %q: %s", roleARN, err)
+	}
+
+	return assumed, nil
+}
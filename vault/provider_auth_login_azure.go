@@ -0,0 +1,124 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/vault/api"
+)
+
+func authLoginAzure(client *api.Client, login map[string]interface{}) (string, error) {
+	httpClient := cleanhttp.DefaultClient()
+	resource := login["resource"].(string)
+	clientID := login["client_id"].(string)
+
+	var jwt string
+	var err error
+	if tokenFile := os.Getenv("AZURE_FEDERATED_TOKEN_FILE"); tokenFile != "" {
+		jwt, err = authLoginAzureWorkloadIdentityToken(httpClient, tokenFile, clientID, login["tenant_id"].(string), resource)
+	} else {
+		jwt, err = authLoginAzureMSIToken(httpClient, clientID, resource)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	data := map[string]interface{}{
+		"role": login["role"].(string),
+		"jwt":  jwt,
+	}
+	for _, k := range []string{"subscription_id", "resource_group_name", "vm_name", "vmss_name"} {
+		if v := login[k].(string); v != "" {
+			data[k] = v
+		}
+	}
+
+	return authLoginWrite(client, login, authLoginPath(login), data)
+}
+
+// authLoginAzureMSIToken fetches an access token of the managed identity of
+// the VM from the instance metadata service. The client ID selects one of
+// several user assigned identities.
+func authLoginAzureMSIToken(httpClient *http.Client, clientID, resource string) (string, error) {
+	params := url.Values{
+		"api-version": {"2018-02-01"},
+		"resource":    {resource},
+	}
+	if clientID != "" {
+		params.Set("client_id", clientID)
+	}
+
+	req, err := http.NewRequest("GET", "http://169.254.169.254/metadata/identity/oauth2/token?"+params.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata", "true")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error fetching managed identity token: %s", err)
+	}
+	return authLoginAzureAccessToken(resp)
+}
+
+// authLoginAzureWorkloadIdentityToken exchanges the federated token that
+// workload identity projects into the pod for an Azure AD access token.
+func authLoginAzureWorkloadIdentityToken(httpClient *http.Client, tokenFile, clientID, tenantID, resource string) (string, error) {
+	if clientID == "" {
+		clientID = os.Getenv("AZURE_CLIENT_ID")
+	}
+	if tenantID == "" {
+		tenantID = os.Getenv("AZURE_TENANT_ID")
+	}
+	if clientID == "" || tenantID == "" {
+		return "", fmt.Errorf("client_id and tenant_id must be set to use workload identity")
+	}
+
+	assertion, err := ioutil.ReadFile(tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("error reading federated token file %q: %s", tokenFile, err)
+	}
+
+	authorityHost := os.Getenv("AZURE_AUTHORITY_HOST")
+	if authorityHost == "" {
+		authorityHost = "https://login.microsoftonline.com/"
+	}
+
+	form := url.Values{
+		"grant_type":            {"client_credentials"},
+		"client_id":             {clientID},
+		"scope":                 {strings.TrimSuffix(resource, "/") + "/.default"},
+		"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+		"client_assertion":      {strings.TrimSpace(string(assertion))},
+	}
+	resp, err := httpClient.PostForm(strings.TrimSuffix(authorityHost, "/")+"/"+url.PathEscape(tenantID)+"/oauth2/v2.0/token", form)
+	if err != nil {
+		return "", fmt.Errorf("error fetching workload identity token: %s", err)
+	}
+	return authLoginAzureAccessToken(resp)
+}
+
+func authLoginAzureAccessToken(resp *http.Response) (string, error) {
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Azure AD token request returned status %d", resp.StatusCode)
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("error decoding Azure AD token: %s", err)
+	}
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("no access token in the Azure AD token response")
+	}
+
+	return token.AccessToken, nil
+}
@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+func TestAuthLoginAzureWorkloadIdentityToken(t *testing.T) {
+	tokenFile, err := ioutil.TempFile("", "terraform-provider-vault")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tokenFile.Name())
+	if _, err := tokenFile.WriteString("federated-token\n"); err != nil {
+		t.Fatal(err)
+	}
+	tokenFile.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tenant/oauth2/v2.0/token" {
+			t.Errorf("bad token request path: %q", r.URL.Path)
+		}
+		expected := map[string]string{
+			"grant_type":       "client_credentials",
+			"client_id":        "client",
+			"scope":            "https://management.azure.com/.default",
+			"client_assertion": "federated-token",
+		}
+		for k, v := range expected {
+			if got := r.PostFormValue(k); got != v {
+				t.Errorf("bad %s: want %#v, got %#v", k, v, got)
+			}
+		}
+		fmt.Fprint(w, `{"access_token": "access-token"}`)
+	}))
+	defer server.Close()
+
+	origAuthorityHost, ok := os.LookupEnv("AZURE_AUTHORITY_HOST")
+	if ok {
+		defer os.Setenv("AZURE_AUTHORITY_HOST", origAuthorityHost)
+	} else {
+		defer os.Unsetenv("AZURE_AUTHORITY_HOST")
+	}
+	os.Setenv("AZURE_AUTHORITY_HOST", server.URL+"/")
+
+	token, err := authLoginAzureWorkloadIdentityToken(cleanhttp.DefaultClient(), tokenFile.Name(), "client", "tenant", "https://management.azure.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "access-token" {
+		t.Errorf("bad token: want %#v, got %#v", "access-token", token)
+	}
+}
@@ -0,0 +1,206 @@
+package vault
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/vault/api"
+)
+
+func authLoginGCP(client *api.Client, login map[string]interface{}) (string, error) {
+	role := login["role"].(string)
+	exp := time.Now().Add(time.Duration(login["jwt_ttl"].(int)) * time.Second)
+	httpClient := cleanhttp.DefaultClient()
+
+	var jwt string
+	var err error
+	switch {
+	case login["credentials"].(string) != "":
+		jwt, err = authLoginGCPSelfSignedJWT(login["credentials"].(string), role, exp)
+	case login["service_account"].(string) != "":
+		jwt, err = authLoginGCPSignJWT(httpClient, login["service_account"].(string), role, exp)
+	default:
+		jwt, err = authLoginGCPIdentityToken(httpClient, role)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return authLoginWrite(client, login, authLoginPath(login), map[string]interface{}{
+		"role": role,
+		"jwt":  jwt,
+	})
+}
+
+// authLoginGCPClaims returns the claims of the JWT an iam type role expects.
+func authLoginGCPClaims(serviceAccount, role string, exp time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"sub": serviceAccount,
+		"aud": "vault/" + role,
+		"exp": exp.Unix(),
+	}
+}
+
+// authLoginGCPSelfSignedJWT signs the JWT with the private key of the given
+// service account key, for iam type roles.
+func authLoginGCPSelfSignedJWT(credentials, role string, exp time.Time) (string, error) {
+	var key struct {
+		ClientEmail  string `json:"client_email"`
+		PrivateKeyID string `json:"private_key_id"`
+		PrivateKey   string `json:"private_key"`
+	}
+	if err := json.Unmarshal([]byte(credentials), &key); err != nil {
+		return "", fmt.Errorf("error decoding GCP credentials: %s", err)
+	}
+
+	block, _ := pem.Decode([]byte(key.PrivateKey))
+	if block == nil {
+		return "", fmt.Errorf("no PEM encoded private key in GCP credentials")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("error parsing GCP credentials private key: %s", err)
+	}
+	privateKey, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return "", fmt.Errorf("GCP credentials private key is not an RSA key")
+	}
+
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+		"kid": key.PrivateKeyID,
+	})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(authLoginGCPClaims(key.ClientEmail, role, exp))
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("error signing JWT: %s", err)
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// authLoginGCPSignJWT has the IAM credentials API sign the JWT for the given
+// service account, authenticating as the instance's service account. This
+// is for iam type roles.
+func authLoginGCPSignJWT(httpClient *http.Client, serviceAccount, role string, exp time.Time) (string, error) {
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := authLoginGCPMetadata(httpClient, "instance/service-accounts/default/token", nil, &token); err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(authLoginGCPClaims(serviceAccount, role, exp))
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(map[string]string{
+		"payload": string(claims),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/" + url.PathEscape(serviceAccount) + ":signJwt"
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error signing JWT for %q: %s", serviceAccount, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error signing JWT for %q: status %d", serviceAccount, resp.StatusCode)
+	}
+
+	var signed struct {
+		SignedJwt string `json:"signedJwt"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&signed); err != nil {
+		return "", fmt.Errorf("error decoding signed JWT for %q: %s", serviceAccount, err)
+	}
+
+	return signed.SignedJwt, nil
+}
+
+// authLoginGCPIdentityToken fetches an identity token of the instance from
+// the metadata server, for gce type roles.
+func authLoginGCPIdentityToken(httpClient *http.Client, role string) (string, error) {
+	var token string
+	params := url.Values{
+		"audience": {"http://vault/" + role},
+		"format":   {"full"},
+	}
+	if err := authLoginGCPMetadata(httpClient, "instance/service-accounts/default/identity", params, &token); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// authLoginGCPMetadata reads a value from the metadata server, decoding it
+// from JSON unless v is a string.
+func authLoginGCPMetadata(httpClient *http.Client, path string, params url.Values, v interface{}) error {
+	host := os.Getenv("GCE_METADATA_HOST")
+	if host == "" {
+		host = "metadata.google.internal"
+	}
+
+	endpoint := "http://" + host + "/computeMetadata/v1/" + path
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error reading %q from the GCP metadata server: %s", path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error reading %q from the GCP metadata server: status %d", path, resp.StatusCode)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading %q from the GCP metadata server: %s", path, err)
+	}
+	if s, ok := v.(*string); ok {
+		*s = strings.TrimSpace(string(b))
+		return nil
+	}
+	return json.Unmarshal(b, v)
+}
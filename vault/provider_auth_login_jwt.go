@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/vault/api"
+)
+
+// kubernetesServiceAccountTokenFile is where the token of the pod's service
+// account is mounted.
+const kubernetesServiceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+func authLoginJWT(client *api.Client, login map[string]interface{}) (string, error) {
+	jwt, err := authLoginJWTToken(cleanhttp.DefaultClient(), login)
+	if err != nil {
+		return "", err
+	}
+
+	return authLoginWrite(client, login, authLoginPath(login), map[string]interface{}{
+		"role": login["role"].(string),
+		"jwt":  jwt,
+	})
+}
+
+// authLoginJWTToken returns the JWT to log in with: the configured one, the
+// contents of jwt_file, the OIDC token of a GitHub Actions job, or the
+// token of the Kubernetes service account, in that order.
+func authLoginJWTToken(httpClient *http.Client, login map[string]interface{}) (string, error) {
+	jwt := login["jwt"].(string)
+	file := login["jwt_file"].(string)
+	if jwt != "" && file != "" {
+		return "", fmt.Errorf("only one of jwt and jwt_file may be set")
+	}
+	if jwt != "" {
+		return jwt, nil
+	}
+
+	if file == "" {
+		if requestURL := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL"); requestURL != "" {
+			return authLoginJWTGitHubActionsToken(httpClient, requestURL, os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN"), login["audience"].(string))
+		}
+		file = kubernetesServiceAccountTokenFile
+	}
+
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("error reading JWT file %q: %s", file, err)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// authLoginJWTGitHubActionsToken requests the OIDC token of the running
+// GitHub Actions job, which needs the id-token: write permission.
+func authLoginJWTGitHubActionsToken(httpClient *http.Client, requestURL, requestToken, audience string) (string, error) {
+	if audience != "" {
+		u, err := url.Parse(requestURL)
+		if err != nil {
+			return "", fmt.Errorf("error parsing ACTIONS_ID_TOKEN_REQUEST_URL: %s", err)
+		}
+		q := u.Query()
+		q.Set("audience", audience)
+		u.RawQuery = q.Encode()
+		requestURL = u.String()
+	}
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+requestToken)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error requesting GitHub Actions OIDC token: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GitHub Actions OIDC token request returned status %d", resp.StatusCode)
+	}
+
+	var token struct {
+		Value string `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("error decoding GitHub Actions OIDC token: %s", err)
+	}
+
+	return token.Value, nil
+}
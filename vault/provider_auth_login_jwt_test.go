@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+func TestAuthLoginJWTToken(t *testing.T) {
+	jwtFile, err := ioutil.TempFile("", "terraform-provider-vault")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(jwtFile.Name())
+	if _, err := jwtFile.WriteString("file-jwt\n"); err != nil {
+		t.Fatal(err)
+	}
+	jwtFile.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("Authorization"); v != "Bearer request-token" {
+			t.Errorf("bad authorization header: %q", v)
+		}
+		if v := r.URL.Query().Get("audience"); v != "vault" {
+			t.Errorf("bad audience: %q", v)
+		}
+		fmt.Fprint(w, `{"value": "actions-jwt"}`)
+	}))
+	defer server.Close()
+
+	for _, k := range []string{"ACTIONS_ID_TOKEN_REQUEST_URL", "ACTIONS_ID_TOKEN_REQUEST_TOKEN"} {
+		if v, ok := os.LookupEnv(k); ok {
+			defer os.Setenv(k, v)
+		} else {
+			defer os.Unsetenv(k)
+		}
+	}
+	os.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", server.URL+"/token?api-version=2.0")
+	os.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "request-token")
+
+	tests := []struct {
+		name        string
+		jwt         string
+		jwtFile     string
+		expectedJWT string
+	}{
+		{
+			name:        "JWT",
+			jwt:         "schema-jwt",
+			expectedJWT: "schema-jwt",
+		},
+		{
+			name:        "File",
+			jwtFile:     jwtFile.Name(),
+			expectedJWT: "file-jwt",
+		},
+		{
+			name:        "GitHubActions",
+			expectedJWT: "actions-jwt",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			jwt, err := authLoginJWTToken(cleanhttp.DefaultClient(), map[string]interface{}{
+				"jwt":      tc.jwt,
+				"jwt_file": tc.jwtFile,
+				"audience": "vault",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if jwt != tc.expectedJWT {
+				t.Errorf("bad JWT: want %#v, got %#v", tc.expectedJWT, jwt)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// authLoginMFARequirement is returned instead of a token by the logins that
+// require MFA, which are completed with sys/mfa/validate.
+type authLoginMFARequirement struct {
+	MFARequestID   string `json:"mfa_request_id"`
+	MFAConstraints map[string]struct {
+		Any []struct {
+			Type         string `json:"type"`
+			ID           string `json:"id"`
+			UsesPasscode bool   `json:"uses_passcode"`
+		} `json:"any"`
+	} `json:"mfa_constraints"`
+}
+
+// authLoginMFAValidate completes the MFA of a login with the method and
+// passcode of the mfa block. Each constraint is satisfied by the configured
+// method, or by its first one. Methods without a passcode, such as Okta or
+// Duo push, wait for the login to be approved on the user's device.
+func authLoginMFAValidate(client *api.Client, login map[string]interface{}, requirement *authLoginMFARequirement) (*api.Secret, error) {
+	methodID := ""
+	passcode := ""
+	if mfa := login["mfa"].([]interface{}); len(mfa) > 0 && mfa[0] != nil {
+		methodID = mfa[0].(map[string]interface{})["method_id"].(string)
+		passcode = mfa[0].(map[string]interface{})["passcode"].(string)
+	}
+
+	payload := map[string]interface{}{}
+	for name, constraint := range requirement.MFAConstraints {
+		if len(constraint.Any) == 0 {
+			return nil, fmt.Errorf("MFA constraint %q has no methods", name)
+		}
+		method := constraint.Any[0]
+		for _, m := range constraint.Any {
+			if m.ID == methodID {
+				method = m
+				break
+			}
+		}
+
+		if !method.UsesPasscode {
+			log.Printf("[WARN] Approve the login to Vault with the %s MFA method %q of %q", method.Type, method.ID, name)
+			payload[method.ID] = []string{}
+			continue
+		}
+		if passcode == "" {
+			return nil, fmt.Errorf("the %s MFA method %q of %q requires a passcode, set it in the mfa block", method.Type, method.ID, name)
+		}
+		payload[method.ID] = []string{passcode}
+	}
+
+	body, err := authLoginRawRequest(client, login, "PUT", "sys/mfa/validate", nil, map[string]interface{}{
+		"mfa_request_id": requirement.MFARequestID,
+		"mfa_payload":    payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error validating MFA: %s", err)
+	}
+	return api.ParseSecret(bytes.NewReader(body))
+}
@@ -0,0 +1,116 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/vault/api"
+)
+
+// authLoginOIDC runs the OIDC authorization code flow like
+// `vault login -method=oidc`: the browser is sent to the provider, which
+// redirects back to a listener on the local machine with the code.
+func authLoginOIDC(client *api.Client, login map[string]interface{}) (string, error) {
+	mount := authLoginMountPath(login)
+	redirectURI := login["redirect_uri"].(string)
+	redirect, err := url.Parse(redirectURI)
+	if err != nil {
+		return "", fmt.Errorf("error parsing redirect_uri %q: %s", redirectURI, err)
+	}
+
+	nonce, err := uuid.GenerateUUID()
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := authLoginRequest(client, login, "PUT", mount+"/oidc/auth_url", nil, map[string]interface{}{
+		"role":         login["role"].(string),
+		"redirect_uri": redirectURI,
+		"client_nonce": nonce,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error requesting the OIDC auth URL: %s", err)
+	}
+	authURL := ""
+	if secret != nil {
+		authURL, _ = secret.Data["auth_url"].(string)
+	}
+	if authURL == "" {
+		return "", fmt.Errorf("no OIDC auth URL returned, check that redirect_uri %q is allowed by the role", redirectURI)
+	}
+
+	listener, err := net.Listen("tcp", login["listen_address"].(string))
+	if err != nil {
+		return "", fmt.Errorf("error starting the OIDC callback listener: %s", err)
+	}
+	defer listener.Close()
+
+	callbacks := make(chan url.Values, 1)
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != redirect.Path {
+				http.NotFound(w, r)
+				return
+			}
+			fmt.Fprint(w, "Vault login complete, you can close this window and return to Terraform.")
+			select {
+			case callbacks <- r.URL.Query():
+			default:
+			}
+		}),
+	}
+	go server.Serve(listener)
+	defer server.Close()
+
+	log.Printf("[INFO] Complete the OIDC login to Vault in your browser: %s", authURL)
+	if !login["skip_browser"].(bool) {
+		if err := authLoginOIDCOpenBrowser(authURL); err != nil {
+			log.Printf("[WARN] Failed to open the browser for the OIDC login to Vault: %s", err)
+		}
+	}
+
+	var callback url.Values
+	timeout := time.Duration(login["timeout"].(int)) * time.Second
+	select {
+	case callback = <-callbacks:
+	case <-time.After(timeout):
+		return "", fmt.Errorf("timed out waiting for the OIDC login to complete")
+	}
+	if v := callback.Get("error"); v != "" {
+		return "", fmt.Errorf("OIDC login failed: %s: %s", v, callback.Get("error_description"))
+	}
+
+	secret, err = authLoginRequest(client, login, "GET", mount+"/oidc/callback", url.Values{
+		"state":        {callback.Get("state")},
+		"code":         {callback.Get("code")},
+		"client_nonce": {nonce},
+	}, nil)
+	if err != nil {
+		return "", fmt.Errorf("error completing the OIDC login: %s", err)
+	}
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		return "", fmt.Errorf("no token in the OIDC callback response")
+	}
+
+	return secret.Auth.ClientToken, nil
+}
+
+func authLoginOIDCOpenBrowser(u string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", u)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", u)
+	default:
+		cmd = exec.Command("xdg-open", u)
+	}
+	return cmd.Start()
+}
@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAuthLoginOIDC(t *testing.T) {
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/auth/oidc/oidc/auth_url":
+			fmt.Fprint(w, `{"data": {"auth_url": "https://idp.example.com/auth"}}`)
+		case "/v1/auth/oidc/oidc/callback":
+			q := r.URL.Query()
+			if q.Get("state") != "state" || q.Get("code") != "code" || q.Get("client_nonce") == "" {
+				t.Errorf("bad callback query: %q", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"auth": {"client_token": "oidc-token"}}`)
+		default:
+			t.Errorf("unexpected request to %q", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer vault.Close()
+
+	config := api.DefaultConfig()
+	config.Address = vault.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// find a free port for the callback listener
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	listenAddress := l.Addr().String()
+	l.Close()
+
+	// play the browser, which is redirected back with the code
+	go func() {
+		for i := 0; i < 50; i++ {
+			resp, err := http.Get("http://" + listenAddress + "/oidc/callback?state=state&code=code")
+			if err == nil {
+				resp.Body.Close()
+				return
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+	}()
+
+	token, err := authLoginOIDC(client, map[string]interface{}{
+		"mount":              "oidc",
+		"namespace":          "",
+		"use_root_namespace": false,
+		"role":               "",
+		"redirect_uri":       "http://" + listenAddress + "/oidc/callback",
+		"listen_address":     listenAddress,
+		"skip_browser":       true,
+		"timeout":            10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "oidc-token" {
+		t.Errorf("bad token: want %#v, got %#v", "oidc-token", token)
+	}
+}
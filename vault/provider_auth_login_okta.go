@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/vault/api"
+)
+
+// authLoginOkta logs in with the Okta auth backend. If the Okta user is
+// enrolled in Okta Verify push, the login waits for the push to be approved,
+// and the number to select with the Okta number challenge is logged. The
+// logs of the provider are only shown with TF_LOG, so the number is also
+// added to the error of a failed login.
+func authLoginOkta(client *api.Client, login map[string]interface{}) (string, error) {
+	nonce, err := uuid.GenerateUUID()
+	if err != nil {
+		return "", err
+	}
+
+	data := map[string]interface{}{
+		"password": login["password"].(string),
+		"nonce":    nonce,
+	}
+	for _, k := range []string{"totp", "provider"} {
+		if v := login[k].(string); v != "" {
+			data[k] = v
+		}
+	}
+
+	done := make(chan struct{})
+	answers := make(chan interface{}, 1)
+	go authLoginOktaNumberChallenge(client, login, authLoginMountPath(login)+"/verify/"+nonce, answers, done)
+
+	token, err := authLoginWrite(client, login, authLoginPath(login)+"/"+login["username"].(string), data)
+	close(done)
+	if err != nil {
+		select {
+		case answer := <-answers:
+			return "", fmt.Errorf("%s (the Okta number challenge was %v, set TF_LOG=WARN to see it while the login waits)", err, answer)
+		default:
+		}
+	}
+	return token, err
+}
+
+// authLoginOktaNumberChallenge polls the number challenge of the push sent
+// for the login, until it is known or the login is done. The number is
+// logged and sent to answers.
+func authLoginOktaNumberChallenge(client *api.Client, login map[string]interface{}, path string, answers chan<- interface{}, done chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case <-time.After(time.Second):
+		}
+
+		body, err := authLoginRawRequest(client, login, "GET", path, nil, nil)
+		if err != nil {
+			// the challenge is not known before the push is sent
+			continue
+		}
+		secret, err := api.ParseSecret(bytes.NewReader(body))
+		if err != nil || secret == nil {
+			continue
+		}
+		if answer := secret.Data["correct_answer"]; answer != nil {
+			log.Printf("[WARN] Select %v in the Okta Verify app to approve the login to Vault", answer)
+			answers <- answer
+			return
+		}
+	}
+}
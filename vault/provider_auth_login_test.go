@@ -0,0 +1,226 @@
+package vault
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAuthLoginTokenFile(t *testing.T) {
+	tokenFile, err := ioutil.TempFile("", "terraform-provider-vault")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tokenFile.Name())
+	if _, err := tokenFile.WriteString("sink-token\n"); err != nil {
+		t.Fatal(err)
+	}
+	tokenFile.Close()
+
+	token, err := authLoginTokenFile(nil, map[string]interface{}{
+		"filename": tokenFile.Name(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "sink-token" {
+		t.Errorf("bad token: want %#v, got %#v", "sink-token", token)
+	}
+
+	if err := ioutil.WriteFile(tokenFile.Name(), []byte("\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := authLoginTokenFile(nil, map[string]interface{}{
+		"filename": tokenFile.Name(),
+	}); err == nil {
+		t.Error("expected an error reading an empty token file")
+	}
+}
+
+func TestAuthLoginMFA(t *testing.T) {
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/auth/userpass/login/alice":
+			fmt.Fprint(w, `{"auth": {"client_token": "", "mfa_requirement": {
+				"mfa_request_id": "request-id",
+				"mfa_constraints": {
+					"totp": {"any": [{"type": "totp", "id": "totp-id", "uses_passcode": true}]},
+					"push": {"any": [{"type": "duo", "id": "duo-id", "uses_passcode": false}]}
+				}
+			}}}`)
+		case "/v1/sys/mfa/validate":
+			var data struct {
+				MFARequestID string              `json:"mfa_request_id"`
+				MFAPayload   map[string][]string `json:"mfa_payload"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+				t.Error(err)
+				return
+			}
+			if data.MFARequestID != "request-id" {
+				t.Errorf("bad MFA request ID: %q", data.MFARequestID)
+			}
+			if p := data.MFAPayload["totp-id"]; len(p) != 1 || p[0] != "123456" {
+				t.Errorf("bad totp payload: %#v", p)
+			}
+			if p, ok := data.MFAPayload["duo-id"]; !ok || len(p) != 0 {
+				t.Errorf("bad duo payload: %#v", p)
+			}
+			fmt.Fprint(w, `{"auth": {"client_token": "mfa-token"}}`)
+		default:
+			t.Errorf("unexpected request to %q", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer vault.Close()
+
+	config := api.DefaultConfig()
+	config.Address = vault.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := authLoginUserpass(client, map[string]interface{}{
+		"mount":              "userpass",
+		"namespace":          "",
+		"use_root_namespace": false,
+		"username":           "alice",
+		"password":           "password",
+		"password_file":      "",
+		"mfa": []interface{}{
+			map[string]interface{}{
+				"method_id": "totp-id",
+				"passcode":  "123456",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "mfa-token" {
+		t.Errorf("bad token: want %#v, got %#v", "mfa-token", token)
+	}
+}
+
+func TestAuthLoginOktaNumberChallenge(t *testing.T) {
+	verified := make(chan struct{})
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v1/auth/okta/login/alice":
+			// fail the login once the number challenge was read, as when
+			// the push is rejected.
+			select {
+			case <-verified:
+				time.Sleep(100 * time.Millisecond)
+			case <-time.After(5 * time.Second):
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errors": ["push rejected"]}`)
+		case strings.HasPrefix(r.URL.Path, "/v1/auth/okta/verify/"):
+			fmt.Fprint(w, `{"data": {"correct_answer": 42}}`)
+			select {
+			case <-verified:
+			default:
+				close(verified)
+			}
+		default:
+			t.Errorf("unexpected request to %q", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer vault.Close()
+
+	config := api.DefaultConfig()
+	config.Address = vault.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = authLoginOkta(client, map[string]interface{}{
+		"mount":              "okta",
+		"namespace":          "",
+		"use_root_namespace": false,
+		"username":           "alice",
+		"password":           "password",
+		"totp":               "",
+		"provider":           "",
+		"mfa":                []interface{}{},
+	})
+	if err == nil {
+		t.Fatal("expected the login to fail")
+	}
+	if !strings.Contains(err.Error(), "Okta number challenge was 42") {
+		t.Errorf("expected the error to include the number challenge, got %q", err)
+	}
+}
+
+func TestAuthLoginCert(t *testing.T) {
+	certPEM, keyPEM := testGenerateCertificate(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the Vault API writes with PUT, which Vault handles like POST.
+		if (r.Method != "PUT" && r.Method != "POST") || r.URL.Path != "/v1/auth/tls/login" {
+			t.Errorf("unexpected request %s %q", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		if len(r.TLS.PeerCertificates) == 0 || r.TLS.PeerCertificates[0].Subject.CommonName != "terraform" {
+			t.Errorf("the client_auth certificate was not presented")
+		}
+		var data map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Error(err)
+			return
+		}
+		if data["name"] != "web" {
+			t.Errorf("bad role name: %#v", data["name"])
+		}
+		fmt.Fprint(w, `{"auth": {"client_token": "cert-token"}}`)
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAnyClientCert,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+	d := providerResource.TestResourceData()
+	d.Set("address", server.URL)
+	d.Set("skip_child_token", true)
+	d.Set("skip_get_vault_version", true)
+	d.Set("skip_tls_verify", true)
+	d.Set("client_auth", []interface{}{
+		map[string]interface{}{
+			"cert_pem": certPEM,
+			"key_pem":  keyPEM,
+		},
+	})
+	d.Set("auth_login_cert", []interface{}{
+		map[string]interface{}{
+			"mount": "tls",
+			"name":  "web",
+		},
+	})
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token := meta.(*api.Client).Token(); token != "cert-token" {
+		t.Errorf("bad token: want %#v, got %#v", "cert-token", token)
+	}
+}
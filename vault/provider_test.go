@@ -1,14 +1,34 @@
 package vault
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/command/config"
+	"github.com/hashicorp/vault/helper/consts"
 	"github.com/mitchellh/go-homedir"
 	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
+	"path/filepath"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
@@ -16,29 +36,20 @@ import (
 
 // How to run the acceptance tests for this provider:
 //
-// - Obtain an official Vault release from the Vault website at
-//   https://vaultproject.io/ and extract the "vault" binary
-//   somewhere.
+// - Install Docker and run the Terraform acceptance tests as usual:
+//       make testacc TEST=./vault
 //
-// - Run the following to start the Vault server in development mode:
-//       vault server -dev
+//   TestMain starts a Vault dev server in Docker for the tests, and stops it
+//   once they are done.
 //
-// - Take the "Root Token" value printed by Vault as the server started
-//   up and set it as the value of the VAULT_TOKEN environment variable
-//   in a new shell whose current working directory is the root of the
-//   Terraform repository.
+// - To run the tests against another Vault, e.g. one started with
+//   "vault server -dev", set its address and root token in the VAULT_ADDR
+//   and VAULT_TOKEN environment variables.
 //
-// - As directed by the Vault server output, set the VAULT_ADDR environment
-//   variable. e.g.:
-//       export VAULT_ADDR='http://127.0.0.1:8200'
-//
-// - Run the Terraform acceptance tests as usual:
-//       make testacc TEST=./builtin/providers/vault
-//
-// The tests expect to be run in a fresh, empty Vault and thus do not attempt
-// to randomize or otherwise make the generated resource paths unique on
-// each run. In case of weird behavior, restart the Vault dev server to
-// start over with a fresh Vault. (Remember to reset VAULT_TOKEN.)
+// Most tests randomize the generated resource paths, but some expect to be
+// run in a fresh, empty Vault. In case of weird behavior, restart the Vault
+// dev server to start over with a fresh Vault, or run "make sweep" to remove
+// the objects left over by failed tests.
 
 func TestProvider(t *testing.T) {
 	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
@@ -46,6 +57,45 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+// secretFieldRegex matches the names of the fields that hold tokens,
+// passwords, private keys and other secrets.
+var secretFieldRegex = regexp.MustCompile(`(^|_)(token|password|bindpass|secret|secret_id|secret_key|access_key|private_key|jwt|passcode|credentials|data_json|tag_value)$`)
+
+// nonSensitiveFields are the fields matching secretFieldRegex that are
+// deliberately not marked Sensitive, with the reason why.
+var nonSensitiveFields = map[string]string{
+	"vault_client_counts.data_json":                 "holds usage counts, not secrets",
+	"vault_generic_endpoint.nonsensitive_data_json": "only set when the user opts out of sensitive with sensitive = false",
+}
+
+// testCheckSensitiveSchema fails the test for each string field of s, or of
+// its nested blocks, that holds a secret but isn't marked Sensitive.
+func testCheckSensitiveSchema(t *testing.T, name string, s map[string]*schema.Schema) {
+	for k, v := range s {
+		secret := v.Type == schema.TypeString && secretFieldRegex.MatchString(k)
+		if _, ok := nonSensitiveFields[name+"."+k]; ok {
+			secret = false
+		}
+		if secret && !v.Sensitive {
+			t.Errorf("%s.%s holds a secret and must be marked Sensitive", name, k)
+		}
+		if r, ok := v.Elem.(*schema.Resource); ok {
+			testCheckSensitiveSchema(t, name+"."+k, r.Schema)
+		}
+	}
+}
+
+func TestProviderSensitiveFields(t *testing.T) {
+	p := Provider().(*schema.Provider)
+	testCheckSensitiveSchema(t, "provider", p.Schema)
+	for name, r := range p.ResourcesMap {
+		testCheckSensitiveSchema(t, name, r.Schema)
+	}
+	for name, r := range p.DataSourcesMap {
+		testCheckSensitiveSchema(t, name, r.Schema)
+	}
+}
+
 var testProvider *schema.Provider
 var testProviders map[string]terraform.ResourceProvider
 
@@ -93,6 +143,115 @@ func getTestRMQCreds(t *testing.T) (string, string, string) {
 	return connectionUri, username, password
 }
 
+func getTestGCPCreds(t *testing.T) (string, string) {
+	credentials := os.Getenv("GOOGLE_CREDENTIALS")
+	project := os.Getenv("GOOGLE_PROJECT")
+	if credentials == "" {
+		t.Skip("GOOGLE_CREDENTIALS not set")
+	}
+	if project == "" {
+		t.Skip("GOOGLE_PROJECT not set")
+	}
+	return credentials, project
+}
+
+func getTestADCreds(t *testing.T) (string, string, string, string, string) {
+	url := os.Getenv("AD_URL")
+	binddn := os.Getenv("AD_BINDDN")
+	bindpass := os.Getenv("AD_BINDPASS")
+	userdn := os.Getenv("AD_USERDN")
+	account := os.Getenv("AD_SERVICE_ACCOUNT")
+	if url == "" {
+		t.Skip("AD_URL not set")
+	}
+	if binddn == "" {
+		t.Skip("AD_BINDDN not set")
+	}
+	if bindpass == "" {
+		t.Skip("AD_BINDPASS not set")
+	}
+	if userdn == "" {
+		t.Skip("AD_USERDN not set")
+	}
+	if account == "" {
+		t.Skip("AD_SERVICE_ACCOUNT not set")
+	}
+	return url, binddn, bindpass, userdn, account
+}
+
+func getTestLDAPCreds(t *testing.T) (string, string, string) {
+	url := os.Getenv("LDAP_URL")
+	binddn := os.Getenv("LDAP_BINDDN")
+	bindpass := os.Getenv("LDAP_BINDPASS")
+	if url == "" {
+		t.Skip("LDAP_URL not set")
+	}
+	if binddn == "" {
+		t.Skip("LDAP_BINDDN not set")
+	}
+	if bindpass == "" {
+		t.Skip("LDAP_BINDPASS not set")
+	}
+	return url, binddn, bindpass
+}
+
+func getTestNomadCreds(t *testing.T) (string, string) {
+	address := os.Getenv("NOMAD_ADDR")
+	token := os.Getenv("NOMAD_TOKEN")
+	if address == "" {
+		t.Skip("NOMAD_ADDR not set")
+	}
+	if token == "" {
+		t.Skip("NOMAD_TOKEN not set")
+	}
+	return address, token
+}
+
+func getTestTFCCreds(t *testing.T) (string, string) {
+	token := os.Getenv("TEST_TF_TOKEN")
+	organization := os.Getenv("TEST_TF_ORGANIZATION")
+	if token == "" {
+		t.Skip("TEST_TF_TOKEN not set")
+	}
+	if organization == "" {
+		t.Skip("TEST_TF_ORGANIZATION not set")
+	}
+	return token, organization
+}
+
+func getTestKubernetesCreds(t *testing.T) (string, string, string) {
+	host := os.Getenv("KUBERNETES_HOST")
+	caCert := os.Getenv("KUBERNETES_CA_CERT")
+	jwt := os.Getenv("KUBERNETES_SERVICE_ACCOUNT_JWT")
+	if host == "" {
+		t.Skip("KUBERNETES_HOST not set")
+	}
+	if jwt == "" {
+		t.Skip("KUBERNETES_SERVICE_ACCOUNT_JWT not set")
+	}
+	return host, caCert, jwt
+}
+
+func getTestAzureCreds(t *testing.T) (string, string, string, string) {
+	subscriptionID := os.Getenv("AZURE_SUBSCRIPTION_ID")
+	tenantID := os.Getenv("AZURE_TENANT_ID")
+	clientID := os.Getenv("AZURE_CLIENT_ID")
+	clientSecret := os.Getenv("AZURE_CLIENT_SECRET")
+	if subscriptionID == "" {
+		t.Skip("AZURE_SUBSCRIPTION_ID not set")
+	}
+	if tenantID == "" {
+		t.Skip("AZURE_TENANT_ID not set")
+	}
+	if clientID == "" {
+		t.Skip("AZURE_CLIENT_ID not set")
+	}
+	if clientSecret == "" {
+		t.Skip("AZURE_CLIENT_SECRET not set")
+	}
+	return subscriptionID, tenantID, clientID, clientSecret
+}
+
 // A basic token helper script.
 const tokenHelperScript = `
 #!/usr/bin/env bash
@@ -261,3 +420,725 @@ func TestAccProviderToken(t *testing.T) {
 		})
 	}
 }
+
+func testAccEnterprisePreCheck(t *testing.T) {
+	if v := os.Getenv("VAULT_ENTERPRISE"); v == "" {
+		t.Skip("VAULT_ENTERPRISE not set")
+	}
+}
+
+func TestAccProviderNamespace(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf(
+			"Acceptance tests skipped unless env '%s' set",
+			resource.TestEnvVar))
+	}
+	testAccPreCheck(t)
+	testAccEnterprisePreCheck(t)
+
+	namespace := acctest.RandomWithPrefix("tf-test-namespace")
+
+	rootClient, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rootClient.Logical().Write("sys/namespaces/"+namespace, nil); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if _, err := rootClient.Logical().Delete("sys/namespaces/" + namespace); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+	d := providerResource.TestResourceData()
+	d.Set("address", os.Getenv("VAULT_ADDR"))
+	d.Set("token", os.Getenv("VAULT_TOKEN"))
+	d.Set("max_lease_ttl_seconds", 1200)
+	d.Set("namespace", namespace)
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := meta.(*api.Client)
+
+	if v := client.Headers().Get(consts.NamespaceHeaderName); v != namespace {
+		t.Errorf("bad namespace header: want %#v, got %#v", namespace, v)
+	}
+
+	// the child token must have been created in the namespace
+	secret, err := client.Auth().Token().LookupSelf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := secret.Data["namespace_path"]; v != namespace+"/" {
+		t.Errorf("bad token namespace: want %#v, got %#v", namespace+"/", v)
+	}
+}
+
+func TestAccProviderAuthLoginUserpass(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf(
+			"Acceptance tests skipped unless env '%s' set",
+			resource.TestEnvVar))
+	}
+	testAccPreCheck(t)
+
+	mount := acctest.RandomWithPrefix("userpass")
+	username := acctest.RandomWithPrefix("tf-test-user")
+	password := acctest.RandomWithPrefix("tf-test-password")
+
+	rootClient, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rootClient.Sys().EnableAuthWithOptions(mount, &api.EnableAuthOptions{Type: "userpass"}); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := rootClient.Sys().DisableAuth(mount); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	_, err = rootClient.Logical().Write("auth/"+mount+"/users/"+username, map[string]interface{}{
+		"password": password,
+		"policies": "default,tf-test-policy",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+	d := providerResource.TestResourceData()
+	d.Set("address", os.Getenv("VAULT_ADDR"))
+	d.Set("max_lease_ttl_seconds", 1200)
+	d.Set("auth_login_userpass", []interface{}{
+		map[string]interface{}{
+			"mount":    mount,
+			"username": username,
+			"password": password,
+		},
+	})
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := meta.(*api.Client)
+
+	secret, err := client.Auth().Token().LookupSelf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	policies, err := secret.TokenPolicies()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(policies) != 2 || policies[1] != "tf-test-policy" {
+		t.Errorf("bad token policies: want %#v, got %#v", []string{"default", "tf-test-policy"}, policies)
+	}
+}
+
+func TestAccProviderAuthLoginAWS(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf(
+			"Acceptance tests skipped unless env '%s' set",
+			resource.TestEnvVar))
+	}
+	testAccPreCheck(t)
+	accessKey, secretKey := getTestAWSCreds(t)
+
+	mount := acctest.RandomWithPrefix("tf-test-aws")
+	role := acctest.RandomWithPrefix("tf-test")
+
+	creds, err := authLoginAWSCredentials("", "", "", "terraform-provider-vault", "us-east-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: creds,
+		Region:      aws.String("us-east-1"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	identity, err := sts.New(sess).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootClient, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rootClient.Sys().EnableAuthWithOptions(mount, &api.EnableAuthOptions{Type: "aws"}); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := rootClient.Sys().DisableAuth(mount); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	_, err = rootClient.Logical().Write("auth/"+mount+"/config/client", map[string]interface{}{
+		"access_key": accessKey,
+		"secret_key": secretKey,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = rootClient.Logical().Write("auth/"+mount+"/role/"+role, map[string]interface{}{
+		"auth_type":               "iam",
+		"bound_iam_principal_arn": *identity.Arn,
+		"policies":                "default",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+	d := providerResource.TestResourceData()
+	d.Set("address", os.Getenv("VAULT_ADDR"))
+	d.Set("max_lease_ttl_seconds", 1200)
+	d.Set("auth_login_aws", []interface{}{
+		map[string]interface{}{
+			"mount":  mount,
+			"role":   role,
+			"region": "us-east-1",
+		},
+	})
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := meta.(*api.Client).Auth().Token().LookupSelf(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAccProviderAuthLoginGCP(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf(
+			"Acceptance tests skipped unless env '%s' set",
+			resource.TestEnvVar))
+	}
+	testAccPreCheck(t)
+	credentials, _ := getTestGCPCreds(t)
+
+	var key struct {
+		ClientEmail string `json:"client_email"`
+	}
+	if err := json.Unmarshal([]byte(credentials), &key); err != nil {
+		t.Fatal(err)
+	}
+
+	mount := acctest.RandomWithPrefix("tf-test-gcp")
+	role := acctest.RandomWithPrefix("tf-test")
+
+	rootClient, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rootClient.Sys().EnableAuthWithOptions(mount, &api.EnableAuthOptions{Type: "gcp"}); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := rootClient.Sys().DisableAuth(mount); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	_, err = rootClient.Logical().Write("auth/"+mount+"/config", map[string]interface{}{
+		"credentials": credentials,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = rootClient.Logical().Write("auth/"+mount+"/role/"+role, map[string]interface{}{
+		"type":                   "iam",
+		"bound_service_accounts": key.ClientEmail,
+		"policies":               "default",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+	d := providerResource.TestResourceData()
+	d.Set("address", os.Getenv("VAULT_ADDR"))
+	d.Set("max_lease_ttl_seconds", 1200)
+	d.Set("auth_login_gcp", []interface{}{
+		map[string]interface{}{
+			"mount":       mount,
+			"role":        role,
+			"credentials": credentials,
+			"jwt_ttl":     900,
+		},
+	})
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := meta.(*api.Client).Auth().Token().LookupSelf(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAccProviderChildToken(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf(
+			"Acceptance tests skipped unless env '%s' set",
+			resource.TestEnvVar))
+	}
+	testAccPreCheck(t)
+
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+
+	tests := []struct {
+		name                string
+		skipChildToken      bool
+		expectedDisplayName string
+	}{
+		{
+			name:                "ChildToken",
+			expectedDisplayName: "token-tf-test",
+		},
+		{
+			name:           "SkipChildToken",
+			skipChildToken: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d := providerResource.TestResourceData()
+			d.Set("address", os.Getenv("VAULT_ADDR"))
+			d.Set("token", os.Getenv("VAULT_TOKEN"))
+			d.Set("max_lease_ttl_seconds", 1200)
+			d.Set("token_name", "tf-test")
+			d.Set("skip_child_token", tc.skipChildToken)
+
+			meta, err := providerConfigure(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			client := meta.(*api.Client)
+
+			if tc.skipChildToken {
+				if client.Token() != os.Getenv("VAULT_TOKEN") {
+					t.Errorf("expected the given token to be used")
+				}
+				return
+			}
+
+			secret, err := client.Auth().Token().LookupSelf()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v := secret.Data["display_name"]; v != tc.expectedDisplayName {
+				t.Errorf("bad display name: want %#v, got %#v", tc.expectedDisplayName, v)
+			}
+		})
+	}
+}
+
+func TestAccProviderRenewToken(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf(
+			"Acceptance tests skipped unless env '%s' set",
+			resource.TestEnvVar))
+	}
+	testAccPreCheck(t)
+
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+	d := providerResource.TestResourceData()
+	d.Set("address", os.Getenv("VAULT_ADDR"))
+	d.Set("token", os.Getenv("VAULT_TOKEN"))
+	d.Set("max_lease_ttl_seconds", 1200)
+	d.Set("renew_token", true)
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := meta.(*api.Client)
+
+	secret, err := client.Auth().Token().LookupSelf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	renewable, err := secret.TokenIsRenewable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !renewable {
+		t.Errorf("expected the child token to be renewable")
+	}
+
+	renewingClients.Lock()
+	renewing := renewingClients.clients[client]
+	renewingClients.Unlock()
+	if !renewing {
+		t.Errorf("expected the child token to be renewed")
+	}
+}
+
+func TestProviderClientAuthPEM(t *testing.T) {
+	certPEM, keyPEM := testGenerateCertificate(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			t.Errorf("no client certificate presented")
+		}
+		if r.TLS.ServerName != "vault.example.com" {
+			t.Errorf("bad server name: %q", r.TLS.ServerName)
+		}
+		fmt.Fprint(w, `{"data": {"foo": "bar"}}`)
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAnyClientCert,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+	d := providerResource.TestResourceData()
+	d.Set("address", server.URL)
+	d.Set("token", "test-token")
+	d.Set("skip_child_token", true)
+	d.Set("skip_get_vault_version", true)
+	d.Set("skip_tls_verify", true)
+	d.Set("tls_server_name", "vault.example.com")
+	d.Set("client_auth", []interface{}{
+		map[string]interface{}{
+			"cert_pem": certPEM,
+			"key_pem":  keyPEM,
+		},
+	})
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret, err := meta.(*api.Client).Logical().Read("secret/foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret == nil || secret.Data["foo"] != "bar" {
+		t.Errorf("bad secret: %#v", secret)
+	}
+}
+
+// testGenerateCertificate returns a PEM-encoded self-signed certificate and
+// its private key.
+func testGenerateCertificate(t *testing.T) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestProviderHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("X-Trace-Id"); v != "trace" {
+			t.Errorf("bad X-Trace-Id header: %q", v)
+		}
+		if v := r.Header.Get("X-Cdn-Auth"); v != "secret" {
+			t.Errorf("bad X-Cdn-Auth header: %q", v)
+		}
+		fmt.Fprint(w, `{"data": {"foo": "bar"}}`)
+	}))
+	defer server.Close()
+
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+	d := providerResource.TestResourceData()
+	d.Set("address", server.URL)
+	d.Set("token", "test-token")
+	d.Set("skip_child_token", true)
+	d.Set("skip_get_vault_version", true)
+	d.Set("headers", []interface{}{
+		map[string]interface{}{
+			"name":  "X-Trace-Id",
+			"value": "trace",
+		},
+		map[string]interface{}{
+			"name":      "X-Cdn-Auth",
+			"value":     "secret",
+			"sensitive": true,
+		},
+	})
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := meta.(*api.Client).Logical().Read("secret/foo"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProviderVaultVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/sys/seal-status" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"sealed": false, "version": "1.10.3"}`)
+	}))
+	defer server.Close()
+
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+
+	for _, tc := range []struct {
+		skip     bool
+		override string
+		wantErr  bool
+	}{
+		{wantErr: true},
+		{override: "1.11.0"},
+		{skip: true},
+		{skip: true, override: "1.9.0", wantErr: true},
+	} {
+		d := providerResource.TestResourceData()
+		d.Set("address", server.URL)
+		d.Set("token", "test-token")
+		d.Set("skip_child_token", true)
+		d.Set("skip_get_vault_version", tc.skip)
+		d.Set("vault_version_override", tc.override)
+
+		meta, err := providerConfigure(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = checkVaultVersion(meta.(*api.Client), "1.11.0", "issuer_ref")
+		if tc.wantErr && err == nil {
+			t.Errorf("expected an error with skip %t and override %q", tc.skip, tc.override)
+		}
+		if !tc.wantErr && err != nil {
+			t.Errorf("unexpected error with skip %t and override %q: %s", tc.skip, tc.override, err)
+		}
+	}
+}
+
+func TestProviderVaultEnterprise(t *testing.T) {
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+
+	for _, tc := range []struct {
+		override string
+		wantErr  bool
+	}{
+		{override: "1.15.0+ent"},
+		{override: "1.4.0+prem"},
+		{override: "1.15.0", wantErr: true},
+		{override: "1.3.0+ent", wantErr: true},
+		{},
+	} {
+		d := providerResource.TestResourceData()
+		d.Set("address", "http://127.0.0.1:8200")
+		d.Set("token", "test-token")
+		d.Set("skip_child_token", true)
+		d.Set("skip_get_vault_version", true)
+		d.Set("vault_version_override", tc.override)
+
+		meta, err := providerConfigure(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = checkVaultEnterprise(meta.(*api.Client), "1.4.0", "vault_transform_role")
+		if tc.wantErr && err == nil {
+			t.Errorf("expected an error with override %q", tc.override)
+		}
+		if !tc.wantErr && err != nil {
+			t.Errorf("unexpected error with override %q: %s", tc.override, err)
+		}
+	}
+}
+
+func TestProviderSetNamespaceFromToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/auth/token/lookup-self":
+			if v := r.Header.Get(consts.NamespaceHeaderName); v != "" {
+				t.Errorf("token looked up in namespace %q", v)
+			}
+			fmt.Fprint(w, `{"data": {"namespace_path": "ns1/"}}`)
+		default:
+			if v := r.Header.Get(consts.NamespaceHeaderName); v != "ns1" {
+				t.Errorf("bad namespace header: %q", v)
+			}
+			fmt.Fprint(w, `{"data": {"foo": "bar"}}`)
+		}
+	}))
+	defer server.Close()
+
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+	d := providerResource.TestResourceData()
+	d.Set("address", server.URL)
+	d.Set("token", "test-token")
+	d.Set("skip_child_token", true)
+	d.Set("skip_get_vault_version", true)
+	d.Set("set_namespace_from_token", true)
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := meta.(*api.Client).Logical().Read("secret/foo"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProviderRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"foo": "bar"}}`)
+	}))
+	defer server.Close()
+
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+	d := providerResource.TestResourceData()
+	d.Set("address", server.URL)
+	d.Set("token", "test-token")
+	d.Set("skip_child_token", true)
+	d.Set("skip_get_vault_version", true)
+	d.Set("rate_limit", 10)
+	d.Set("rate_limit_burst", 1)
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if _, err := meta.(*api.Client).Logical().Read("secret/foo"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 250*time.Millisecond {
+		t.Errorf("4 requests at 10 per second took only %s", elapsed)
+	}
+}
+
+func TestProviderUnixSocketAddress(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terraform-provider-vault")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	listener, err := net.Listen("unix", filepath.Join(dir, "agent.sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"data": {"foo": "bar"}}`)
+		}),
+	}
+	go server.Serve(listener)
+	defer server.Close()
+
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+	d := providerResource.TestResourceData()
+	d.Set("address", "unix://"+filepath.Join(dir, "agent.sock"))
+	d.Set("proxy_address", "http://proxy.invalid:3128")
+	d.Set("token", "test-token")
+	d.Set("skip_child_token", true)
+	d.Set("skip_get_vault_version", true)
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret, err := meta.(*api.Client).Logical().Read("secret/foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret == nil || secret.Data["foo"] != "bar" {
+		t.Errorf("bad secret: %#v", secret)
+	}
+}
+
+func TestProviderProxyAddress(t *testing.T) {
+	proxied := false
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Host != "vault.invalid:8200" {
+			t.Errorf("bad proxied host: %q", r.URL.Host)
+		}
+		proxied = true
+		fmt.Fprint(w, `{"data": {"foo": "bar"}}`)
+	}))
+	defer proxy.Close()
+
+	providerResource := &schema.Resource{
+		Schema: Provider().(*schema.Provider).Schema,
+	}
+	d := providerResource.TestResourceData()
+	d.Set("address", "http://vault.invalid:8200")
+	d.Set("proxy_address", proxy.URL)
+	d.Set("token", "test-token")
+	d.Set("skip_child_token", true)
+	d.Set("skip_get_vault_version", true)
+
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := meta.(*api.Client).Logical().Read("secret/foo"); err != nil {
+		t.Fatal(err)
+	}
+	if !proxied {
+		t.Errorf("request was not sent through the proxy")
+	}
+}
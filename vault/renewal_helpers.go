@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"log"
+	"sync"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// renewingClients are the clients of the providers configured with
+// renew_token, whose leases are renewed too while Terraform runs.
+var renewingClients = struct {
+	sync.Mutex
+	clients map[*api.Client]bool
+}{clients: map[*api.Client]bool{}}
+
+// startTokenRenewal renews the token of the client in the background, until
+// the provider process exits or the token can't be renewed any longer.
+func startTokenRenewal(client *api.Client, secret *api.Secret) error {
+	renewingClients.Lock()
+	renewingClients.clients[client] = true
+	renewingClients.Unlock()
+
+	return startRenewer(client, secret, "token")
+}
+
+// renewLease renews the lease of a secret read by a data source in the
+// background, if its provider is configured with renew_token.
+func renewLease(client *api.Client, secret *api.Secret) {
+	if secret == nil || secret.LeaseID == "" || !secret.Renewable {
+		return
+	}
+
+	renewingClients.Lock()
+	renewing := renewingClients.clients[client]
+	renewingClients.Unlock()
+	if !renewing {
+		return
+	}
+
+	if err := startRenewer(client, secret, "lease "+secret.LeaseID); err != nil {
+		log.Printf("[WARN] Failed to renew Vault lease %q: %s", secret.LeaseID, err)
+	}
+}
+
+func startRenewer(client *api.Client, secret *api.Secret, description string) error {
+	renewer, err := client.NewRenewer(&api.RenewerInput{
+		Secret: secret,
+	})
+	if err != nil {
+		return err
+	}
+
+	go renewer.Renew()
+	go func() {
+		for {
+			select {
+			case err := <-renewer.DoneCh():
+				if err != nil {
+					log.Printf("[WARN] Stopped renewing Vault %s: %s", description, err)
+				} else {
+					log.Printf("[DEBUG] Stopped renewing Vault %s", description)
+				}
+				return
+			case <-renewer.RenewCh():
+				log.Printf("[DEBUG] Renewed Vault %s", description)
+			}
+		}
+	}()
+
+	return nil
+}
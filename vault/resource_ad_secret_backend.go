@@ -0,0 +1,357 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// adSecretBackendConfigFields are the fields of the AD configuration.
+var adSecretBackendConfigFields = []string{
+	"binddn",
+	"bindpass",
+	"url",
+	"userdn",
+	"upndomain",
+	"certificate",
+	"password_policy",
+	"tls_min_version",
+	"tls_max_version",
+	"ttl",
+	"max_ttl",
+	"last_rotation_tolerance",
+	"starttls",
+	"insecure_tls",
+	"rotate_root",
+}
+
+func adSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: adSecretBackendCreate,
+		Read:   adSecretBackendRead,
+		Update: adSecretBackendUpdate,
+		Delete: adSecretBackendDelete,
+		Exists: adSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ad",
+				Description:  "Path to mount the backend at.",
+				ValidateFunc: validateMountPath,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lease duration for secrets in seconds",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum possible lease duration for secrets in seconds",
+			},
+			"binddn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Distinguished name of the object to bind as when managing service account passwords.",
+			},
+			"bindpass": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Password for the binddn.",
+				Sensitive:   true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "LDAP URL(s) to connect to, comma separated, e.g. ldaps://ad.example.com.",
+			},
+			"userdn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Base DN under which to search for service accounts.",
+			},
+			"upndomain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "userPrincipalName domain used to construct the UPN of the binddn.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CA certificate to use when verifying the LDAP server certificate, in PEM format.",
+			},
+			"password_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the password policy used to generate service account passwords.",
+			},
+			"tls_min_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Minimum TLS version to use, e.g. tls12.",
+			},
+			"tls_max_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum TLS version to use, e.g. tls12.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lifetime in seconds of service account passwords.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum lifetime in seconds of service account passwords.",
+			},
+			"last_rotation_tolerance": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds after Vault last rotated a password within which a password change made in AD is tolerated.",
+			},
+			"starttls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Issue a StartTLS command after establishing an unencrypted connection.",
+			},
+			"insecure_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Skip LDAP server SSL certificate verification, insecure.",
+			},
+			"rotate_root": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Rotate the bindpass after it is written, so that only Vault knows it.",
+			},
+		},
+	}
+}
+
+func adSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	description := d.Get("description").(string)
+	defaultTTL := d.Get("default_lease_ttl_seconds").(int)
+	maxTTL := d.Get("max_lease_ttl_seconds").(int)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting AD backend at %q", path)
+	err := mountSecretBackend(client, path, &api.MountInput{
+		Type:        "ad",
+		Description: description,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", defaultTTL),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", maxTTL),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted AD backend at %q", path)
+	d.SetId(path)
+
+	d.SetPartial("path")
+	d.SetPartial("description")
+	d.SetPartial("default_lease_ttl_seconds")
+	d.SetPartial("max_lease_ttl_seconds")
+
+	if err := adSecretBackendWriteConfig(client, path, d); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return adSecretBackendRead(d, meta)
+}
+
+func adSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading AD backend mount %q from Vault", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read AD backend mount %q from Vault", path)
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
+
+	configPath := adSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading AD configuration from %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading AD configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read AD configuration from %q", configPath)
+	if resp != nil {
+		// the bind password is never returned.
+		for _, k := range []string{
+			"binddn", "url", "userdn", "upndomain", "certificate",
+			"password_policy", "tls_min_version", "tls_max_version", "ttl",
+			"max_ttl", "last_rotation_tolerance", "starttls", "insecure_tls",
+		} {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func adSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	d.Partial(true)
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") || d.HasChange("description") {
+		config := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			config.Description = &description
+		}
+		log.Printf("[DEBUG] Tuning mount %q", path)
+		err := tuneMount(client, path, config)
+		if err != nil {
+			return fmt.Errorf("error tuning mount %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Tuned mount %q", path)
+		d.SetPartial("default_lease_ttl_seconds")
+		d.SetPartial("max_lease_ttl_seconds")
+		d.SetPartial("description")
+	}
+	err := writeBackendConfigOnChange(d, adSecretBackendConfigFields, func() error {
+		return adSecretBackendWriteConfig(client, path, d)
+	})
+	if err != nil {
+		return err
+	}
+	d.Partial(false)
+	return adSecretBackendRead(d, meta)
+}
+
+func adSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting AD backend %q", path)
+	err := unmountSecretBackend(client, path)
+	if err != nil {
+		return fmt.Errorf("error unmounting AD backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted AD backend %q", path)
+	return nil
+}
+
+func adSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if AD backend exists at %q", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if AD backend exists at %q", path)
+	_, ok := mounts[strings.Trim(path, "/")+"/"]
+	return ok, nil
+}
+
+func adSecretBackendWriteConfig(client *api.Client, path string, d *schema.ResourceData) error {
+	configPath := adSecretBackendConfigPath(path)
+
+	log.Printf("[DEBUG] Writing AD configuration to %q", configPath)
+	data := map[string]interface{}{}
+	for _, k := range []string{
+		"binddn", "bindpass", "url", "userdn", "upndomain", "certificate",
+		"password_policy", "tls_min_version", "tls_max_version",
+	} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("max_ttl"); ok {
+		data["max_ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("last_rotation_tolerance"); ok {
+		data["last_rotation_tolerance"] = v.(int)
+	}
+	if v, ok := d.GetOkExists("starttls"); ok {
+		data["starttls"] = v.(bool)
+	}
+	if v, ok := d.GetOkExists("insecure_tls"); ok {
+		data["insecure_tls"] = v.(bool)
+	}
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing AD configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote AD configuration to %q", configPath)
+	for _, k := range adSecretBackendConfigFields {
+		d.SetPartial(k)
+	}
+
+	if d.Get("rotate_root").(bool) && (d.HasChange("bindpass") || d.HasChange("rotate_root")) {
+		rotatePath := strings.Trim(path, "/") + "/rotate-root"
+		log.Printf("[DEBUG] Rotating root credentials at %q", rotatePath)
+		if _, err := client.Logical().Write(rotatePath, nil); err != nil {
+			return fmt.Errorf("error rotating root credentials for %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Rotated root credentials at %q", rotatePath)
+	}
+
+	return nil
+}
+
+func adSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
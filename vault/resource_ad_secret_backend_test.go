@@ -0,0 +1,94 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccADSecretBackend_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-ad")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccADSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccADSecretBackendConfig_initial(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ad_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_ad_secret_backend.test", "binddn", "CN=Administrator,CN=Users,DC=corp,DC=example,DC=net"),
+					resource.TestCheckResourceAttr("vault_ad_secret_backend.test", "url", "ldaps://ad.example.net"),
+					resource.TestCheckResourceAttr("vault_ad_secret_backend.test", "userdn", "CN=Users,DC=corp,DC=example,DC=net"),
+					resource.TestCheckResourceAttr("vault_ad_secret_backend.test", "ttl", "3600"),
+				),
+			},
+			{
+				Config: testAccADSecretBackendConfig_updated(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ad_secret_backend.test", "ttl", "7200"),
+					resource.TestCheckResourceAttr("vault_ad_secret_backend.test", "upndomain", "corp.example.net"),
+				),
+			},
+			{
+				ResourceName:            "vault_ad_secret_backend.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bindpass", "rotate_root"},
+			},
+		},
+	})
+}
+
+func testAccADSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ad_secret_backend" {
+			continue
+		}
+		for path, mount := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if mount.Type == "ad" && path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccADSecretBackendConfig_initial(path string) string {
+	return fmt.Sprintf(`
+resource "vault_ad_secret_backend" "test" {
+  path     = "%s"
+  binddn   = "CN=Administrator,CN=Users,DC=corp,DC=example,DC=net"
+  bindpass = "SuperSecretPassw0rd"
+  url      = "ldaps://ad.example.net"
+  userdn   = "CN=Users,DC=corp,DC=example,DC=net"
+  ttl      = 3600
+}`, path)
+}
+
+func testAccADSecretBackendConfig_updated(path string) string {
+	return fmt.Sprintf(`
+resource "vault_ad_secret_backend" "test" {
+  path      = "%s"
+  binddn    = "CN=Administrator,CN=Users,DC=corp,DC=example,DC=net"
+  bindpass  = "SuperSecretPassw0rd"
+  url       = "ldaps://ad.example.net"
+  userdn    = "CN=Users,DC=corp,DC=example,DC=net"
+  ttl       = 7200
+  upndomain = "corp.example.net"
+}`, path)
+}
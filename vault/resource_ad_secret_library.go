@@ -0,0 +1,194 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	adSecretLibraryBackendFromPathRegex = regexp.MustCompile("^(.+)/library/.+$")
+	adSecretLibraryNameFromPathRegex    = regexp.MustCompile("^.+/library/(.+)$")
+)
+
+func adSecretLibraryResource() *schema.Resource {
+	return &schema.Resource{
+		Create: adSecretLibraryWrite,
+		Read:   adSecretLibraryRead,
+		Update: adSecretLibraryWrite,
+		Delete: adSecretLibraryDelete,
+		Exists: adSecretLibraryExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where the AD secrets engine is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the library set.",
+			},
+			"service_account_names": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Names of the service accounts that can be checked out from the library. An account can only belong to one library.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lifetime in seconds of a check-out.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum lifetime in seconds of a check-out, including renewals.",
+			},
+			"disable_check_in_enforcement": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow any entity with access to check-in to check in a service account, not only the one that checked it out.",
+			},
+		},
+	}
+}
+
+func adSecretLibraryWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := adSecretLibraryPath(backend, name)
+
+	data := map[string]interface{}{
+		"service_account_names":        d.Get("service_account_names").(*schema.Set).List(),
+		"disable_check_in_enforcement": d.Get("disable_check_in_enforcement").(bool),
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("max_ttl"); ok {
+		data["max_ttl"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Writing AD secret library %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing AD secret library %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote AD secret library %q", path)
+
+	d.SetId(path)
+	return adSecretLibraryRead(d, meta)
+}
+
+func adSecretLibraryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := adSecretLibraryBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid library ID %q: %s", path, err)
+	}
+	name, err := adSecretLibraryNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid library ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading AD secret library %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading AD secret library %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read AD secret library %q", path)
+	if resp == nil {
+		log.Printf("[WARN] AD secret library %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	if err := d.Set("service_account_names", resp.Data["service_account_names"]); err != nil {
+		return fmt.Errorf("error setting service_account_names in state: %s", err)
+	}
+	d.Set("ttl", resp.Data["ttl"])
+	d.Set("max_ttl", resp.Data["max_ttl"])
+	d.Set("disable_check_in_enforcement", resp.Data["disable_check_in_enforcement"])
+
+	return nil
+}
+
+func adSecretLibraryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting AD secret library %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting AD secret library %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted AD secret library %q", path)
+	return nil
+}
+
+func adSecretLibraryExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if AD secret library %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if AD secret library %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if AD secret library %q exists", path)
+	return resp != nil, nil
+}
+
+func adSecretLibraryPath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/library/" + strings.Trim(name, "/")
+}
+
+func adSecretLibraryNameFromPath(path string) (string, error) {
+	if !adSecretLibraryNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no library found")
+	}
+	res := adSecretLibraryNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for library", len(res))
+	}
+	return res[1], nil
+}
+
+func adSecretLibraryBackendFromPath(path string) (string, error) {
+	if !adSecretLibraryBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := adSecretLibraryBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccADSecretLibrary_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ad")
+	name := acctest.RandomWithPrefix("tf-test")
+	// Vault checks the service accounts exist in the directory, so this
+	// needs a real AD server.
+	url, binddn, bindpass, userdn, account := getTestADCreds(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckADSecretLibraryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccADSecretLibraryConfig_basic(backend, url, binddn, bindpass, userdn, name, account, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ad_secret_library.test", "name", name),
+					resource.TestCheckResourceAttr("vault_ad_secret_library.test", "service_account_names.#", "1"),
+					resource.TestCheckResourceAttr("vault_ad_secret_library.test", "ttl", "3600"),
+					resource.TestCheckResourceAttr("vault_ad_secret_library.test", "disable_check_in_enforcement", "false"),
+				),
+			},
+			{
+				Config: testAccADSecretLibraryConfig_basic(backend, url, binddn, bindpass, userdn, name, account, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ad_secret_library.test", "ttl", "7200"),
+				),
+			},
+			{
+				ResourceName:      "vault_ad_secret_library.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckADSecretLibraryDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ad_secret_library" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("AD secret library %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccADSecretLibraryConfig_basic(backend, url, binddn, bindpass, userdn, name, account string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_ad_secret_backend" "test" {
+  path     = "%s"
+  url      = "%s"
+  binddn   = "%s"
+  bindpass = "%s"
+  userdn   = "%s"
+}
+
+resource "vault_ad_secret_library" "test" {
+  backend               = "${vault_ad_secret_backend.test.path}"
+  name                  = "%s"
+  service_account_names = ["%s"]
+  ttl                   = %d
+}`, backend, url, binddn, bindpass, userdn, name, account, ttl)
+}
@@ -14,10 +14,11 @@ func approleAuthBackendLoginResource() *schema.Resource {
 	return &schema.Resource{
 		Create: approleAuthBackendLoginCreate,
 		Read:   approleAuthBackendLoginRead,
+		Update: approleAuthBackendLoginUpdate,
 		Delete: approleAuthBackendLoginDelete,
 		Exists: approleAuthBackendLoginExists,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withRevokeLeaseSchema(map[string]*schema.Schema{
 			"role_id": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -26,6 +27,7 @@ func approleAuthBackendLoginResource() *schema.Resource {
 			},
 			"secret_id": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Optional:    true,
 				Description: "The SecretID to log in with.",
 				ForceNew:    true,
@@ -60,6 +62,7 @@ func approleAuthBackendLoginResource() *schema.Resource {
 			},
 			"client_token": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "The token.",
 			},
@@ -82,7 +85,7 @@ func approleAuthBackendLoginResource() *schema.Resource {
 					return strings.Trim(v.(string), "/")
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -153,10 +156,20 @@ func approleAuthBackendLoginRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func approleAuthBackendLoginUpdate(d *schema.ResourceData, meta interface{}) error {
+	// only revoke_lease can be updated, it doesn't need to be sent to Vault.
+	return approleAuthBackendLoginRead(d, meta)
+}
+
 func approleAuthBackendLoginDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	accessor := d.Id()
 
+	if !leaseRevokeOnDestroy(d, client) {
+		log.Printf("[DEBUG] Leaving token %q to expire", accessor)
+		return nil
+	}
+
 	log.Printf("[DEBUG] Revoking token %q", accessor)
 	err := client.Auth().Token().RevokeAccessor(accessor)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/vault/api"
@@ -22,7 +23,7 @@ func approleAuthBackendRoleSecretIDResource() *schema.Resource {
 		Delete: approleAuthBackendRoleSecretIDDelete,
 		Exists: approleAuthBackendRoleSecretIDExists,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withWrappingSchema(map[string]*schema.Schema{
 			"role_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -84,7 +85,40 @@ func approleAuthBackendRoleSecretIDResource() *schema.Resource {
 				Computed:    true,
 				Description: "The unique ID used to access this SecretID.",
 			},
-		},
+
+			"num_uses": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Number of times the SecretID can be used to log in, 0 means unlimited. Defaults to the secret_id_num_uses of the role.",
+			},
+
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Number of seconds after which the SecretID expires, 0 means the secret_id_ttl of the role.",
+			},
+
+			"keepers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that generates a new SecretID whenever any of them changes.",
+			},
+
+			"remaining_uses": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of times the SecretID can still be used to log in, 0 means unlimited.",
+			},
+
+			"expiration_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the SecretID expires, empty if it doesn't.",
+			},
+		}, true),
 	}
 }
 
@@ -119,6 +153,33 @@ func approleAuthBackendRoleSecretIDCreate(d *schema.ResourceData, meta interface
 	} else {
 		data["metadata"] = ""
 	}
+	if v, ok := d.GetOk("num_uses"); ok {
+		data["num_uses"] = v.(int)
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(int)
+	}
+
+	if wrapTTL, ok := d.GetOk("wrapping_ttl"); ok {
+		resp, err := wrappedRequest(client, "PUT", path, data, wrapTTL.(string))
+		if err != nil {
+			return fmt.Errorf("error writing AppRole auth backend role SecretID %q: %s", path, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("no response writing AppRole auth backend role SecretID %q", path)
+		}
+		log.Printf("[DEBUG] Wrote wrapped AppRole auth backend role SecretID %q", path)
+
+		// the SecretID itself is only available by unwrapping the token
+		accessor := resp.WrapInfo.WrappedAccessor
+		d.Set("secret_id", "")
+		d.Set("accessor", accessor)
+		setWrappingInfo(d, resp)
+
+		d.SetId(approleAuthBackendRoleSecretIDID(backend, role, accessor))
+
+		return approleAuthBackendRoleSecretIDRead(d, meta)
+	}
 
 	resp, err := client.Logical().Write(path, data)
 
@@ -151,8 +212,11 @@ func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}
 		"secret_id_accessor": accessor,
 	})
 	if err != nil {
-		// We need to check if the secret_id has expired
+		// the SecretID is removed once it expired or its uses are exhausted,
+		// so it is generated again.
 		if isExpiredTokenErr(err) {
+			log.Printf("[WARN] AppRole auth backend role SecretID %q expired, removing from state", id)
+			d.SetId("")
 			return nil
 		}
 		return fmt.Errorf("error reading AppRole auth backend role SecretID %q: %s", id, err)
@@ -164,6 +228,17 @@ func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}
 		return nil
 	}
 
+	expiration, err := approleAuthBackendRoleSecretIDExpiration(resp.Data)
+	if err != nil {
+		return fmt.Errorf("error reading expiration_time of SecretID %q: %s", id, err)
+	}
+	// Vault only removes the expired SecretIDs when it tidies them.
+	if !expiration.IsZero() && expiration.Before(time.Now()) {
+		log.Printf("[WARN] AppRole auth backend role SecretID %q expired at %s, removing from state", id, expiration)
+		d.SetId("")
+		return nil
+	}
+
 	var cidrs []string
 	switch resp.Data["cidr_list"].(type) {
 	case string:
@@ -193,10 +268,31 @@ func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}
 	}
 	d.Set("metadata", string(metadata))
 	d.Set("accessor", accessor)
+	d.Set("remaining_uses", resp.Data["secret_id_num_uses"])
+	if expiration.IsZero() {
+		d.Set("expiration_time", "")
+	} else {
+		d.Set("expiration_time", expiration.Format(time.RFC3339))
+	}
 
 	return nil
 }
 
+// approleAuthBackendRoleSecretIDExpiration returns the expiration time of a
+// SecretID looked up from Vault, which is zero if the SecretID doesn't
+// expire.
+func approleAuthBackendRoleSecretIDExpiration(data map[string]interface{}) (time.Time, error) {
+	v, _ := data["expiration_time"].(string)
+	if v == "" {
+		return time.Time{}, nil
+	}
+	expiration, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return expiration, nil
+}
+
 func approleAuthBackendRoleSecretIDDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	id := d.Id()
@@ -235,9 +331,9 @@ func approleAuthBackendRoleSecretIDExists(d *schema.ResourceData, meta interface
 		"secret_id_accessor": accessor,
 	})
 	if err != nil {
-		// We need to check if the secret_id has expired
+		// an expired SecretID no longer exists
 		if isExpiredTokenErr(err) {
-			return true, nil
+			return false, nil
 		}
 		return true, fmt.Errorf("error checking if AppRole auth backend role SecretID %q exists: %s", id, err)
 	}
@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -34,6 +35,32 @@ func TestAccAppRoleAuthBackendRoleSecretID_basic(t *testing.T) {
 	})
 }
 
+func TestAccAppRoleAuthBackendRoleSecretID_wrapped(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAppRoleAuthBackendRoleSecretIDDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRoleAuthBackendRoleSecretIDConfig_wrapped(backend, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vault_approle_auth_backend_role_secret_id.secret_id",
+						"accessor"),
+					resource.TestCheckResourceAttrSet("vault_approle_auth_backend_role_secret_id.secret_id",
+						"wrapping_token"),
+					resource.TestCheckResourceAttrSet("vault_approle_auth_backend_role_secret_id.secret_id",
+						"wrapping_accessor"),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role_secret_id.secret_id",
+						"secret_id", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAppRoleAuthBackendRoleSecretID_full(t *testing.T) {
 	backend := acctest.RandomWithPrefix("approle")
 	role := acctest.RandomWithPrefix("test-role")
@@ -65,6 +92,68 @@ func TestAccAppRoleAuthBackendRoleSecretID_full(t *testing.T) {
 	})
 }
 
+func TestAccAppRoleAuthBackendRoleSecretID_keepers(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("test-role")
+	var accessor string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAppRoleAuthBackendRoleSecretIDDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRoleAuthBackendRoleSecretIDConfig_keepers(backend, role, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role_secret_id.secret_id",
+						"remaining_uses", "5"),
+					resource.TestCheckResourceAttrSet("vault_approle_auth_backend_role_secret_id.secret_id",
+						"expiration_time"),
+					func(s *terraform.State) error {
+						accessor = s.RootModule().Resources["vault_approle_auth_backend_role_secret_id.secret_id"].Primary.Attributes["accessor"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccAppRoleAuthBackendRoleSecretIDConfig_keepers(backend, role, "2"),
+				Check: func(s *terraform.State) error {
+					rotated := s.RootModule().Resources["vault_approle_auth_backend_role_secret_id.secret_id"].Primary.Attributes["accessor"]
+					if rotated == accessor {
+						return fmt.Errorf("expected a new SecretID after changing the keepers, still got %q", accessor)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAppRoleAuthBackendRoleSecretIDExpiration(t *testing.T) {
+	for _, tc := range []struct {
+		value    interface{}
+		zero     bool
+		expected string
+	}{
+		{value: nil, zero: true},
+		{value: "0001-01-01T00:00:00Z", zero: true},
+		{value: "2030-01-02T03:04:05.123456789Z", expected: "2030-01-02T03:04:05Z"},
+	} {
+		expiration, err := approleAuthBackendRoleSecretIDExpiration(map[string]interface{}{
+			"expiration_time": tc.value,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expiration.IsZero() != tc.zero {
+			t.Errorf("expected the expiration of %v to be zero: %t, got %s", tc.value, tc.zero, expiration)
+		}
+		if !tc.zero && expiration.Format(time.RFC3339) != tc.expected {
+			t.Errorf("expected the expiration of %v to be %s, got %s", tc.value, tc.expected, expiration.Format(time.RFC3339))
+		}
+	}
+}
+
 func testAccCheckAppRoleAuthBackendRoleSecretIDDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -102,6 +191,26 @@ resource "vault_approle_auth_backend_role_secret_id" "secret_id" {
 }`, backend, role)
 }
 
+func testAccAppRoleAuthBackendRoleSecretIDConfig_wrapped(backend, role string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend = "${vault_auth_backend.approle.path}"
+  role_name = "%s"
+  policies = ["default", "dev", "prod"]
+}
+
+resource "vault_approle_auth_backend_role_secret_id" "secret_id" {
+  role_name = "${vault_approle_auth_backend_role.role.role_name}"
+  backend = "${vault_auth_backend.approle.path}"
+  wrapping_ttl = "60s"
+}`, backend, role)
+}
+
 func testAccAppRoleAuthBackendRoleSecretIDConfig_full(backend, role, secretID string) string {
 	return fmt.Sprintf(`
 resource "vault_auth_backend" "approle" {
@@ -128,3 +237,28 @@ EOF
   secret_id = "%s"
 }`, backend, role, secretID)
 }
+
+func testAccAppRoleAuthBackendRoleSecretIDConfig_keepers(backend, role, generation string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend = "${vault_auth_backend.approle.path}"
+  role_name = "%s"
+  policies = ["default", "dev", "prod"]
+}
+
+resource "vault_approle_auth_backend_role_secret_id" "secret_id" {
+  role_name = "${vault_approle_auth_backend_role.role.role_name}"
+  backend = "${vault_auth_backend.approle.path}"
+  num_uses = 5
+  ttl = 3600
+
+  keepers = {
+    generation = "%s"
+  }
+}`, backend, role, generation)
+}
@@ -1,10 +1,8 @@
 package vault
 
 import (
-	"errors"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/vault/api"
@@ -15,6 +13,7 @@ func authBackendResource() *schema.Resource {
 		SchemaVersion: 1,
 
 		Create: authBackendWrite,
+		Update: authBackendUpdate,
 		Delete: authBackendDelete,
 		Read:   authBackendRead,
 		Importer: &schema.ResourceImporter{
@@ -31,18 +30,12 @@ func authBackendResource() *schema.Resource {
 			},
 
 			"path": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				ForceNew:    true,
-				Description: "path to mount the backend. This defaults to the type.",
-				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
-					value := v.(string)
-					if strings.HasSuffix(value, "/") {
-						errs = append(errs, errors.New("cannot write to a path ending in '/'"))
-					}
-					return
-				},
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "path to mount the backend. This defaults to the type.",
+				ValidateFunc: validateMountPath,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return old+"/" == new || new+"/" == old
 				},
@@ -50,7 +43,6 @@ func authBackendResource() *schema.Resource {
 
 			"description": {
 				Type:        schema.TypeString,
-				ForceNew:    true,
 				Optional:    true,
 				Description: "The description of the auth backend",
 			},
@@ -77,7 +69,7 @@ func authBackendWrite(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Writing auth %q to Vault", path)
 
-	err := client.Sys().EnableAuth(path, name, desc)
+	err := enableAuthBackend(client, path, name, desc)
 
 	if err != nil {
 		return fmt.Errorf("error writing to Vault: %s", err)
@@ -88,6 +80,22 @@ func authBackendWrite(d *schema.ResourceData, meta interface{}) error {
 	return authBackendRead(d, meta)
 }
 
+func authBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		log.Printf("[DEBUG] Updating description of auth %q in Vault", path)
+		if err := tuneAuthBackend(client, path, api.MountConfigInput{Description: &description}); err != nil {
+			return fmt.Errorf("error updating description of auth %q in Vault: %s", path, err)
+		}
+	}
+
+	return authBackendRead(d, meta)
+}
+
 func authBackendDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -95,7 +103,7 @@ func authBackendDelete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Deleting auth %s from Vault", path)
 
-	err := client.Sys().DisableAuth(path)
+	err := disableAuthBackend(client, path)
 
 	if err != nil {
 		return fmt.Errorf("error disabling auth from Vault: %s", err)
@@ -109,7 +117,7 @@ func authBackendRead(d *schema.ResourceData, meta interface{}) error {
 
 	targetPath := d.Id() + "/"
 
-	auths, err := client.Sys().ListAuth()
+	auths, err := listAuthMounts(client)
 
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
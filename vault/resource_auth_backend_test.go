@@ -28,6 +28,49 @@ func TestResourceAuth(t *testing.T) {
 	})
 }
 
+func TestResourceAuth_updateDescription(t *testing.T) {
+	path := "github-" + acctest.RandString(10)
+	var accessor string
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckAuthBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceAuth_descriptionConfig(path, "Test auth backend"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_auth_backend.test", "description", "Test auth backend"),
+					func(s *terraform.State) error {
+						accessor = s.RootModule().Resources["vault_auth_backend.test"].Primary.Attributes["accessor"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testResourceAuth_descriptionConfig(path, "Updated auth backend"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_auth_backend.test", "description", "Updated auth backend"),
+					func(s *terraform.State) error {
+						if v := s.RootModule().Resources["vault_auth_backend.test"].Primary.Attributes["accessor"]; v != accessor {
+							return fmt.Errorf("auth backend was recreated, accessor changed from %q to %q", accessor, v)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testResourceAuth_descriptionConfig(path, description string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+	type = "github"
+	path = "%s"
+	description = "%s"
+}`, path, description)
+}
+
 func testAccCheckAuthBackendDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
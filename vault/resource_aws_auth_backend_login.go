@@ -15,9 +15,10 @@ func awsAuthBackendLoginResource() *schema.Resource {
 	return &schema.Resource{
 		Create: awsAuthBackendLoginCreate,
 		Read:   awsAuthBackendLoginRead,
+		Update: awsAuthBackendLoginUpdate,
 		Delete: awsAuthBackendLoginDelete,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withRevokeLeaseSchema(map[string]*schema.Schema{
 			"backend": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -141,7 +142,7 @@ func awsAuthBackendLoginResource() *schema.Resource {
 				Description: "The token returned by Vault.",
 				Sensitive:   true,
 			},
-		},
+		}),
 	}
 }
 
@@ -230,10 +231,19 @@ func awsAuthBackendLoginRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func awsAuthBackendLoginUpdate(d *schema.ResourceData, meta interface{}) error {
+	// only revoke_lease can be updated, it doesn't need to be sent to Vault.
+	return nil
+}
+
 func awsAuthBackendLoginDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
 	accessor := d.Get("accessor").(string)
+	if !leaseRevokeOnDestroy(d, client) {
+		log.Printf("[DEBUG] Leaving token %q to expire", accessor)
+		return nil
+	}
 	token, ok := d.GetOk("client_token")
 	if !ok {
 		log.Printf("[DEBUG] Token %q has no token set in state, removing from state", accessor)
@@ -31,7 +31,7 @@ func awsAuthBackendRoleTagResource() *schema.Resource {
 				ForceNew:    true,
 			},
 			"policies": {
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Optional:    true,
 				Description: "Policies to be associated with the tag.",
 				Elem: &schema.Schema{
@@ -40,10 +40,11 @@ func awsAuthBackendRoleTagResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"max_ttl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The maximum allowed lifetime of tokens issued using this role.",
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The maximum allowed lifetime of tokens issued using this role.",
+				ForceNew:     true,
+				ValidateFunc: validateDuration,
 			},
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -64,8 +65,9 @@ func awsAuthBackendRoleTagResource() *schema.Resource {
 				ForceNew:    true,
 			},
 			"tag_value": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Sensitive: true,
+				Computed:  true,
 			},
 			"tag_key": {
 				Type:     schema.TypeString,
@@ -85,7 +87,7 @@ func awsAuthBackendRoleTagResourceCreate(d *schema.ResourceData, meta interface{
 	data := map[string]interface{}{}
 
 	if v, ok := d.GetOk("policies"); ok {
-		data["policies"] = v
+		data["policies"] = v.(*schema.Set).List()
 	}
 	if v, ok := d.GetOk("max_ttl"); ok {
 		data["max_ttl"] = v
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -150,18 +151,12 @@ func TestAccAWSAuthBackendRole_iamUpdate(t *testing.T) {
 					testAccAWSAuthBackendRoleCheck_attrs(backend, role),
 					resource.TestCheckResourceAttr("vault_aws_auth_backend_role.role",
 						"bound_iam_principal_arns.#", "1"),
-					resource.TestCheckResourceAttr("vault_aws_auth_backend_role.role",
-						"bound_iam_principal_arns.0", "arn:aws:iam::123456789012:role/MyRole/*"),
 					resource.TestCheckResourceAttr("vault_aws_auth_backend_role.role",
 						"ttl", "30"),
 					resource.TestCheckResourceAttr("vault_aws_auth_backend_role.role",
 						"max_ttl", "60"),
 					resource.TestCheckResourceAttr("vault_aws_auth_backend_role.role",
 						"policies.#", "2"),
-					resource.TestCheckResourceAttr("vault_aws_auth_backend_role.role",
-						"policies.0", "default"),
-					resource.TestCheckResourceAttr("vault_aws_auth_backend_role.role",
-						"policies.1", "dev"),
 				),
 			},
 		},
@@ -242,9 +237,9 @@ func testAccAWSAuthBackendRoleCheck_attrs(backend, role string) resource.TestChe
 			} else if _, ok := instanceState.Attributes[attr.PreviousNameInProvider]; ok {
 				providerValIsArray = false
 				stateAttr = attr.PreviousNameInProvider
-			} else if _, ok := instanceState.Attributes[attr.NameInProvider+".0"]; ok {
+			} else if _, ok := instanceState.Attributes[attr.NameInProvider+".#"]; ok {
 				stateAttr = attr.NameInProvider
-			} else if _, ok := instanceState.Attributes[attr.PreviousNameInProvider+".0"]; ok {
+			} else if _, ok := instanceState.Attributes[attr.PreviousNameInProvider+".#"]; ok {
 				stateAttr = attr.PreviousNameInProvider
 			}
 			stateAttrVal := instanceState.Attributes[stateAttr]
@@ -298,9 +293,15 @@ func testAccAWSAuthBackendRoleCheck_attrs(backend, role string) resource.TestChe
 						return fmt.Errorf("expected %s to have %d entries in state, has %d", stateAttr, len(vaultRespVal), count)
 					}
 					for i := 0; i < count; i++ {
-						stateData := instanceState.Attributes[stateAttr+"."+strconv.Itoa(i)]
-						if stateData != vaultRespVal[i] {
-							return fmt.Errorf("expected item %d of %s (%s in state) of %q to be %q, got %q", i, attr.NameInVault, stateAttr, endpoint, stateData, vaultRespVal[i])
+						found := false
+						for stateKey, stateValue := range instanceState.Attributes {
+							if strings.HasPrefix(stateKey, stateAttr+".") && vaultRespVal[i] == stateValue {
+								found = true
+								break
+							}
+						}
+						if !found {
+							return fmt.Errorf("expected item %d of %s (%s in state) of %q to be in state but wasn't", i, attr.NameInVault, stateAttr, endpoint)
 						}
 					}
 					match = true
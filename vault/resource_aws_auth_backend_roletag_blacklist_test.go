@@ -195,11 +195,11 @@ func testAccAWSAuthBackendRoleTagBlacklistCheck_attrs(backend string) resource.T
 		}
 
 		if respBuffer != stateBuffer {
-			return fmt.Errorf("Expected safety_buffer of %q to be %q, got %q", endpoint, stateBuffer, respBuffer)
+			return fmt.Errorf("Expected safety_buffer of %q to be %d, got %d", endpoint, stateBuffer, respBuffer)
 		}
 
 		if respDisable != stateDisable {
-			return fmt.Errorf("Expected disable_periodic_tidy of %q to be %q, got %q", endpoint, stateBuffer, respBuffer)
+			return fmt.Errorf("Expected disable_periodic_tidy of %q to be %t, got %t", endpoint, stateDisable, respDisable)
 		}
 		return nil
 	}
@@ -20,20 +20,14 @@ func awsSecretBackendResource() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: withRootRotationSchema(map[string]*schema.Schema{
 			"path": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Default:     "aws",
-				Description: "Path to mount the backend at.",
-				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
-					value := v.(string)
-					if strings.HasSuffix(value, "/") {
-						errs = append(errs, fmt.Errorf("path cannot end in '/'"))
-					}
-					return
-				},
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "aws",
+				Description:  "Path to mount the backend at.",
+				ValidateFunc: validateMountPath,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return old+"/" == new || new+"/" == old
 				},
@@ -41,7 +35,6 @@ func awsSecretBackendResource() *schema.Resource {
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "Human-friendly description of the mount for the backend.",
 			},
 			"default_lease_ttl_seconds": {
@@ -58,16 +51,18 @@ func awsSecretBackendResource() *schema.Resource {
 				Description: "Maximum possible lease duration for secrets in seconds",
 			},
 			"access_key": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The AWS Access Key ID to use when generating new credentials.",
-				Sensitive:   true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The AWS Access Key ID to use when generating new credentials.",
+				Sensitive:     true,
+				ConflictsWith: []string{"identity_token_audience"},
 			},
 			"secret_key": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The AWS Secret Access Key to use when generating new credentials.",
-				Sensitive:   true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The AWS Secret Access Key to use when generating new credentials.",
+				Sensitive:     true,
+				ConflictsWith: []string{"identity_token_audience"},
 			},
 			"region": {
 				Type:        schema.TypeString,
@@ -75,7 +70,46 @@ func awsSecretBackendResource() *schema.Resource {
 				Computed:    true,
 				Description: "The AWS region to make API calls against. Defaults to us-east-1.",
 			},
-		},
+			"iam_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies a custom HTTP IAM endpoint to use.",
+			},
+			"sts_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies a custom HTTP STS endpoint to use.",
+			},
+			"username_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Template describing how dynamic usernames are generated. Requires Vault 1.7 or later.",
+			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Role ARN to assume for plugin identity token federation. Requires Vault Enterprise 1.16 or later.",
+			},
+			"identity_token_audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The audience claim value of plugin identity tokens, required for identity token federation. Requires Vault Enterprise 1.16 or later.",
+			},
+			"identity_token_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL of generated identity tokens in seconds. Requires Vault Enterprise 1.16 or later.",
+			},
+			"rotate_root": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"identity_token_audience"},
+				Description:   "Rotate the root credentials after they are written, so that only Vault knows the secret key.",
+			},
+		}),
 	}
 }
 
@@ -86,13 +120,10 @@ func awsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	description := d.Get("description").(string)
 	defaultTTL := d.Get("default_lease_ttl_seconds").(int)
 	maxTTL := d.Get("max_lease_ttl_seconds").(int)
-	accessKey := d.Get("access_key").(string)
-	secretKey := d.Get("secret_key").(string)
-	region := d.Get("region").(string)
 
 	d.Partial(true)
 	log.Printf("[DEBUG] Mounting AWS backend at %q", path)
-	err := client.Sys().Mount(path, &api.MountInput{
+	err := mountSecretBackend(client, path, &api.MountInput{
 		Type:        "aws",
 		Description: description,
 		Config: api.MountConfigInput{
@@ -111,25 +142,9 @@ func awsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetPartial("default_lease_ttl_seconds")
 	d.SetPartial("max_lease_ttl_seconds")
 
-	log.Printf("[DEBUG] Writing root credentials to %q", path+"/config/root")
-	data := map[string]interface{}{
-		"access_key": accessKey,
-		"secret_key": secretKey,
-	}
-	if region != "" {
-		data["region"] = region
-	}
-	_, err = client.Logical().Write(path+"/config/root", data)
-	if err != nil {
-		return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
-	}
-	log.Printf("[DEBUG] Wrote root credentials to %q", path+"/config/root")
-	d.SetPartial("access_key")
-	d.SetPartial("secret_key")
-	if region == "" {
-		d.Set("region", "us-east-1")
+	if err := awsSecretBackendWriteRootConfig(client, path, d); err != nil {
+		return err
 	}
-	d.SetPartial("region")
 	d.Partial(false)
 
 	return awsSecretBackendRead(d, meta)
@@ -141,7 +156,7 @@ func awsSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 	path := d.Id()
 
 	log.Printf("[DEBUG] Reading AWS backend mount %q from Vault", path)
-	mounts, err := client.Sys().ListMounts()
+	mounts, err := listMounts(client)
 	if err != nil {
 		return fmt.Errorf("error reading mount %q: %s", path, err)
 	}
@@ -161,9 +176,27 @@ func awsSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
 	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
 
-	// access key, secret key, and region, sadly, we can't read out
+	// the access key and secret key, sadly, we can't read out
 	// the API doesn't support it
 	// So... if they drift, they drift.
+	log.Printf("[DEBUG] Reading root configuration from %q", path+"/config/root")
+	resp, err := client.Logical().Read(path + "/config/root")
+	if err != nil {
+		return fmt.Errorf("error reading root configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read root configuration from %q", path+"/config/root")
+	// older versions of Vault can't read the root configuration at all.
+	if resp != nil {
+		for _, k := range []string{
+			"region", "iam_endpoint", "sts_endpoint", "username_template",
+			"role_arn", "identity_token_audience", "identity_token_ttl",
+		} {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+		readRootRotationData(d, resp.Data)
+	}
 
 	return nil
 }
@@ -173,44 +206,89 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	path := d.Id()
 	d.Partial(true)
-	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") {
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") || d.HasChange("description") {
 		config := api.MountConfigInput{
 			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
 			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
 		}
-		log.Printf("[DEBUG] Updating lease TTLs for %q", path)
-		err := client.Sys().TuneMount(path, config)
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			config.Description = &description
+		}
+		log.Printf("[DEBUG] Tuning mount %q", path)
+		err := tuneMount(client, path, config)
 		if err != nil {
-			return fmt.Errorf("error updating mount TTLs for %q: %s", path, err)
+			return fmt.Errorf("error tuning mount %q: %s", path, err)
 		}
-		log.Printf("[DEBUG] Updated lease TTLs for %q", path)
+		log.Printf("[DEBUG] Tuned mount %q", path)
 		d.SetPartial("default_lease_ttl_seconds")
 		d.SetPartial("max_lease_ttl_seconds")
+		d.SetPartial("description")
 	}
-	if d.HasChange("access_key") || d.HasChange("secret_key") || d.HasChange("region") {
-		log.Printf("[DEBUG] Updating root credentials at %q", path+"/config/root")
-		data := map[string]interface{}{
-			"access_key": d.Get("access_key").(string),
-			"secret_key": d.Get("secret_key").(string),
-		}
-		region := d.Get("region").(string)
-		if region != "" {
-			data["region"] = region
+	err := writeBackendConfigOnChange(d, awsSecretBackendRootConfigFields, func() error {
+		return awsSecretBackendWriteRootConfig(client, path, d)
+	})
+	if err != nil {
+		return err
+	}
+	d.Partial(false)
+	return awsSecretBackendRead(d, meta)
+}
+
+// awsSecretBackendRootConfigFields are the fields of the AWS root
+// configuration, written to config/root.
+var awsSecretBackendRootConfigFields = append([]string{
+	"access_key",
+	"secret_key",
+	"region",
+	"iam_endpoint",
+	"sts_endpoint",
+	"username_template",
+	"role_arn",
+	"identity_token_audience",
+	"identity_token_ttl",
+	"rotate_root",
+}, rootRotationFields...)
+
+func awsSecretBackendWriteRootConfig(client *api.Client, path string, d *schema.ResourceData) error {
+	log.Printf("[DEBUG] Writing root credentials to %q", path+"/config/root")
+	data := map[string]interface{}{}
+	for _, k := range []string{
+		"access_key", "secret_key", "region", "iam_endpoint", "sts_endpoint",
+		"username_template", "role_arn", "identity_token_audience",
+	} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
 		}
-		_, err := client.Logical().Write(path+"/config/root", data)
+	}
+	if v, ok := d.GetOk("identity_token_ttl"); ok {
+		data["identity_token_ttl"] = v.(int)
+	}
+	if err := setRootRotationData(client, d, data); err != nil {
+		return err
+	}
+	_, err := client.Logical().Write(path+"/config/root", data)
+	if err != nil {
+		return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote root credentials to %q", path+"/config/root")
+	for _, k := range awsSecretBackendRootConfigFields {
+		d.SetPartial(k)
+	}
+	if d.Get("region").(string) == "" {
+		d.Set("region", "us-east-1")
+	}
+
+	if d.Get("rotate_root").(bool) && (d.HasChange("access_key") || d.HasChange("secret_key") || d.HasChange("rotate_root")) {
+		log.Printf("[DEBUG] Rotating root credentials at %q", path+"/config/rotate-root")
+		_, err := client.Logical().Write(path+"/config/rotate-root", nil)
 		if err != nil {
-			return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
-		}
-		log.Printf("[DEBUG] Updated root credentials at %q", path+"/config/root")
-		d.SetPartial("access_key")
-		d.SetPartial("secret_key")
-		if region == "" {
-			d.Set("region", "us-east-1")
+			return fmt.Errorf("error rotating root credentials for %q: %s", path, err)
 		}
-		d.SetPartial("region")
+		log.Printf("[DEBUG] Rotated root credentials at %q", path+"/config/rotate-root")
 	}
-	d.Partial(false)
-	return awsSecretBackendRead(d, meta)
+
+	return nil
 }
 
 func awsSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
@@ -219,7 +297,7 @@ func awsSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
 	path := d.Id()
 
 	log.Printf("[DEBUG] Unmounting AWS backend %q", path)
-	err := client.Sys().Unmount(path)
+	err := unmountSecretBackend(client, path)
 	if err != nil {
 		return fmt.Errorf("error unmounting AWS backend from %q: %s", path, err)
 	}
@@ -231,7 +309,7 @@ func awsSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, err
 	client := meta.(*api.Client)
 	path := d.Id()
 	log.Printf("[DEBUG] Checking if AWS backend exists at %q", path)
-	mounts, err := client.Sys().ListMounts()
+	mounts, err := listMounts(client)
 	if err != nil {
 		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
 	}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -36,15 +37,77 @@ func awsSecretBackendRoleResource() *schema.Resource {
 			"policy_arn": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"policy"},
+				ConflictsWith: []string{"policy", "policy_arns", "policy_document"},
 				Description:   "ARN for an existing IAM policy the role should use.",
+				Deprecated:    "use policy_arns instead",
 			},
 			"policy": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				ConflictsWith:    []string{"policy_arn"},
+				ConflictsWith:    []string{"policy_arn", "policy_arns", "policy_document"},
 				Description:      "IAM policy the role should use in JSON format.",
 				DiffSuppressFunc: jsonDiffSuppress,
+				Deprecated:       "use policy_document instead",
+			},
+			"credential_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The type of credential to generate. Must be one of \"iam_user\", \"assumed_role\", \"federation_token\" or \"session_token\".",
+				ValidateFunc: validation.StringInSlice([]string{"iam_user", "assumed_role", "federation_token", "session_token"}, false),
+			},
+			"policy_document": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "IAM policy the role should use in JSON format.",
+				DiffSuppressFunc: jsonDiffSuppress,
+			},
+			"policy_arns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "ARNs of existing IAM policies the role should use.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"role_arns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "ARNs of the AWS roles allowed to be assumed, for assumed_role credentials.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"iam_groups": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Names of IAM groups generated users are added to, or whose policies are used for the other credential types.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"user_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The path of generated IAM users, for iam_user credentials.",
+			},
+			"permissions_boundary_arn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ARN of the permissions boundary attached to generated IAM users, for iam_user credentials.",
+			},
+			"default_sts_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The default TTL in seconds of STS credentials, for assumed_role and federation_token credentials.",
+			},
+			"max_sts_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum TTL in seconds of STS credentials, for assumed_role and federation_token credentials.",
 			},
 		},
 	}
@@ -57,9 +120,13 @@ func awsSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 	name := d.Get("name").(string)
 	policyARN := d.Get("policy_arn").(string)
 	policy := d.Get("policy").(string)
+	policyDocument := d.Get("policy_document").(string)
+	policyARNs := toStringArray(d.Get("policy_arns").([]interface{}))
+	roleARNs := toStringArray(d.Get("role_arns").([]interface{}))
+	iamGroups := toStringArray(d.Get("iam_groups").([]interface{}))
 
-	if policy == "" && policyARN == "" {
-		return fmt.Errorf("either policy or policy_arn must be set.")
+	if policy == "" && policyARN == "" && policyDocument == "" && len(policyARNs) == 0 && len(roleARNs) == 0 && len(iamGroups) == 0 {
+		return fmt.Errorf("one of policy_document, policy_arns, role_arns or iam_groups must be set.")
 	}
 
 	data := map[string]interface{}{}
@@ -69,6 +136,24 @@ func awsSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 	if policyARN != "" {
 		data["arn"] = policyARN
 	}
+	// the deprecated fields are sent on their own, so that roles keep
+	// working against versions of Vault without credential types.
+	if policy == "" && policyARN == "" {
+		data["policy_document"] = policyDocument
+		data["policy_arns"] = policyARNs
+		data["role_arns"] = roleARNs
+		data["iam_groups"] = iamGroups
+	}
+	for _, k := range []string{"credential_type", "user_path", "permissions_boundary_arn"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	for _, k := range []string{"default_sts_ttl", "max_sts_ttl"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(int)
+		}
+	}
 	log.Printf("[DEBUG] Creating role %q on AWS backend %q", name, backend)
 	_, err := client.Logical().Write(backend+"/roles/"+name, data)
 	if err != nil {
@@ -100,13 +185,60 @@ func awsSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		d.SetId("")
 		return nil
 	}
-	d.Set("policy", secret.Data["policy"])
-	d.Set("policy_arn", secret.Data["arn"])
+	if _, ok := secret.Data["credential_types"]; !ok {
+		// older versions of Vault only know about policy and arn.
+		d.Set("policy", secret.Data["policy"])
+		d.Set("policy_arn", secret.Data["arn"])
+	} else if err := awsSecretBackendRoleReadCredentialTypes(d, secret); err != nil {
+		return err
+	}
 	d.Set("backend", strings.Join(pathPieces[:len(pathPieces)-2], "/"))
 	d.Set("name", pathPieces[len(pathPieces)-1])
 	return nil
 }
 
+// awsSecretBackendRoleReadCredentialTypes sets the role fields returned by
+// versions of Vault supporting credential types, keeping the deprecated
+// policy and policy_arn fields in sync for configurations using them.
+func awsSecretBackendRoleReadCredentialTypes(d *schema.ResourceData, secret *api.Secret) error {
+	if v, ok := secret.Data["credential_types"].([]interface{}); ok && len(v) == 1 {
+		d.Set("credential_type", v[0])
+	}
+
+	policyDocument, _ := secret.Data["policy_document"].(string)
+	policyARNs := []string{}
+	if v, ok := secret.Data["policy_arns"].([]interface{}); ok {
+		policyARNs = jsonStringArrayToStringArray(v)
+	}
+	if _, ok := d.GetOk("policy"); ok {
+		d.Set("policy", policyDocument)
+	} else {
+		d.Set("policy_document", policyDocument)
+	}
+	if _, ok := d.GetOk("policy_arn"); ok && len(policyARNs) == 1 {
+		d.Set("policy_arn", policyARNs[0])
+	} else if err := d.Set("policy_arns", policyARNs); err != nil {
+		return fmt.Errorf("error setting policy_arns in state: %s", err)
+	}
+
+	for _, k := range []string{"role_arns", "iam_groups"} {
+		values := []string{}
+		if v, ok := secret.Data[k].([]interface{}); ok {
+			values = jsonStringArrayToStringArray(v)
+		}
+		if err := d.Set(k, values); err != nil {
+			return fmt.Errorf("error setting %s in state: %s", k, err)
+		}
+	}
+	for _, k := range []string{"user_path", "permissions_boundary_arn", "default_sts_ttl", "max_sts_ttl"} {
+		if v, ok := secret.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
 func awsSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -119,6 +119,46 @@ func TestAccAWSSecretBackendRole_nested(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecretBackendRole_credentialTypes(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-aws")
+	name := acctest.RandomWithPrefix("tf-test-aws")
+	accessKey, secretKey := getTestAWSCreds(t)
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccAWSSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecretBackendRoleConfig_credentialTypes(name, backend, accessKey, secretKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.iam_user", "credential_type", "iam_user"),
+					testCheckResourceAttrJSON("vault_aws_secret_backend_role.iam_user", "policy_document", testAccAWSSecretBackendRolePolicyInline_basic),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.iam_user", "policy_arns.#", "2"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.iam_user", "iam_groups.#", "1"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.iam_user", "user_path", "/vault/"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.iam_user", "permissions_boundary_arn", testAccAWSSecretBackendRolePolicyArn_basic),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.assumed_role", "credential_type", "assumed_role"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.assumed_role", "role_arns.#", "1"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.assumed_role", "role_arns.0", "arn:aws:iam::123456789123:role/foo"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.assumed_role", "default_sts_ttl", "900"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.assumed_role", "max_sts_ttl", "3600"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.federation_token", "credential_type", "federation_token"),
+				),
+			},
+			{
+				ResourceName:      "vault_aws_secret_backend_role.iam_user",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "vault_aws_secret_backend_role.assumed_role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccAWSSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -180,3 +220,42 @@ resource "vault_aws_secret_backend_role" "test_policy_arn" {
 }
 `, path, accessKey, secretKey, name, testAccAWSSecretBackendRolePolicyInline_updated, name, testAccAWSSecretBackendRolePolicyArn_updated)
 }
+
+func testAccAWSSecretBackendRoleConfig_credentialTypes(name, path, accessKey, secretKey string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_aws_secret_backend_role" "iam_user" {
+  name = "%s-iam-user"
+  backend = "${vault_aws_secret_backend.test.path}"
+  credential_type = "iam_user"
+  policy_document = %q
+  policy_arns = ["%s", "%s"]
+  iam_groups = ["developers"]
+  user_path = "/vault/"
+  permissions_boundary_arn = "%s"
+}
+
+resource "vault_aws_secret_backend_role" "assumed_role" {
+  name = "%s-assumed-role"
+  backend = "${vault_aws_secret_backend.test.path}"
+  credential_type = "assumed_role"
+  role_arns = ["arn:aws:iam::123456789123:role/foo"]
+  default_sts_ttl = 900
+  max_sts_ttl = 3600
+}
+
+resource "vault_aws_secret_backend_role" "federation_token" {
+  name = "%s-federation-token"
+  backend = "${vault_aws_secret_backend.test.path}"
+  credential_type = "federation_token"
+  policy_document = %q
+}
+`, path, accessKey, secretKey,
+		name, testAccAWSSecretBackendRolePolicyInline_basic, testAccAWSSecretBackendRolePolicyArn_basic, testAccAWSSecretBackendRolePolicyArn_updated, testAccAWSSecretBackendRolePolicyArn_basic,
+		name, name, testAccAWSSecretBackendRolePolicyInline_updated)
+}
@@ -0,0 +1,171 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	awsSecretBackendStaticRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/static-roles/.+$")
+	awsSecretBackendStaticRoleNameFromPathRegex    = regexp.MustCompile("^.+/static-roles/(.+)$")
+)
+
+func awsSecretBackendStaticRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: awsSecretBackendStaticRoleWrite,
+		Read:   awsSecretBackendStaticRoleRead,
+		Update: awsSecretBackendStaticRoleWrite,
+		Delete: awsSecretBackendStaticRoleDelete,
+		Exists: awsSecretBackendStaticRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the AWS Secret Backend the static role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the static role.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the existing IAM user whose access keys Vault manages.",
+			},
+			"rotation_period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "How often in seconds Vault rotates the access keys of the IAM user, at least 60.",
+				ValidateFunc: validation.IntAtLeast(60),
+			},
+		},
+	}
+}
+
+func awsSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := awsSecretBackendStaticRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"username":        d.Get("username").(string),
+		"rotation_period": d.Get("rotation_period").(int),
+	}
+
+	log.Printf("[DEBUG] Writing AWS secret backend static role %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing AWS secret backend static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote AWS secret backend static role %q", path)
+
+	d.SetId(path)
+	return awsSecretBackendStaticRoleRead(d, meta)
+}
+
+func awsSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := awsSecretBackendStaticRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid static role ID %q: %s", path, err)
+	}
+	name, err := awsSecretBackendStaticRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid static role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading AWS secret backend static role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading AWS secret backend static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read AWS secret backend static role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] AWS secret backend static role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	d.Set("username", resp.Data["username"])
+	d.Set("rotation_period", resp.Data["rotation_period"])
+
+	return nil
+}
+
+func awsSecretBackendStaticRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting AWS secret backend static role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting AWS secret backend static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted AWS secret backend static role %q", path)
+	return nil
+}
+
+func awsSecretBackendStaticRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if AWS secret backend static role %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if AWS secret backend static role %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if AWS secret backend static role %q exists", path)
+	return resp != nil, nil
+}
+
+func awsSecretBackendStaticRolePath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/static-roles/" + strings.Trim(name, "/")
+}
+
+func awsSecretBackendStaticRoleNameFromPath(path string) (string, error) {
+	if !awsSecretBackendStaticRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no static role found")
+	}
+	res := awsSecretBackendStaticRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for static role", len(res))
+	}
+	return res[1], nil
+}
+
+func awsSecretBackendStaticRoleBackendFromPath(path string) (string, error) {
+	if !awsSecretBackendStaticRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := awsSecretBackendStaticRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
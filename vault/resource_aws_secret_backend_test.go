@@ -47,6 +47,26 @@ func TestAccAWSSecretBackend_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecretBackend_endpoints(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-aws")
+	accessKey, secretKey := getTestAWSCreds(t)
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccAWSSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecretBackendConfig_endpoints(path, accessKey, secretKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "iam_endpoint", "https://iam.amazonaws.com"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "sts_endpoint", "https://sts.us-west-1.amazonaws.com"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "username_template", "{{ printf \"vault-%s\" (random 20) }}"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSecretBackend_import(t *testing.T) {
 	path := acctest.RandomWithPrefix("tf-test-aws")
 	accessKey, secretKey := getTestAWSCreds(t)
@@ -72,7 +92,7 @@ func TestAccAWSSecretBackend_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// the API can't serve these fields, so ignore them
-				ImportStateVerifyIgnore: []string{"access_key", "secret_key", "region"},
+				ImportStateVerifyIgnore: []string{"access_key", "secret_key", "region", "rotate_root"},
 			},
 		},
 	})
@@ -125,3 +145,16 @@ resource "vault_aws_secret_backend" "test" {
   region = "us-west-1"
 }`, path, accessKey, secretKey)
 }
+
+func testAccAWSSecretBackendConfig_endpoints(path, accessKey, secretKey string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+  region = "us-west-1"
+  iam_endpoint = "https://iam.amazonaws.com"
+  sts_endpoint = "https://sts.us-west-1.amazonaws.com"
+  username_template = "{{ printf \"vault-%%s\" (random 20) }}"
+}`, path, accessKey, secretKey)
+}
@@ -0,0 +1,305 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// azureSecretBackendConfigFields are the fields of the Azure configuration.
+var azureSecretBackendConfigFields = append([]string{
+	"subscription_id",
+	"tenant_id",
+	"client_id",
+	"client_secret",
+	"environment",
+	"identity_token_audience",
+	"identity_token_ttl",
+	"rotate_root",
+}, rootRotationFields...)
+
+func azureSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: azureSecretBackendCreate,
+		Read:   azureSecretBackendRead,
+		Update: azureSecretBackendUpdate,
+		Delete: azureSecretBackendDelete,
+		Exists: azureSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: withRootRotationSchema(map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "azure",
+				Description:  "Path to mount the backend at.",
+				ValidateFunc: validateMountPath,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lease duration for secrets in seconds",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum possible lease duration for secrets in seconds",
+			},
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The subscription ID of the Azure Active Directory.",
+				Sensitive:   true,
+			},
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The tenant ID of the Azure Active Directory.",
+				Sensitive:   true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The client ID of the application Vault uses to manage service principals.",
+				Sensitive:   true,
+			},
+			"client_secret": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The client secret of the application Vault uses to manage service principals.",
+				Sensitive:     true,
+				ConflictsWith: []string{"identity_token_audience"},
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Azure environment, e.g. AzurePublicCloud or AzureUSGovernmentCloud.",
+			},
+			"identity_token_audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The audience claim value of plugin identity tokens, required for identity token federation. Requires Vault Enterprise 1.17 or later.",
+			},
+			"identity_token_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL of generated identity tokens in seconds. Requires Vault Enterprise 1.17 or later.",
+			},
+			"rotate_root": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"identity_token_audience"},
+				Description:   "Rotate the client secret after it is written, so that only Vault knows it.",
+			},
+		}),
+	}
+}
+
+func azureSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	description := d.Get("description").(string)
+	defaultTTL := d.Get("default_lease_ttl_seconds").(int)
+	maxTTL := d.Get("max_lease_ttl_seconds").(int)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting Azure backend at %q", path)
+	err := mountSecretBackend(client, path, &api.MountInput{
+		Type:        "azure",
+		Description: description,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", defaultTTL),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", maxTTL),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted Azure backend at %q", path)
+	d.SetId(path)
+
+	d.SetPartial("path")
+	d.SetPartial("description")
+	d.SetPartial("default_lease_ttl_seconds")
+	d.SetPartial("max_lease_ttl_seconds")
+
+	if err := azureSecretBackendWriteConfig(client, path, d); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return azureSecretBackendRead(d, meta)
+}
+
+func azureSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading Azure backend mount %q from Vault", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Azure backend mount %q from Vault", path)
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
+
+	configPath := azureSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading Azure configuration from %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading Azure configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Azure configuration from %q", configPath)
+	if resp != nil {
+		// the client secret is never returned.
+		for _, k := range []string{
+			"subscription_id", "tenant_id", "client_id", "environment",
+			"identity_token_audience", "identity_token_ttl",
+		} {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+		readRootRotationData(d, resp.Data)
+	}
+
+	return nil
+}
+
+func azureSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	d.Partial(true)
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") || d.HasChange("description") {
+		config := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			config.Description = &description
+		}
+		log.Printf("[DEBUG] Tuning mount %q", path)
+		err := tuneMount(client, path, config)
+		if err != nil {
+			return fmt.Errorf("error tuning mount %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Tuned mount %q", path)
+		d.SetPartial("default_lease_ttl_seconds")
+		d.SetPartial("max_lease_ttl_seconds")
+		d.SetPartial("description")
+	}
+	err := writeBackendConfigOnChange(d, azureSecretBackendConfigFields, func() error {
+		return azureSecretBackendWriteConfig(client, path, d)
+	})
+	if err != nil {
+		return err
+	}
+	d.Partial(false)
+	return azureSecretBackendRead(d, meta)
+}
+
+func azureSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting Azure backend %q", path)
+	err := unmountSecretBackend(client, path)
+	if err != nil {
+		return fmt.Errorf("error unmounting Azure backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted Azure backend %q", path)
+	return nil
+}
+
+func azureSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if Azure backend exists at %q", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if Azure backend exists at %q", path)
+	_, ok := mounts[strings.Trim(path, "/")+"/"]
+	return ok, nil
+}
+
+func azureSecretBackendWriteConfig(client *api.Client, path string, d *schema.ResourceData) error {
+	configPath := azureSecretBackendConfigPath(path)
+
+	log.Printf("[DEBUG] Writing Azure configuration to %q", configPath)
+	data := map[string]interface{}{}
+	for _, k := range []string{
+		"subscription_id", "tenant_id", "client_id", "client_secret",
+		"environment", "identity_token_audience",
+	} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("identity_token_ttl"); ok {
+		data["identity_token_ttl"] = v.(int)
+	}
+	if err := setRootRotationData(client, d, data); err != nil {
+		return err
+	}
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing Azure configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Azure configuration to %q", configPath)
+	for _, k := range azureSecretBackendConfigFields {
+		d.SetPartial(k)
+	}
+
+	if d.Get("rotate_root").(bool) && (d.HasChange("client_secret") || d.HasChange("rotate_root")) {
+		rotatePath := strings.Trim(path, "/") + "/rotate-root"
+		log.Printf("[DEBUG] Rotating root credentials at %q", rotatePath)
+		if _, err := client.Logical().Write(rotatePath, nil); err != nil {
+			return fmt.Errorf("error rotating root credentials for %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Rotated root credentials at %q", rotatePath)
+	}
+
+	return nil
+}
+
+func azureSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
@@ -0,0 +1,317 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"encoding/json"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	azureSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
+	azureSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+)$")
+)
+
+func azureSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: azureSecretBackendRoleWrite,
+		Read:   azureSecretBackendRoleRead,
+		Update: azureSecretBackendRoleWrite,
+		Delete: azureSecretBackendRoleDelete,
+		Exists: azureSecretBackendRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Azure Secret Backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the role.",
+			},
+			"azure_roles": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Azure roles assigned to the generated service principals.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The name of the Azure role, looked up to find its ID if role_id is not set.",
+						},
+						"role_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The ID of the Azure role.",
+						},
+						"scope": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The scope the role is assigned at.",
+						},
+					},
+				},
+			},
+			"azure_groups": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Azure groups the generated service principals are added to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the Azure group.",
+						},
+						"object_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The object ID of the Azure group.",
+						},
+					},
+				},
+			},
+			"application_object_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Object ID of an existing application to generate credentials for, instead of creating service principals.",
+			},
+			"permanently_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether generated applications are permanently deleted from Azure when their credentials are revoked.",
+			},
+			"sign_in_audience": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The Microsoft accounts generated applications support.",
+				ValidateFunc: validation.StringInSlice([]string{"AzureADMyOrg", "AzureADMultipleOrgs", "AzureADandPersonalMicrosoftAccount", "PersonalMicrosoftAccount"}, false),
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Tags of generated applications, in the key:value format.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lease duration of generated credentials in seconds.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum lease duration of generated credentials in seconds.",
+			},
+		},
+	}
+}
+
+func azureSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := azureSecretBackendRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"application_object_id": d.Get("application_object_id").(string),
+		"tags":                  toStringArray(d.Get("tags").([]interface{})),
+	}
+
+	// Vault expects the role and group assignments as JSON strings.
+	azureRoles, err := json.Marshal(azureSecretBackendRoleExpandList(d.Get("azure_roles").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("error encoding azure_roles: %s", err)
+	}
+	data["azure_roles"] = string(azureRoles)
+	azureGroups, err := json.Marshal(azureSecretBackendRoleExpandList(d.Get("azure_groups").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("error encoding azure_groups: %s", err)
+	}
+	data["azure_groups"] = string(azureGroups)
+
+	if v, ok := d.GetOk("sign_in_audience"); ok {
+		data["sign_in_audience"] = v.(string)
+	}
+	if v, ok := d.GetOk("permanently_delete"); ok {
+		data["permanently_delete"] = v.(bool)
+	}
+	for _, k := range []string{"ttl", "max_ttl"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(int)
+		}
+	}
+
+	log.Printf("[DEBUG] Writing Azure secret backend role %q", path)
+	_, err = client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing Azure secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Azure secret backend role %q", path)
+
+	d.SetId(path)
+	return azureSecretBackendRoleRead(d, meta)
+}
+
+func azureSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := azureSecretBackendRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+	name, err := azureSecretBackendRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading Azure secret backend role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Azure secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Azure secret backend role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Azure secret backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range []string{"application_object_id", "permanently_delete", "sign_in_audience", "ttl", "max_ttl"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+	if err := d.Set("tags", resp.Data["tags"]); err != nil {
+		return fmt.Errorf("error setting tags in state: %s", err)
+	}
+	if err := d.Set("azure_roles", azureSecretBackendRoleFlattenList(resp.Data["azure_roles"], "role_name", "role_id", "scope")); err != nil {
+		return fmt.Errorf("error setting azure_roles in state: %s", err)
+	}
+	if err := d.Set("azure_groups", azureSecretBackendRoleFlattenList(resp.Data["azure_groups"], "group_name", "object_id")); err != nil {
+		return fmt.Errorf("error setting azure_groups in state: %s", err)
+	}
+
+	return nil
+}
+
+func azureSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting Azure secret backend role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting Azure secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted Azure secret backend role %q", path)
+	return nil
+}
+
+func azureSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if Azure secret backend role %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if Azure secret backend role %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if Azure secret backend role %q exists", path)
+	return resp != nil, nil
+}
+
+// azureSecretBackendRoleExpandList converts azure_roles or azure_groups
+// blocks into the objects Vault expects, leaving out unset keys.
+func azureSecretBackendRoleExpandList(raw []interface{}) []map[string]interface{} {
+	items := make([]map[string]interface{}, 0, len(raw))
+	for _, r := range raw {
+		item := map[string]interface{}{}
+		for k, v := range r.(map[string]interface{}) {
+			if s, ok := v.(string); ok && s != "" {
+				item[k] = s
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// azureSecretBackendRoleFlattenList converts the role or group assignments
+// returned by Vault into blocks holding the given keys.
+func azureSecretBackendRoleFlattenList(raw interface{}, keys ...string) []interface{} {
+	items, ok := raw.([]interface{})
+	if !ok {
+		return nil
+	}
+	flattened := make([]interface{}, 0, len(items))
+	for _, i := range items {
+		item, ok := i.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		block := map[string]interface{}{}
+		for _, k := range keys {
+			v, _ := item[k].(string)
+			block[k] = v
+		}
+		flattened = append(flattened, block)
+	}
+	return flattened
+}
+
+func azureSecretBackendRolePath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/roles/" + strings.Trim(name, "/")
+}
+
+func azureSecretBackendRoleNameFromPath(path string) (string, error) {
+	if !azureSecretBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := azureSecretBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
+
+func azureSecretBackendRoleBackendFromPath(path string) (string, error) {
+	if !azureSecretBackendRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := azureSecretBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
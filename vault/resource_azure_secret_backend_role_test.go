@@ -0,0 +1,90 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccAzureSecretBackendRole_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-azure")
+	name := acctest.RandomWithPrefix("tf-test-azure")
+	subscriptionID, tenantID, clientID, clientSecret := getTestAzureCreds(t)
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccAzureSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureSecretBackendRoleConfig_basic(path, subscriptionID, tenantID, clientID, clientSecret, name, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test", "azure_roles.#", "1"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test", "azure_roles.0.role_name", "Reader"),
+					resource.TestCheckResourceAttrSet("vault_azure_secret_backend_role.test", "azure_roles.0.role_id"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test", "ttl", "3600"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test", "permanently_delete", "true"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test", "sign_in_audience", "AzureADMyOrg"),
+				),
+			},
+			{
+				Config: testAccAzureSecretBackendRoleConfig_basic(path, subscriptionID, tenantID, clientID, clientSecret, name, 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test", "ttl", "1800"),
+				),
+			},
+			{
+				ResourceName:      "vault_azure_secret_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureSecretBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_azure_secret_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccAzureSecretBackendRoleConfig_basic(path, subscriptionID, tenantID, clientID, clientSecret, name string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_azure_secret_backend" "test" {
+  path = "%s"
+  subscription_id = "%s"
+  tenant_id = "%s"
+  client_id = "%s"
+  client_secret = "%s"
+}
+
+resource "vault_azure_secret_backend_role" "test" {
+  backend = "${vault_azure_secret_backend.test.path}"
+  name = "%s"
+  ttl = %d
+  max_ttl = 7200
+  permanently_delete = true
+  sign_in_audience = "AzureADMyOrg"
+
+  azure_roles {
+    role_name = "Reader"
+    scope = "/subscriptions/%s"
+  }
+}`, path, subscriptionID, tenantID, clientID, clientSecret, name, ttl, subscriptionID)
+}
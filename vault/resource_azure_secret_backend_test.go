@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccAzureSecretBackend_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-azure")
+	subscriptionID, tenantID, clientID, clientSecret := getTestAzureCreds(t)
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccAzureSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureSecretBackendConfig_basic(path, subscriptionID, tenantID, clientID, clientSecret, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_azure_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend.test", "default_lease_ttl_seconds", "3600"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend.test", "subscription_id", subscriptionID),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend.test", "tenant_id", tenantID),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend.test", "client_id", clientID),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend.test", "environment", "AzurePublicCloud"),
+				),
+			},
+			{
+				Config: testAccAzureSecretBackendConfig_basic(path, subscriptionID, tenantID, clientID, clientSecret, 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_azure_secret_backend.test", "default_lease_ttl_seconds", "1800"),
+				),
+			},
+			{
+				ResourceName:      "vault_azure_secret_backend.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the API never returns the client secret
+				ImportStateVerifyIgnore: []string{"client_secret", "rotate_root"},
+			},
+		},
+	})
+}
+
+func testAccAzureSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_azure_secret_backend" {
+			continue
+		}
+		for path, mount := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if mount.Type == "azure" && path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccAzureSecretBackendConfig_basic(path, subscriptionID, tenantID, clientID, clientSecret string, defaultTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_azure_secret_backend" "test" {
+  path = "%s"
+  default_lease_ttl_seconds = %d
+  subscription_id = "%s"
+  tenant_id = "%s"
+  client_id = "%s"
+  client_secret = "%s"
+  environment = "AzurePublicCloud"
+}`, path, defaultTTL, subscriptionID, tenantID, clientID, clientSecret)
+}
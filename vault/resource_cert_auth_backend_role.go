@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,6 +11,11 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+var (
+	certAuthResourceBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/certs/.+$")
+	certAuthResourceNameFromPathRegex    = regexp.MustCompile("^auth/.+/certs/(.+)$")
+)
+
 func certAuthBackendRoleResource() *schema.Resource {
 	return &schema.Resource{
 		SchemaVersion: 1,
@@ -18,6 +24,9 @@ func certAuthBackendRoleResource() *schema.Resource {
 		Update: certAuthResourceUpdate,
 		Read:   certAuthResourceRead,
 		Delete: certAuthResourceRead,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -47,19 +56,22 @@ func certAuthBackendRoleResource() *schema.Resource {
 				Computed: true,
 			},
 			"ttl": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDuration,
 			},
 			"max_ttl": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDuration,
 			},
 			"period": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDuration,
 			},
 			"policies": {
 				Type: schema.TypeSet,
@@ -192,6 +204,16 @@ func certAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	path := d.Id()
 
+	backend, err := certAuthResourceBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for cert auth backend role: %s", path, err)
+	}
+
+	name, err := certAuthResourceNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for cert auth backend role: %s", path, err)
+	}
+
 	log.Printf("[DEBUG] Reading cert %q", path)
 	resp, err := client.Logical().Read(path)
 	if err != nil {
@@ -205,6 +227,8 @@ func certAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	d.Set("backend", backend)
+	d.Set("name", name)
 	d.Set("certificate", resp.Data["certificate"])
 	d.Set("display_name", resp.Data["display_name"])
 	d.Set("ttl", resp.Data["ttl"])
@@ -262,3 +286,25 @@ func certAuthResourceDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func certAuthResourceNameFromPath(path string) (string, error) {
+	if !certAuthResourceNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := certAuthResourceNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
+
+func certAuthResourceBackendFromPath(path string) (string, error) {
+	if !certAuthResourceBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := certAuthResourceBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -82,6 +82,11 @@ func TestCertAuthBackend_basic(t *testing.T) {
 				Config: testCertAuthBackendConfig_basic(backend, name, testCertificate, allowedNames),
 				Check:  testCertAuthBackendCheck_attrs(backend, name),
 			},
+			{
+				ResourceName:      "vault_cert_auth_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -37,7 +37,6 @@ func consulSecretBackendResource() *schema.Resource {
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "Human-friendly description of the mount for the backend.",
 			},
 			"default_lease_ttl_seconds": {
@@ -65,10 +64,32 @@ func consulSecretBackendResource() *schema.Resource {
 			},
 			"token": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Description: "Specifies the Consul ACL token to use. This must be a management type token.",
 				Sensitive:   true,
 			},
+			"bootstrap": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Have Vault bootstrap the Consul ACL system and use the resulting management token, instead of token. Requires Vault 1.10 or later.",
+			},
+			"ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CA certificate to use when verifying the Consul server certificate, in PEM format.",
+			},
+			"client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Client certificate to present to Consul, in PEM format.",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key of the client certificate, in PEM format.",
+				Sensitive:   true,
+			},
 		},
 	}
 }
@@ -77,11 +98,10 @@ func consulSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
 	path := d.Get("path").(string)
-	address := d.Get("address").(string)
-	scheme := d.Get("scheme").(string)
-	token := d.Get("token").(string)
 
-	configPath := consulSecretBackendConfigPath(path)
+	if d.Get("token").(string) == "" && !d.Get("bootstrap").(bool) {
+		return fmt.Errorf("token must be set unless bootstrap is enabled")
+	}
 
 	info := &api.MountInput{
 		Type:        "consul",
@@ -95,7 +115,7 @@ func consulSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	d.Partial(true)
 	log.Printf("[DEBUG] Mounting Consul backend at %q", path)
 
-	if err := client.Sys().Mount(path, info); err != nil {
+	if err := mountSecretBackend(client, path, info); err != nil {
 		return fmt.Errorf("Error mounting to %q: %s", path, err)
 	}
 
@@ -107,22 +127,12 @@ func consulSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetPartial("default_lease_ttl_seconds")
 	d.SetPartial("max_lease_ttl_seconds")
 
-	log.Printf("[DEBUG] Writing Consul configuration to %q", configPath)
-	data := map[string]interface{}{
-		"address": address,
-		"token":   token,
-		"scheme":  scheme,
+	if err := consulSecretBackendWriteConfig(client, path, d); err != nil {
+		return err
 	}
-	if _, err := client.Logical().Write(configPath, data); err != nil {
-		return fmt.Errorf("Error writing Consul configuration for %q: %s", path, err)
-	}
-	log.Printf("[DEBUG] Wrote Consul configuration to %q", configPath)
-	d.SetPartial("address")
-	d.SetPartial("token")
-	d.SetPartial("scheme")
 	d.Partial(false)
 
-	return nil
+	return consulSecretBackendRead(d, meta)
 }
 
 func consulSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
@@ -133,7 +143,7 @@ func consulSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Reading Consul backend mount %q from Vault", path)
 
-	mounts, err := client.Sys().ListMounts()
+	mounts, err := listMounts(client)
 	if err != nil {
 		return fmt.Errorf("Error reading mount %q: %s", path, err)
 	}
@@ -159,13 +169,13 @@ func consulSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
 
-	log.Printf("[DEBUG] secret: %#v", secret)
-
-	// token, sadly, we can't read out
+	// token and the TLS material, sadly, we can't read out
 	// the API doesn't support it
 	// So... if it drifts, it drift.
-	d.Set("address", secret.Data["address"].(string))
-	d.Set("scheme", secret.Data["scheme"].(string))
+	if secret != nil {
+		d.Set("address", secret.Data["address"])
+		d.Set("scheme", secret.Data["scheme"])
+	}
 
 	return nil
 }
@@ -174,38 +184,33 @@ func consulSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
 	path := d.Id()
-	configPath := consulSecretBackendConfigPath(path)
 
 	d.Partial(true)
 
-	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") {
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") || d.HasChange("description") {
 		config := api.MountConfigInput{
 			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
 			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
 		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			config.Description = &description
+		}
 
-		log.Printf("[DEBUG] Updating lease TTLs for %q", path)
-		if err := client.Sys().TuneMount(path, config); err != nil {
-			return fmt.Errorf("Error updating mount TTLs for %q: %s", path, err)
+		log.Printf("[DEBUG] Tuning mount %q", path)
+		if err := tuneMount(client, path, config); err != nil {
+			return fmt.Errorf("error tuning mount %q: %s", path, err)
 		}
 
 		d.SetPartial("default_lease_ttl_seconds")
 		d.SetPartial("max_lease_ttl_seconds")
+		d.SetPartial("description")
 	}
-	if d.HasChange("address") || d.HasChange("token") || d.HasChange("scheme") {
-		log.Printf("[DEBUG] Updating Consul configuration at %q", configPath)
-		data := map[string]interface{}{
-			"address": d.Get("address").(string),
-			"token":   d.Get("token").(string),
-			"scheme":  d.Get("scheme").(string),
-		}
-		if _, err := client.Logical().Write(configPath, data); err != nil {
-			return fmt.Errorf("Error configuring Consul configuration for %q: %s", path, err)
-		}
-		log.Printf("[DEBUG] Updated Consul configuration at %q", configPath)
-		d.SetPartial("address")
-		d.SetPartial("token")
-		d.SetPartial("scheme")
+	err := writeBackendConfigOnChange(d, consulSecretBackendConfigFields, func() error {
+		return consulSecretBackendWriteConfig(client, path, d)
+	})
+	if err != nil {
+		return err
 	}
 	d.Partial(false)
 	return consulSecretBackendRead(d, meta)
@@ -217,7 +222,7 @@ func consulSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
 	path := d.Id()
 
 	log.Printf("[DEBUG] Unmounting Consul backend %q", path)
-	err := client.Sys().Unmount(path)
+	err := unmountSecretBackend(client, path)
 	if err != nil {
 		return fmt.Errorf("Error unmounting Consul backend from %q: %s", path, err)
 	}
@@ -231,7 +236,7 @@ func consulSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool,
 	path := d.Id()
 
 	log.Printf("[DEBUG] Checking if Consul backend exists at %q", path)
-	mounts, err := client.Sys().ListMounts()
+	mounts, err := listMounts(client)
 	if err != nil {
 		return true, fmt.Errorf("Error retrieving list of mounts: %s", err)
 	}
@@ -240,6 +245,42 @@ func consulSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool,
 	return ok, nil
 }
 
+// consulSecretBackendConfigFields are the fields of the Consul configuration.
+var consulSecretBackendConfigFields = []string{
+	"address",
+	"scheme",
+	"token",
+	"ca_cert",
+	"client_cert",
+	"client_key",
+}
+
+func consulSecretBackendWriteConfig(client *api.Client, path string, d *schema.ResourceData) error {
+	configPath := consulSecretBackendConfigPath(path)
+
+	log.Printf("[DEBUG] Writing Consul configuration to %q", configPath)
+	data := map[string]interface{}{
+		"address": d.Get("address").(string),
+		"scheme":  d.Get("scheme").(string),
+	}
+	// without a token Vault bootstraps the Consul ACL system and keeps the
+	// management token it gets back.
+	for _, k := range []string{"token", "ca_cert", "client_cert", "client_key"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("Error writing Consul configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Consul configuration to %q", configPath)
+	for _, k := range consulSecretBackendConfigFields {
+		d.SetPartial(k)
+	}
+
+	return nil
+}
+
 func consulSecretBackendConfigPath(backend string) string {
 	return strings.Trim(backend, "/") + "/config/access"
 }
@@ -0,0 +1,249 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	consulSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
+	consulSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+)$")
+)
+
+func consulSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: consulSecretBackendRoleWrite,
+		Read:   consulSecretBackendRoleRead,
+		Update: consulSecretBackendRoleWrite,
+		Delete: consulSecretBackendRoleDelete,
+		Exists: consulSecretBackendRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Consul Secret Backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the role.",
+			},
+			"consul_policies": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Names of the Consul ACL policies to attach to generated tokens.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"consul_roles": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Names of the Consul ACL roles to attach to generated tokens. Requires Consul 1.5 or later.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"service_identities": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Consul service identities to attach to generated tokens, in the format \"<service>[:<datacenter1>,<datacenter2>]\". Requires Consul 1.5 or later.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"node_identities": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Consul node identities to attach to generated tokens, in the format \"<node>:<datacenter>\". Requires Consul 1.8 or later.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"consul_namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Consul namespace generated tokens belong to. Requires Consul Enterprise 1.7 or later.",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Consul admin partition generated tokens belong to. Requires Consul Enterprise 1.11 or later.",
+			},
+			"local": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Create tokens local to the datacenter instead of global ones.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Lease TTL in seconds of generated tokens.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum lease TTL in seconds of generated tokens.",
+			},
+		},
+	}
+}
+
+func consulSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := consulSecretBackendRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"consul_policies":    d.Get("consul_policies").([]interface{}),
+		"consul_roles":       d.Get("consul_roles").([]interface{}),
+		"service_identities": d.Get("service_identities").([]interface{}),
+		"node_identities":    d.Get("node_identities").([]interface{}),
+		"local":              d.Get("local").(bool),
+	}
+	for _, k := range []string{"consul_namespace", "partition"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	for _, k := range []string{"ttl", "max_ttl"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(int)
+		}
+	}
+	if len(data["consul_policies"].([]interface{})) == 0 && len(data["consul_roles"].([]interface{})) == 0 &&
+		len(data["service_identities"].([]interface{})) == 0 && len(data["node_identities"].([]interface{})) == 0 {
+		return fmt.Errorf("at least one of consul_policies, consul_roles, service_identities or node_identities must be set")
+	}
+
+	log.Printf("[DEBUG] Writing Consul secret backend role %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing Consul secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Consul secret backend role %q", path)
+
+	d.SetId(path)
+	return consulSecretBackendRoleRead(d, meta)
+}
+
+func consulSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := consulSecretBackendRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+	name, err := consulSecretBackendRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading Consul secret backend role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Consul secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Consul secret backend role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Consul secret backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range []string{"consul_policies", "consul_roles", "service_identities", "node_identities"} {
+		values := []string{}
+		if v, ok := resp.Data[k].([]interface{}); ok {
+			values = jsonStringArrayToStringArray(v)
+		}
+		if err := d.Set(k, values); err != nil {
+			return fmt.Errorf("error setting %s in state: %s", k, err)
+		}
+	}
+	d.Set("consul_namespace", resp.Data["consul_namespace"])
+	d.Set("partition", resp.Data["partition"])
+	d.Set("local", resp.Data["local"])
+	d.Set("ttl", resp.Data["ttl"])
+	d.Set("max_ttl", resp.Data["max_ttl"])
+
+	return nil
+}
+
+func consulSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting Consul secret backend role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting Consul secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted Consul secret backend role %q", path)
+	return nil
+}
+
+func consulSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if Consul secret backend role %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if Consul secret backend role %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if Consul secret backend role %q exists", path)
+	return resp != nil, nil
+}
+
+func consulSecretBackendRolePath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/roles/" + strings.Trim(name, "/")
+}
+
+func consulSecretBackendRoleNameFromPath(path string) (string, error) {
+	if !consulSecretBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := consulSecretBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
+
+func consulSecretBackendRoleBackendFromPath(path string) (string, error) {
+	if !consulSecretBackendRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := consulSecretBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,108 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccConsulSecretBackendRole_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-consul")
+	name := acctest.RandomWithPrefix("tf-test")
+	token := "026a0c16-87cd-4c2d-b3f3-fb539f592b7e"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckConsulSecretBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConsulSecretBackendRoleConfig_initial(backend, name, token),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "consul_policies.#", "1"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "consul_policies.0", "readonly"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "ttl", "3600"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "local", "false"),
+				),
+			},
+			{
+				Config: testAccConsulSecretBackendRoleConfig_updated(backend, name, token),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "consul_policies.#", "0"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "consul_roles.#", "1"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "consul_roles.0", "operators"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "service_identities.#", "1"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "service_identities.0", "web:dc1"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "node_identities.#", "1"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "node_identities.0", "server-1:dc1"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "local", "true"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "max_ttl", "7200"),
+				),
+			},
+			{
+				ResourceName:      "vault_consul_secret_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConsulSecretBackendRoleDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_consul_secret_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("Consul secret backend role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccConsulSecretBackendRoleConfig_initial(backend, name, token string) string {
+	return fmt.Sprintf(`
+resource "vault_consul_secret_backend" "test" {
+  path    = "%s"
+  address = "127.0.0.1:8500"
+  token   = "%s"
+}
+
+resource "vault_consul_secret_backend_role" "test" {
+  backend         = "${vault_consul_secret_backend.test.path}"
+  name            = "%s"
+  consul_policies = ["readonly"]
+  ttl             = 3600
+}`, backend, token, name)
+}
+
+func testAccConsulSecretBackendRoleConfig_updated(backend, name, token string) string {
+	return fmt.Sprintf(`
+resource "vault_consul_secret_backend" "test" {
+  path    = "%s"
+  address = "127.0.0.1:8500"
+  token   = "%s"
+}
+
+resource "vault_consul_secret_backend_role" "test" {
+  backend            = "${vault_consul_secret_backend.test.path}"
+  name               = "%s"
+  consul_roles       = ["operators"]
+  service_identities = ["web:dc1"]
+  node_identities    = ["server-1:dc1"]
+  local              = true
+  ttl                = 3600
+  max_ttl            = 7200
+}`, backend, token, name)
+}
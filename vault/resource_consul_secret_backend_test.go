@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"os"
 	"strings"
 	"testing"
 
@@ -47,6 +48,31 @@ func TestConsulSecretBackend(t *testing.T) {
 	})
 }
 
+func TestConsulSecretBackend_bootstrap(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-consul")
+	// Vault bootstraps the ACL system itself, so this needs a fresh Consul
+	// cluster with ACLs enabled but not bootstrapped yet.
+	address := os.Getenv("CONSUL_HTTP_ADDR")
+	if address == "" {
+		t.Skip("CONSUL_HTTP_ADDR not set")
+	}
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccConsulSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testConsulSecretBackend_bootstrapConfig(path, address),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_consul_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend.test", "address", address),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend.test", "bootstrap", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccConsulSecretBackendCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -92,3 +118,12 @@ resource "vault_consul_secret_backend" "test" {
   scheme = "https"
 }`, path, token)
 }
+
+func testConsulSecretBackend_bootstrapConfig(path, address string) string {
+	return fmt.Sprintf(`
+resource "vault_consul_secret_backend" "test" {
+  path = "%s"
+  address = "%s"
+  bootstrap = true
+}`, path, address)
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/vault/api"
@@ -28,7 +29,12 @@ func databaseSecretBackendConnectionResource() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: withRootRotationSchema(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -42,7 +48,7 @@ func databaseSecretBackendConnectionResource() *schema.Resource {
 				Default:     true,
 			},
 			"allowed_roles": {
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Optional:    true,
 				Description: "A list of roles that are allowed to use this connection.",
 				Elem: &schema.Schema{
@@ -225,7 +231,7 @@ func databaseSecretBackendConnectionResource() *schema.Resource {
 					return strings.Trim(v.(string), "/")
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -415,7 +421,10 @@ func setDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[s
 }
 
 func databaseSecretBackendConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := clientWithTimeout(meta.(*api.Client), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
 
 	backend := d.Get("backend").(string)
 	name := d.Get("name").(string)
@@ -433,7 +442,7 @@ func databaseSecretBackendConnectionCreate(d *schema.ResourceData, meta interfac
 
 	if v, ok := d.GetOkExists("allowed_roles"); ok {
 		var roles []string
-		for _, role := range v.([]interface{}) {
+		for _, role := range v.(*schema.Set).List() {
 			roles = append(roles, role.(string))
 		}
 		data["allowed_roles"] = strings.Join(roles, ",")
@@ -445,6 +454,10 @@ func databaseSecretBackendConnectionCreate(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	if err := setRootRotationData(meta.(*api.Client), d, data); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Writing connection config to %q", path)
 	_, err = client.Logical().Write(path, data)
 	if err != nil {
@@ -578,12 +591,16 @@ func databaseSecretBackendConnectionRead(d *schema.ResourceData, meta interface{
 	if v, ok := resp.Data["verify_connection"]; ok {
 		d.Set("verify_connection", v.(bool))
 	}
+	readRootRotationData(d, resp.Data)
 
 	return nil
 }
 
 func databaseSecretBackendConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := clientWithTimeout(meta.(*api.Client), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
 
 	backend := d.Get("backend").(string)
 	name := d.Get("name").(string)
@@ -601,12 +618,16 @@ func databaseSecretBackendConnectionUpdate(d *schema.ResourceData, meta interfac
 
 	if v, ok := d.GetOkExists("allowed_roles"); ok {
 		var roles []string
-		for _, role := range v.([]interface{}) {
+		for _, role := range v.(*schema.Set).List() {
 			roles = append(roles, role.(string))
 		}
 		data["allowed_roles"] = strings.Join(roles, ",")
 	}
 
+	if err := setRootRotationData(meta.(*api.Client), d, data); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Writing connection config to %q", path)
 	_, err = client.Logical().Write(path, data)
 
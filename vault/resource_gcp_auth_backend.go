@@ -20,6 +20,9 @@ func gcpAuthBackendResource() *schema.Resource {
 		Read:   gcpAuthBackendRead,
 		Delete: gcpAuthBackendDelete,
 		Exists: gcpAuthBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"credentials": {
@@ -109,7 +112,7 @@ func gcpAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 	desc := d.Get("description").(string)
 
 	log.Printf("[DEBUG] Enabling gcp auth backend %q", path)
-	err := client.Sys().EnableAuth(path, authType, desc)
+	err := enableAuthBackend(client, path, authType, desc)
 	if err != nil {
 		return fmt.Errorf("error enabling gcp auth backend %q: %s", path, err)
 	}
@@ -144,6 +147,16 @@ func gcpAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 
 func gcpAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
+
+	auths, err := listAuthMounts(client)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	if authMount := auths[strings.Trim(d.Id(), "/")+"/"]; authMount != nil {
+		d.Set("description", authMount.Description)
+	}
+	d.Set("path", d.Id())
+
 	path := gcpAuthBackendConfigPath(d.Id())
 
 	log.Printf("[DEBUG] Reading gcp auth backend config %q", path)
@@ -172,7 +185,7 @@ func gcpAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
 	path := d.Id()
 
 	log.Printf("[DEBUG] Deleting gcp auth backend %q", path)
-	err := client.Sys().DisableAuth(path)
+	err := disableAuthBackend(client, path)
 	if err != nil {
 		return fmt.Errorf("error deleting gcp auth backend %q: %q", path, err)
 	}
@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,6 +11,11 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+var (
+	gcpAuthBackendRoleBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/role/.+$")
+	gcpAuthBackendRoleNameFromPathRegex    = regexp.MustCompile("^auth/.+/role/(.+)$")
+)
+
 func gcpAuthBackendRoleResource() *schema.Resource {
 	return &schema.Resource{
 		SchemaVersion: 1,
@@ -18,6 +24,9 @@ func gcpAuthBackendRoleResource() *schema.Resource {
 		Update: gcpAuthResourceUpdate,
 		Read:   gcpAuthResourceRead,
 		Delete: gcpAuthResourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role": {
@@ -36,19 +45,22 @@ func gcpAuthBackendRoleResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"ttl": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDuration,
 			},
 			"max_ttl": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDuration,
 			},
 			"period": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDuration,
 			},
 			"policies": {
 				Type: schema.TypeSet,
@@ -237,6 +249,16 @@ func gcpAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	path := d.Id()
 
+	backend, err := gcpAuthBackendRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for GCP auth backend role: %s", path, err)
+	}
+
+	role, err := gcpAuthBackendRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for GCP auth backend role: %s", path, err)
+	}
+
 	log.Printf("[DEBUG] Reading GCP role %q", path)
 	resp, err := client.Logical().Read(path)
 	if err != nil {
@@ -250,6 +272,8 @@ func gcpAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	d.Set("backend", backend)
+	d.Set("role", role)
 	d.Set("ttl", resp.Data["ttl"])
 	d.Set("max_ttl", resp.Data["max_ttl"])
 	d.Set("type", resp.Data["role_type"])
@@ -304,3 +328,25 @@ func gcpAuthResourceDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func gcpAuthBackendRoleNameFromPath(path string) (string, error) {
+	if !gcpAuthBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := gcpAuthBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
+
+func gcpAuthBackendRoleBackendFromPath(path string) (string, error) {
+	if !gcpAuthBackendRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := gcpAuthBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -28,6 +28,11 @@ func TestGCPAuthBackendRole_basic(t *testing.T) {
 				Config: testGCPAuthBackendRoleConfig_basic(backend, name, serviceAccount, projectId),
 				Check:  testGCPAuthBackendRoleCheck_attrs(backend, name),
 			},
+			{
+				ResourceName:      "vault_gcp_auth_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -46,6 +51,11 @@ func TestGCPAuthBackendRole_gce(t *testing.T) {
 				Config: testGCPAuthBackendRoleConfig_gce(backend, name, projectId),
 				Check:  testGCPAuthBackendRoleCheck_attrs(backend, name),
 			},
+			{
+				ResourceName:      "vault_gcp_auth_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
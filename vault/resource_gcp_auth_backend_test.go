@@ -34,6 +34,12 @@ func TestGCPAuthBackend_basic(t *testing.T) {
 				Config: testGCPAuthBackendConfig_basic(gcpJSONCredentials),
 				Check:  testGCPAuthBackendCheck_attrs(),
 			},
+			{
+				ResourceName:            "vault_gcp_auth_backend.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials"},
+			},
 		},
 	})
 }
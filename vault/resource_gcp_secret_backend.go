@@ -20,20 +20,14 @@ func gcpSecretBackendResource() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: withRootRotationSchema(map[string]*schema.Schema{
 			"path": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Default:     "gcp",
-				Description: "Path to mount the backend at.",
-				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
-					value := v.(string)
-					if strings.HasSuffix(value, "/") {
-						errs = append(errs, fmt.Errorf("path cannot end in '/'"))
-					}
-					return
-				},
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "gcp",
+				Description:  "Path to mount the backend at.",
+				ValidateFunc: validateMountPath,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return old+"/" == new || new+"/" == old
 				},
@@ -47,13 +41,36 @@ func gcpSecretBackendResource() *schema.Resource {
 				// string. This makes terraform not want to change when an extra
 				// space is included in the JSON string. It is also necesarry
 				// when disable_read is false for comparing values.
-				StateFunc:    NormalizeDataJSON,
-				ValidateFunc: ValidateDataJSON,
+				StateFunc:     NormalizeDataJSON,
+				ValidateFunc:  ValidateDataJSON,
+				ConflictsWith: []string{"identity_token_audience"},
+			},
+			"identity_token_audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The audience claim value of plugin identity tokens, required for workload identity federation. Requires Vault Enterprise 1.17 or later.",
+			},
+			"identity_token_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL of generated identity tokens in seconds. Requires Vault Enterprise 1.17 or later.",
+			},
+			"service_account_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Service account to impersonate with workload identity federation. Requires Vault Enterprise 1.17 or later.",
+			},
+			"rotate_root": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"identity_token_audience"},
+				Description:   "Rotate the service account key of the credentials after they are written, so that only Vault knows the new key.",
 			},
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "Human-friendly description of the mount for the backend.",
 			},
 			"default_lease_ttl_seconds": {
@@ -68,7 +85,7 @@ func gcpSecretBackendResource() *schema.Resource {
 				Default:     "86400",
 				Description: "Maximum possible lease duration for secrets in seconds",
 			},
-		},
+		}),
 	}
 }
 
@@ -79,13 +96,10 @@ func gcpSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	description := d.Get("description").(string)
 	defaultTTL := d.Get("default_lease_ttl_seconds").(int)
 	maxTTL := d.Get("max_lease_ttl_seconds").(int)
-	credentials := d.Get("credentials").(string)
-
-	configPath := gcpSecretBackendConfigPath(path)
 
 	d.Partial(true)
 	log.Printf("[DEBUG] Mounting GCP backend at %q", path)
-	err := client.Sys().Mount(path, &api.MountInput{
+	err := mountSecretBackend(client, path, &api.MountInput{
 		Type:        "gcp",
 		Description: description,
 		Config: api.MountConfigInput{
@@ -104,18 +118,9 @@ func gcpSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetPartial("default_lease_ttl_seconds")
 	d.SetPartial("max_lease_ttl_seconds")
 
-	log.Printf("[DEBUG] Writing GCP configuration to %q", configPath)
-	if credentials != "" {
-		data := map[string]interface{}{
-			"credentials": credentials,
-		}
-		if _, err := client.Logical().Write(configPath, data); err != nil {
-			return fmt.Errorf("error writing GCP configuration for %q: %s", path, err)
-		}
-	} else {
-		log.Printf("[DEBUG] No credentials configured")
+	if err := gcpSecretBackendWriteConfig(client, path, d); err != nil {
+		return err
 	}
-	log.Printf("[DEBUG] Wrote GCP configuration to %q", configPath)
 	d.Partial(false)
 
 	return gcpSecretBackendRead(d, meta)
@@ -127,7 +132,7 @@ func gcpSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 	path := d.Id()
 
 	log.Printf("[DEBUG] Reading GCP backend mount %q from Vault", path)
-	mounts, err := client.Sys().ListMounts()
+	mounts, err := listMounts(client)
 	if err != nil {
 		return fmt.Errorf("error reading mount %q: %s", path, err)
 	}
@@ -147,6 +152,24 @@ func gcpSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
 	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
 
+	configPath := gcpSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading GCP configuration from %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading GCP configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read GCP configuration from %q", configPath)
+	// the credentials are never returned, older versions of Vault don't
+	// return anything at all.
+	if resp != nil {
+		for _, k := range []string{"identity_token_audience", "identity_token_ttl", "service_account_email"} {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+		readRootRotationData(d, resp.Data)
+	}
+
 	return nil
 }
 
@@ -155,19 +178,30 @@ func gcpSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	path := d.Id()
 	d.Partial(true)
-	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") {
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") || d.HasChange("description") {
 		config := api.MountConfigInput{
 			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
 			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
 		}
-		log.Printf("[DEBUG] Updating lease TTLs for %q", path)
-		err := client.Sys().TuneMount(path, config)
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			config.Description = &description
+		}
+		log.Printf("[DEBUG] Tuning mount %q", path)
+		err := tuneMount(client, path, config)
 		if err != nil {
-			return fmt.Errorf("error updating mount TTLs for %q: %s", path, err)
+			return fmt.Errorf("error tuning mount %q: %s", path, err)
 		}
-		log.Printf("[DEBUG] Updated lease TTLs for %q", path)
+		log.Printf("[DEBUG] Tuned mount %q", path)
 		d.SetPartial("default_lease_ttl_seconds")
 		d.SetPartial("max_lease_ttl_seconds")
+		d.SetPartial("description")
+	}
+	err := writeBackendConfigOnChange(d, gcpSecretBackendConfigFields, func() error {
+		return gcpSecretBackendWriteConfig(client, path, d)
+	})
+	if err != nil {
+		return err
 	}
 
 	d.Partial(false)
@@ -180,7 +214,7 @@ func gcpSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
 	path := d.Id()
 
 	log.Printf("[DEBUG] Unmounting GCP backend %q", path)
-	err := client.Sys().Unmount(path)
+	err := unmountSecretBackend(client, path)
 	if err != nil {
 		return fmt.Errorf("error unmounting GCP backend from %q: %s", path, err)
 	}
@@ -192,7 +226,7 @@ func gcpSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, err
 	client := meta.(*api.Client)
 	path := d.Id()
 	log.Printf("[DEBUG] Checking if GCP backend exists at %q", path)
-	mounts, err := client.Sys().ListMounts()
+	mounts, err := listMounts(client)
 	if err != nil {
 		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
 	}
@@ -201,6 +235,56 @@ func gcpSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, err
 	return ok, nil
 }
 
+// gcpSecretBackendConfigFields are the fields of the GCP configuration.
+var gcpSecretBackendConfigFields = append([]string{
+	"credentials",
+	"identity_token_audience",
+	"identity_token_ttl",
+	"service_account_email",
+	"rotate_root",
+}, rootRotationFields...)
+
+func gcpSecretBackendWriteConfig(client *api.Client, path string, d *schema.ResourceData) error {
+	configPath := gcpSecretBackendConfigPath(path)
+
+	data := map[string]interface{}{}
+	for _, k := range []string{"credentials", "identity_token_audience", "service_account_email"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("identity_token_ttl"); ok {
+		data["identity_token_ttl"] = v.(int)
+	}
+	if err := setRootRotationData(client, d, data); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Writing GCP configuration to %q", configPath)
+	if len(data) > 0 {
+		if _, err := client.Logical().Write(configPath, data); err != nil {
+			return fmt.Errorf("error writing GCP configuration for %q: %s", path, err)
+		}
+	} else {
+		log.Printf("[DEBUG] No credentials configured")
+	}
+	log.Printf("[DEBUG] Wrote GCP configuration to %q", configPath)
+	for _, k := range gcpSecretBackendConfigFields {
+		d.SetPartial(k)
+	}
+
+	if d.Get("rotate_root").(bool) && (d.HasChange("credentials") || d.HasChange("rotate_root")) {
+		rotatePath := strings.Trim(path, "/") + "/config/rotate-root"
+		log.Printf("[DEBUG] Rotating root credentials at %q", rotatePath)
+		if _, err := client.Logical().Write(rotatePath, nil); err != nil {
+			return fmt.Errorf("error rotating root credentials for %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Rotated root credentials at %q", rotatePath)
+	}
+
+	return nil
+}
+
 func gcpSecretBackendConfigPath(backend string) string {
 	return strings.Trim(backend, "/") + "/config"
 }
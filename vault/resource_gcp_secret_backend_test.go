@@ -40,6 +40,32 @@ func TestGCPSecretBackend(t *testing.T) {
 	})
 }
 
+func TestGCPSecretBackend_identityToken(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-gcp")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t); testAccEnterprisePreCheck(t) },
+		CheckDestroy: testAccGCPSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPSecretBackend_identityTokenConfig(path, 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcp_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_gcp_secret_backend.test", "identity_token_audience", "test-audience"),
+					resource.TestCheckResourceAttr("vault_gcp_secret_backend.test", "identity_token_ttl", "600"),
+					resource.TestCheckResourceAttr("vault_gcp_secret_backend.test", "service_account_email", "test@test.iam.gserviceaccount.com"),
+				),
+			},
+			{
+				Config: testGCPSecretBackend_identityTokenConfig(path, 1200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcp_secret_backend.test", "identity_token_ttl", "1200"),
+				),
+			},
+		},
+	})
+}
+
 func testAccGCPSecretBackendCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -92,3 +118,13 @@ EOF
   max_lease_ttl_seconds = 43200
 }`, path)
 }
+
+func testGCPSecretBackend_identityTokenConfig(path string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  identity_token_audience = "test-audience"
+  identity_token_ttl = %d
+  service_account_email = "test@test.iam.gserviceaccount.com"
+}`, path, ttl)
+}
@@ -0,0 +1,190 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	gcpSecretImpersonatedAccountBackendFromPathRegex = regexp.MustCompile("^(.+)/impersonated-account/.+$")
+	gcpSecretImpersonatedAccountNameFromPathRegex    = regexp.MustCompile("^.+/impersonated-account/(.+)$")
+)
+
+func gcpSecretImpersonatedAccountResource() *schema.Resource {
+	return &schema.Resource{
+		Create: gcpSecretImpersonatedAccountWrite,
+		Read:   gcpSecretImpersonatedAccountRead,
+		Update: gcpSecretImpersonatedAccountWrite,
+		Delete: gcpSecretImpersonatedAccountDelete,
+		Exists: gcpSecretImpersonatedAccountExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where the GCP secrets engine is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the impersonated account.",
+			},
+			"service_account_email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Email of the existing service account to impersonate.",
+			},
+			"token_scopes": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "List of OAuth scopes to assign to access tokens generated for the impersonated account.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Lifetime in seconds of the access tokens generated for the impersonated account.",
+			},
+			"service_account_project": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Project the service account belongs to.",
+			},
+		},
+	}
+}
+
+func gcpSecretImpersonatedAccountWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := gcpSecretImpersonatedAccountPath(backend, name)
+
+	data := map[string]interface{}{
+		"service_account_email": d.Get("service_account_email").(string),
+		"token_scopes":          d.Get("token_scopes").(*schema.Set).List(),
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Writing GCP secret impersonated account %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing GCP secret impersonated account %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote GCP secret impersonated account %q", path)
+
+	d.SetId(path)
+	return gcpSecretImpersonatedAccountRead(d, meta)
+}
+
+func gcpSecretImpersonatedAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := gcpSecretImpersonatedAccountBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid impersonated account ID %q: %s", path, err)
+	}
+	name, err := gcpSecretImpersonatedAccountNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid impersonated account ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading GCP secret impersonated account %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading GCP secret impersonated account %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read GCP secret impersonated account %q", path)
+	if resp == nil {
+		log.Printf("[WARN] GCP secret impersonated account %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	d.Set("service_account_email", resp.Data["service_account_email"])
+	d.Set("service_account_project", resp.Data["service_account_project"])
+	d.Set("ttl", resp.Data["ttl"])
+	if err := d.Set("token_scopes", resp.Data["token_scopes"]); err != nil {
+		return fmt.Errorf("error setting token_scopes in state: %s", err)
+	}
+
+	return nil
+}
+
+func gcpSecretImpersonatedAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting GCP secret impersonated account %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting GCP secret impersonated account %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted GCP secret impersonated account %q", path)
+	return nil
+}
+
+func gcpSecretImpersonatedAccountExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if GCP secret impersonated account %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if GCP secret impersonated account %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if GCP secret impersonated account %q exists", path)
+	return resp != nil, nil
+}
+
+func gcpSecretImpersonatedAccountPath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/impersonated-account/" + strings.Trim(name, "/")
+}
+
+func gcpSecretImpersonatedAccountNameFromPath(path string) (string, error) {
+	if !gcpSecretImpersonatedAccountNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no impersonated account found")
+	}
+	res := gcpSecretImpersonatedAccountNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for impersonated account", len(res))
+	}
+	return res[1], nil
+}
+
+func gcpSecretImpersonatedAccountBackendFromPath(path string) (string, error) {
+	if !gcpSecretImpersonatedAccountBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := gcpSecretImpersonatedAccountBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
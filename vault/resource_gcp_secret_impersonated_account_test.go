@@ -0,0 +1,88 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccGCPSecretImpersonatedAccount_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	name := acctest.RandomWithPrefix("tf-test")
+	credentials, project := getTestGCPCreds(t)
+	// the service account must already exist, Vault only manages its tokens.
+	email := os.Getenv("GOOGLE_SERVICE_ACCOUNT_EMAIL")
+	if email == "" {
+		t.Skip("GOOGLE_SERVICE_ACCOUNT_EMAIL not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckGCPSecretImpersonatedAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGCPSecretImpersonatedAccountConfig_basic(backend, name, credentials, email, 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcp_secret_impersonated_account.test", "name", name),
+					resource.TestCheckResourceAttr("vault_gcp_secret_impersonated_account.test", "service_account_email", email),
+					resource.TestCheckResourceAttr("vault_gcp_secret_impersonated_account.test", "service_account_project", project),
+					resource.TestCheckResourceAttr("vault_gcp_secret_impersonated_account.test", "token_scopes.#", "1"),
+					resource.TestCheckResourceAttr("vault_gcp_secret_impersonated_account.test", "ttl", "600"),
+				),
+			},
+			{
+				Config: testAccGCPSecretImpersonatedAccountConfig_basic(backend, name, credentials, email, 1200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcp_secret_impersonated_account.test", "ttl", "1200"),
+				),
+			},
+			{
+				ResourceName:      "vault_gcp_secret_impersonated_account.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGCPSecretImpersonatedAccountDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_gcp_secret_impersonated_account" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("GCP secret impersonated account %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccGCPSecretImpersonatedAccountConfig_basic(backend, name, credentials, email string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<EOF
+%s
+EOF
+}
+
+resource "vault_gcp_secret_impersonated_account" "test" {
+  backend               = "${vault_gcp_secret_backend.test.path}"
+  name                  = "%s"
+  service_account_email = "%s"
+  token_scopes          = ["https://www.googleapis.com/auth/cloud-platform"]
+  ttl                   = %d
+}`, backend, credentials, name, email, ttl)
+}
@@ -0,0 +1,269 @@
+package vault
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"encoding/json"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	gcpSecretRolesetBackendFromPathRegex = regexp.MustCompile("^(.+)/roleset/.+$")
+	gcpSecretRolesetNameFromPathRegex    = regexp.MustCompile("^.+/roleset/(.+)$")
+)
+
+func gcpSecretRolesetResource() *schema.Resource {
+	return &schema.Resource{
+		Create: gcpSecretRolesetWrite,
+		Read:   gcpSecretRolesetRead,
+		Update: gcpSecretRolesetWrite,
+		Delete: gcpSecretRolesetDelete,
+		Exists: gcpSecretRolesetExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where the GCP secrets engine is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the roleset.",
+			},
+			"project": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the GCP project that this roleset's service account will belong to.",
+			},
+			"secret_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "access_token",
+				Description:  "Type of secret generated for this roleset. Must be either \"access_token\" or \"service_account_key\".",
+				ValidateFunc: validation.StringInSlice([]string{"access_token", "service_account_key"}, false),
+			},
+			"token_scopes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of OAuth scopes to assign to access tokens generated under this roleset. Required if secret_type is \"access_token\".",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"binding": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Bindings of IAM roles on GCP resources to the roleset's service account.",
+				Elem:        gcpSecretBindingResource(),
+			},
+			"service_account_email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Email of the service account created by Vault for this roleset. Vault replaces the service account whenever the bindings or token scopes change.",
+			},
+		},
+	}
+}
+
+func gcpSecretRolesetWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := gcpSecretRolesetPath(backend, name)
+
+	if d.Get("secret_type").(string) == "access_token" && d.Get("token_scopes").(*schema.Set).Len() == 0 {
+		return fmt.Errorf("token_scopes must be set when secret_type is \"access_token\"")
+	}
+
+	data := map[string]interface{}{
+		"project":      d.Get("project").(string),
+		"secret_type":  d.Get("secret_type").(string),
+		"token_scopes": d.Get("token_scopes").(*schema.Set).List(),
+		"bindings":     gcpSecretRenderBindings(d.Get("binding").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Writing GCP secret roleset %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing GCP secret roleset %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote GCP secret roleset %q", path)
+
+	d.SetId(path)
+	return gcpSecretRolesetRead(d, meta)
+}
+
+func gcpSecretRolesetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := gcpSecretRolesetBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid roleset ID %q: %s", path, err)
+	}
+	name, err := gcpSecretRolesetNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid roleset ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading GCP secret roleset %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading GCP secret roleset %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read GCP secret roleset %q", path)
+	if resp == nil {
+		log.Printf("[WARN] GCP secret roleset %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	d.Set("service_account_email", resp.Data["service_account_email"])
+	d.Set("secret_type", resp.Data["secret_type"])
+	if v, ok := resp.Data["service_account_project"]; ok {
+		d.Set("project", v)
+	}
+	if err := d.Set("token_scopes", resp.Data["token_scopes"]); err != nil {
+		return fmt.Errorf("error setting token_scopes in state: %s", err)
+	}
+	bindings, err := gcpSecretFlattenBindings(resp.Data["bindings"])
+	if err != nil {
+		return fmt.Errorf("error reading bindings for GCP secret roleset %q: %s", path, err)
+	}
+	if err := d.Set("binding", bindings); err != nil {
+		return fmt.Errorf("error setting binding in state: %s", err)
+	}
+
+	return nil
+}
+
+func gcpSecretRolesetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting GCP secret roleset %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting GCP secret roleset %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted GCP secret roleset %q", path)
+	return nil
+}
+
+func gcpSecretRolesetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if GCP secret roleset %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if GCP secret roleset %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if GCP secret roleset %q exists", path)
+	return resp != nil, nil
+}
+
+func gcpSecretBindingResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"resource": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Resource name of the GCP resource to bind the roles on, e.g. \"//cloudresourcemanager.googleapis.com/projects/my-project\".",
+			},
+			"roles": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "IAM roles to grant on the resource.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// gcpSecretRenderBindings renders binding blocks in the HCL format the GCP
+// secrets engine expects for its bindings parameter.
+func gcpSecretRenderBindings(bindings *schema.Set) string {
+	var buf bytes.Buffer
+	for _, v := range bindings.List() {
+		binding := v.(map[string]interface{})
+		roles, _ := json.Marshal(binding["roles"].(*schema.Set).List())
+		fmt.Fprintf(&buf, "resource %q {\n  roles = %s\n}\n", binding["resource"].(string), roles)
+	}
+	return buf.String()
+}
+
+// gcpSecretFlattenBindings converts the bindings returned by the GCP
+// secrets engine, a map of resource name to roles, into binding blocks.
+func gcpSecretFlattenBindings(v interface{}) ([]map[string]interface{}, error) {
+	bindings := []map[string]interface{}{}
+	if v == nil {
+		return bindings, nil
+	}
+	raw, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected bindings type %T", v)
+	}
+	for resource, roles := range raw {
+		rawRoles, ok := roles.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected roles type %T for resource %q", roles, resource)
+		}
+		bindings = append(bindings, map[string]interface{}{
+			"resource": resource,
+			"roles":    schema.NewSet(schema.HashString, rawRoles),
+		})
+	}
+	return bindings, nil
+}
+
+func gcpSecretRolesetPath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/roleset/" + strings.Trim(name, "/")
+}
+
+func gcpSecretRolesetNameFromPath(path string) (string, error) {
+	if !gcpSecretRolesetNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no roleset found")
+	}
+	res := gcpSecretRolesetNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for roleset", len(res))
+	}
+	return res[1], nil
+}
+
+func gcpSecretRolesetBackendFromPath(path string) (string, error) {
+	if !gcpSecretRolesetBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := gcpSecretRolesetBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
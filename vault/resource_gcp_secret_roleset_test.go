@@ -0,0 +1,89 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccGCPSecretRoleset_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	name := acctest.RandomWithPrefix("tf-test")
+	credentials, project := getTestGCPCreds(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckGCPSecretRolesetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGCPSecretRolesetConfig_basic(backend, name, credentials, project, "roles/viewer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcp_secret_roleset.test", "name", name),
+					resource.TestCheckResourceAttr("vault_gcp_secret_roleset.test", "project", project),
+					resource.TestCheckResourceAttr("vault_gcp_secret_roleset.test", "secret_type", "access_token"),
+					resource.TestCheckResourceAttr("vault_gcp_secret_roleset.test", "token_scopes.#", "1"),
+					resource.TestCheckResourceAttr("vault_gcp_secret_roleset.test", "binding.#", "1"),
+					resource.TestCheckResourceAttrSet("vault_gcp_secret_roleset.test", "service_account_email"),
+				),
+			},
+			{
+				Config: testAccGCPSecretRolesetConfig_basic(backend, name, credentials, project, "roles/browser"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcp_secret_roleset.test", "binding.#", "1"),
+					resource.TestCheckResourceAttrSet("vault_gcp_secret_roleset.test", "service_account_email"),
+				),
+			},
+			{
+				ResourceName:      "vault_gcp_secret_roleset.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGCPSecretRolesetDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_gcp_secret_roleset" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("GCP secret roleset %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccGCPSecretRolesetConfig_basic(backend, name, credentials, project, role string) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<EOF
+%s
+EOF
+}
+
+resource "vault_gcp_secret_roleset" "test" {
+  backend      = "${vault_gcp_secret_backend.test.path}"
+  name         = "%s"
+  project      = "%s"
+  secret_type  = "access_token"
+  token_scopes = ["https://www.googleapis.com/auth/cloud-platform"]
+
+  binding {
+    resource = "//cloudresourcemanager.googleapis.com/projects/%s"
+    roles    = ["%s"]
+  }
+}`, backend, credentials, name, project, project, role)
+}
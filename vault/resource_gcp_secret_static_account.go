@@ -0,0 +1,211 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	gcpSecretStaticAccountBackendFromPathRegex = regexp.MustCompile("^(.+)/static-account/.+$")
+	gcpSecretStaticAccountNameFromPathRegex    = regexp.MustCompile("^.+/static-account/(.+)$")
+)
+
+func gcpSecretStaticAccountResource() *schema.Resource {
+	return &schema.Resource{
+		Create: gcpSecretStaticAccountWrite,
+		Read:   gcpSecretStaticAccountRead,
+		Update: gcpSecretStaticAccountWrite,
+		Delete: gcpSecretStaticAccountDelete,
+		Exists: gcpSecretStaticAccountExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where the GCP secrets engine is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the static account.",
+			},
+			"service_account_email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Email of the existing service account to manage.",
+			},
+			"secret_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "access_token",
+				Description:  "Type of secret generated for this static account. Must be either \"access_token\" or \"service_account_key\".",
+				ValidateFunc: validation.StringInSlice([]string{"access_token", "service_account_key"}, false),
+			},
+			"token_scopes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of OAuth scopes to assign to access tokens generated under this static account. Required if secret_type is \"access_token\".",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"binding": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Bindings of IAM roles on GCP resources to the service account. Vault leaves the service account's bindings alone when none are given.",
+				Elem:        gcpSecretBindingResource(),
+			},
+			"service_account_project": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Project the service account belongs to.",
+			},
+		},
+	}
+}
+
+func gcpSecretStaticAccountWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := gcpSecretStaticAccountPath(backend, name)
+
+	if d.Get("secret_type").(string) == "access_token" && d.Get("token_scopes").(*schema.Set).Len() == 0 {
+		return fmt.Errorf("token_scopes must be set when secret_type is \"access_token\"")
+	}
+
+	data := map[string]interface{}{
+		"service_account_email": d.Get("service_account_email").(string),
+		"secret_type":           d.Get("secret_type").(string),
+		"token_scopes":          d.Get("token_scopes").(*schema.Set).List(),
+	}
+	if v, ok := d.GetOk("binding"); ok {
+		data["bindings"] = gcpSecretRenderBindings(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Writing GCP secret static account %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing GCP secret static account %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote GCP secret static account %q", path)
+
+	d.SetId(path)
+	return gcpSecretStaticAccountRead(d, meta)
+}
+
+func gcpSecretStaticAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := gcpSecretStaticAccountBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid static account ID %q: %s", path, err)
+	}
+	name, err := gcpSecretStaticAccountNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid static account ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading GCP secret static account %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading GCP secret static account %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read GCP secret static account %q", path)
+	if resp == nil {
+		log.Printf("[WARN] GCP secret static account %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	d.Set("service_account_email", resp.Data["service_account_email"])
+	d.Set("service_account_project", resp.Data["service_account_project"])
+	d.Set("secret_type", resp.Data["secret_type"])
+	if err := d.Set("token_scopes", resp.Data["token_scopes"]); err != nil {
+		return fmt.Errorf("error setting token_scopes in state: %s", err)
+	}
+	bindings, err := gcpSecretFlattenBindings(resp.Data["bindings"])
+	if err != nil {
+		return fmt.Errorf("error reading bindings for GCP secret static account %q: %s", path, err)
+	}
+	if err := d.Set("binding", bindings); err != nil {
+		return fmt.Errorf("error setting binding in state: %s", err)
+	}
+
+	return nil
+}
+
+func gcpSecretStaticAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting GCP secret static account %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting GCP secret static account %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted GCP secret static account %q", path)
+	return nil
+}
+
+func gcpSecretStaticAccountExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if GCP secret static account %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if GCP secret static account %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if GCP secret static account %q exists", path)
+	return resp != nil, nil
+}
+
+func gcpSecretStaticAccountPath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/static-account/" + strings.Trim(name, "/")
+}
+
+func gcpSecretStaticAccountNameFromPath(path string) (string, error) {
+	if !gcpSecretStaticAccountNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no static account found")
+	}
+	res := gcpSecretStaticAccountNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for static account", len(res))
+	}
+	return res[1], nil
+}
+
+func gcpSecretStaticAccountBackendFromPath(path string) (string, error) {
+	if !gcpSecretStaticAccountBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := gcpSecretStaticAccountBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
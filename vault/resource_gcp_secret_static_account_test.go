@@ -0,0 +1,94 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccGCPSecretStaticAccount_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	name := acctest.RandomWithPrefix("tf-test")
+	credentials, project := getTestGCPCreds(t)
+	// the service account must already exist, Vault only manages its keys
+	// and bindings.
+	email := os.Getenv("GOOGLE_SERVICE_ACCOUNT_EMAIL")
+	if email == "" {
+		t.Skip("GOOGLE_SERVICE_ACCOUNT_EMAIL not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckGCPSecretStaticAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGCPSecretStaticAccountConfig_basic(backend, name, credentials, email, project, "roles/viewer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "name", name),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "service_account_email", email),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "service_account_project", project),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "secret_type", "access_token"),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "binding.#", "1"),
+				),
+			},
+			{
+				Config: testAccGCPSecretStaticAccountConfig_basic(backend, name, credentials, email, project, "roles/browser"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "binding.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "vault_gcp_secret_static_account.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGCPSecretStaticAccountDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_gcp_secret_static_account" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("GCP secret static account %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccGCPSecretStaticAccountConfig_basic(backend, name, credentials, email, project, role string) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<EOF
+%s
+EOF
+}
+
+resource "vault_gcp_secret_static_account" "test" {
+  backend               = "${vault_gcp_secret_backend.test.path}"
+  name                  = "%s"
+  service_account_email = "%s"
+  secret_type           = "access_token"
+  token_scopes          = ["https://www.googleapis.com/auth/cloud-platform"]
+
+  binding {
+    resource = "//cloudresourcemanager.googleapis.com/projects/%s"
+    roles    = ["%s"]
+  }
+}`, backend, credentials, name, email, project, role)
+}
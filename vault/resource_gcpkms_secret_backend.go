@@ -0,0 +1,250 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// gcpkmsSecretBackendConfigFields are the fields of the GCP KMS configuration.
+var gcpkmsSecretBackendConfigFields = []string{
+	"credentials",
+	"scopes",
+}
+
+func gcpkmsSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: gcpkmsSecretBackendCreate,
+		Read:   gcpkmsSecretBackendRead,
+		Update: gcpkmsSecretBackendUpdate,
+		Delete: gcpkmsSecretBackendDelete,
+		Exists: gcpkmsSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "gcpkms",
+				Description:  "Path to mount the backend at.",
+				ValidateFunc: validateMountPath,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lease duration for secrets in seconds",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum possible lease duration for secrets in seconds",
+			},
+			"credentials": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "JSON encoded credentials of the service account Vault uses to manage keys, defaults to the application default credentials.",
+				Sensitive:   true,
+			},
+			"scopes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "OAuth scopes to request when authenticating to Google Cloud.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func gcpkmsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	description := d.Get("description").(string)
+	defaultTTL := d.Get("default_lease_ttl_seconds").(int)
+	maxTTL := d.Get("max_lease_ttl_seconds").(int)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting GCP KMS backend at %q", path)
+	err := mountSecretBackend(client, path, &api.MountInput{
+		Type:        "gcpkms",
+		Description: description,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", defaultTTL),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", maxTTL),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted GCP KMS backend at %q", path)
+	d.SetId(path)
+
+	d.SetPartial("path")
+	d.SetPartial("description")
+	d.SetPartial("default_lease_ttl_seconds")
+	d.SetPartial("max_lease_ttl_seconds")
+
+	if err := gcpkmsSecretBackendWriteConfig(client, path, d); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return gcpkmsSecretBackendRead(d, meta)
+}
+
+func gcpkmsSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading GCP KMS backend mount %q from Vault", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read GCP KMS backend mount %q from Vault", path)
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
+
+	configPath := gcpkmsSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading GCP KMS configuration from %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading GCP KMS configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read GCP KMS configuration from %q", configPath)
+	if resp != nil {
+		// the credentials are never returned.
+		for _, k := range []string{
+			"scopes",
+		} {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func gcpkmsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	d.Partial(true)
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") || d.HasChange("description") {
+		config := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			config.Description = &description
+		}
+		log.Printf("[DEBUG] Tuning mount %q", path)
+		err := tuneMount(client, path, config)
+		if err != nil {
+			return fmt.Errorf("error tuning mount %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Tuned mount %q", path)
+		d.SetPartial("default_lease_ttl_seconds")
+		d.SetPartial("max_lease_ttl_seconds")
+		d.SetPartial("description")
+	}
+	err := writeBackendConfigOnChange(d, gcpkmsSecretBackendConfigFields, func() error {
+		return gcpkmsSecretBackendWriteConfig(client, path, d)
+	})
+	if err != nil {
+		return err
+	}
+	d.Partial(false)
+	return gcpkmsSecretBackendRead(d, meta)
+}
+
+func gcpkmsSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting GCP KMS backend %q", path)
+	err := unmountSecretBackend(client, path)
+	if err != nil {
+		return fmt.Errorf("error unmounting GCP KMS backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted GCP KMS backend %q", path)
+	return nil
+}
+
+func gcpkmsSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if GCP KMS backend exists at %q", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if GCP KMS backend exists at %q", path)
+	_, ok := mounts[strings.Trim(path, "/")+"/"]
+	return ok, nil
+}
+
+func gcpkmsSecretBackendWriteConfig(client *api.Client, path string, d *schema.ResourceData) error {
+	configPath := gcpkmsSecretBackendConfigPath(path)
+
+	log.Printf("[DEBUG] Writing GCP KMS configuration to %q", configPath)
+	data := map[string]interface{}{}
+	for _, k := range []string{
+		"credentials",
+	} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("scopes"); ok {
+		data["scopes"] = v.([]interface{})
+	}
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing GCP KMS configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote GCP KMS configuration to %q", configPath)
+	for _, k := range gcpkmsSecretBackendConfigFields {
+		d.SetPartial(k)
+	}
+
+	return nil
+}
+
+func gcpkmsSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
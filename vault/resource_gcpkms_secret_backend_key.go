@@ -0,0 +1,272 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	gcpkmsSecretBackendKeyBackendFromPathRegex = regexp.MustCompile("^(.+)/keys/.+$")
+	gcpkmsSecretBackendKeyNameFromPathRegex    = regexp.MustCompile("^.+/keys/(.+)$")
+)
+
+func gcpkmsSecretBackendKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: gcpkmsSecretBackendKeyWrite,
+		Read:   gcpkmsSecretBackendKeyRead,
+		Update: gcpkmsSecretBackendKeyWrite,
+		Delete: gcpkmsSecretBackendKeyDelete,
+		Exists: gcpkmsSecretBackendKeyExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the GCP KMS Secret Backend the key belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the key in Vault.",
+			},
+			"key_ring": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Full name of the Google Cloud KMS key ring, e.g. projects/<project>/locations/<location>/keyRings/<key ring>.",
+			},
+			"crypto_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the crypto key in Google Cloud KMS, defaults to the name of the key in Vault.",
+			},
+			"purpose": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "encrypt_decrypt",
+				Description:  "Purpose of the key, one of \"encrypt_decrypt\", \"asymmetric_sign\" or \"asymmetric_decrypt\".",
+				ValidateFunc: validation.StringInSlice([]string{"encrypt_decrypt", "asymmetric_sign", "asymmetric_decrypt"}, false),
+			},
+			"algorithm": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Algorithm of new key versions, e.g. \"symmetric_encryption\" or \"ec_sign_p256_sha256\".",
+			},
+			"protection_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "software",
+				Description:  "Protection level of the key, either \"software\" or \"hsm\".",
+				ValidateFunc: validation.StringInSlice([]string{"software", "hsm"}, false),
+			},
+			"rotation_period": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Period after which a new primary version of a symmetric key is created, e.g. \"72h\".",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Labels to apply to the crypto key.",
+			},
+			"min_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Minimum key version Vault accepts for operations.",
+			},
+			"max_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum key version Vault accepts for operations.",
+			},
+			"crypto_key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full name of the crypto key in Google Cloud KMS.",
+			},
+			"primary_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The primary version of the key.",
+			},
+		},
+	}
+}
+
+func gcpkmsSecretBackendKeyWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := gcpkmsSecretBackendKeyPath(backend, name)
+
+	data := map[string]interface{}{
+		"key_ring":         d.Get("key_ring").(string),
+		"purpose":          d.Get("purpose").(string),
+		"protection_level": d.Get("protection_level").(string),
+		"labels":           d.Get("labels").(map[string]interface{}),
+	}
+	for _, k := range []string{"crypto_key", "algorithm", "rotation_period"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+
+	log.Printf("[DEBUG] Writing GCP KMS secret backend key %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing GCP KMS secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote GCP KMS secret backend key %q", path)
+
+	d.SetId(path)
+
+	if d.IsNewResource() || d.HasChange("min_version") || d.HasChange("max_version") {
+		configPath := gcpkmsSecretBackendKeyConfigPath(backend, name)
+		config := map[string]interface{}{
+			"min_version": d.Get("min_version").(int),
+			"max_version": d.Get("max_version").(int),
+		}
+		log.Printf("[DEBUG] Writing GCP KMS secret backend key config %q", configPath)
+		if _, err := client.Logical().Write(configPath, config); err != nil {
+			return fmt.Errorf("error writing GCP KMS secret backend key config %q: %s", configPath, err)
+		}
+		log.Printf("[DEBUG] Wrote GCP KMS secret backend key config %q", configPath)
+	}
+
+	return gcpkmsSecretBackendKeyRead(d, meta)
+}
+
+func gcpkmsSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := gcpkmsSecretBackendKeyBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for GCP KMS secret backend key: %s", path, err)
+	}
+	name, err := gcpkmsSecretBackendKeyNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for GCP KMS secret backend key: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading GCP KMS secret backend key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading GCP KMS secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read GCP KMS secret backend key %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] GCP KMS secret backend key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	d.Set("crypto_key_id", resp.Data["id"])
+	if v, ok := resp.Data["primary_version"]; ok && v != nil {
+		d.Set("primary_version", fmt.Sprintf("%v", v))
+	}
+	d.Set("purpose", resp.Data["purpose"])
+	// rotation_period isn't read back, Vault normalises the duration so
+	// "72h" would come back as "72h0m0s".
+	if err := d.Set("labels", resp.Data["labels"]); err != nil {
+		return fmt.Errorf("error setting labels in state: %s", err)
+	}
+
+	// the crypto key id is the key ring followed by /cryptoKeys/<crypto key>.
+	if id, ok := resp.Data["id"].(string); ok {
+		if i := strings.LastIndex(id, "/cryptoKeys/"); i != -1 {
+			d.Set("key_ring", id[:i])
+			d.Set("crypto_key", id[i+len("/cryptoKeys/"):])
+		}
+	}
+
+	return nil
+}
+
+func gcpkmsSecretBackendKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	// this destroys all versions of the crypto key in Google Cloud KMS,
+	// the crypto key itself can't be deleted.
+	log.Printf("[DEBUG] Deleting GCP KMS secret backend key %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting GCP KMS secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted GCP KMS secret backend key %q", path)
+
+	return nil
+}
+
+func gcpkmsSecretBackendKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Checking if GCP KMS secret backend key %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if GCP KMS secret backend key %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if GCP KMS secret backend key %q exists", path)
+
+	return resp != nil, nil
+}
+
+func gcpkmsSecretBackendKeyPath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/keys/" + strings.Trim(name, "/")
+}
+
+func gcpkmsSecretBackendKeyConfigPath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/keys/config/" + strings.Trim(name, "/")
+}
+
+func gcpkmsSecretBackendKeyBackendFromPath(path string) (string, error) {
+	if !gcpkmsSecretBackendKeyBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := gcpkmsSecretBackendKeyBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
+
+func gcpkmsSecretBackendKeyNameFromPath(path string) (string, error) {
+	if !gcpkmsSecretBackendKeyNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := gcpkmsSecretBackendKeyNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
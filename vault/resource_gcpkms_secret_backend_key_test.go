@@ -0,0 +1,89 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccGCPKMSSecretBackendKey_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcpkms")
+	name := acctest.RandomWithPrefix("tf-test")
+	credentials, project := getTestGCPCreds(t)
+	keyRing := fmt.Sprintf("projects/%s/locations/global/keyRings/tf-test-vault", project)
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckGCPKMSSecretBackendKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGCPKMSSecretBackendKeyConfig(backend, credentials, name, keyRing, "72h", "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcpkms_secret_backend_key.test", "name", name),
+					resource.TestCheckResourceAttr("vault_gcpkms_secret_backend_key.test", "key_ring", keyRing),
+					resource.TestCheckResourceAttr("vault_gcpkms_secret_backend_key.test", "crypto_key", name),
+					resource.TestCheckResourceAttr("vault_gcpkms_secret_backend_key.test", "purpose", "encrypt_decrypt"),
+					resource.TestCheckResourceAttr("vault_gcpkms_secret_backend_key.test", "labels.%", "1"),
+					resource.TestCheckResourceAttr("vault_gcpkms_secret_backend_key.test", "labels.stage", "initial"),
+					resource.TestCheckResourceAttrSet("vault_gcpkms_secret_backend_key.test", "crypto_key_id"),
+				),
+			},
+			{
+				Config: testAccGCPKMSSecretBackendKeyConfig(backend, credentials, name, keyRing, "96h", "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcpkms_secret_backend_key.test", "rotation_period", "96h"),
+					resource.TestCheckResourceAttr("vault_gcpkms_secret_backend_key.test", "labels.stage", "updated"),
+				),
+			},
+			{
+				ResourceName:            "vault_gcpkms_secret_backend_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"algorithm", "protection_level", "rotation_period", "min_version", "max_version"},
+			},
+		},
+	})
+}
+
+func testAccCheckGCPKMSSecretBackendKeyDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_gcpkms_secret_backend_key" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("GCP KMS secret backend key %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccGCPKMSSecretBackendKeyConfig(backend, credentials, name, keyRing, rotationPeriod, stage string) string {
+	return fmt.Sprintf(`
+resource "vault_gcpkms_secret_backend" "test" {
+  path        = "%s"
+  credentials = <<EOF
+%s
+EOF
+}
+
+resource "vault_gcpkms_secret_backend_key" "test" {
+  backend         = "${vault_gcpkms_secret_backend.test.path}"
+  name            = "%s"
+  key_ring        = "%s"
+  rotation_period = "%s"
+
+  labels = {
+    stage = "%s"
+  }
+}`, backend, credentials, name, keyRing, rotationPeriod, stage)
+}
@@ -0,0 +1,90 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccGCPKMSSecretBackend_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-gcpkms")
+	credentials, _ := getTestGCPCreds(t)
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccGCPKMSSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGCPKMSSecretBackendConfig_initial(path, credentials),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcpkms_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_gcpkms_secret_backend.test", "description", "test description"),
+				),
+			},
+			{
+				Config: testAccGCPKMSSecretBackendConfig_updated(path, credentials),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcpkms_secret_backend.test", "scopes.#", "1"),
+					resource.TestCheckResourceAttr("vault_gcpkms_secret_backend.test", "scopes.0", "https://www.googleapis.com/auth/cloudkms"),
+				),
+			},
+			{
+				ResourceName:            "vault_gcpkms_secret_backend.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials"},
+			},
+		},
+	})
+}
+
+func testAccGCPKMSSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_gcpkms_secret_backend" {
+			continue
+		}
+		for path, mount := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if mount.Type == "gcpkms" && path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccGCPKMSSecretBackendConfig_initial(path, credentials string) string {
+	return fmt.Sprintf(`
+resource "vault_gcpkms_secret_backend" "test" {
+  path        = "%s"
+  description = "test description"
+  credentials = <<EOF
+%s
+EOF
+}`, path, credentials)
+}
+
+func testAccGCPKMSSecretBackendConfig_updated(path, credentials string) string {
+	return fmt.Sprintf(`
+resource "vault_gcpkms_secret_backend" "test" {
+  path        = "%s"
+  description = "test description"
+  scopes      = ["https://www.googleapis.com/auth/cloudkms"]
+  credentials = <<EOF
+%s
+EOF
+}`, path, credentials)
+}
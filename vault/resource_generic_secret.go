@@ -26,10 +26,11 @@ func genericSecretResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"path": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Full path where the generic secret will be written.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path where the generic secret will be written.",
+				ValidateFunc: validateNoLeadingSlash,
 			},
 
 			// Data is passed as JSON so that an arbitrary structure is
@@ -179,8 +180,6 @@ func genericSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 
-		log.Printf("[DEBUG] secret: %#v", secret)
-
 		jsonData, err := json.Marshal(secret.Data)
 		if err != nil {
 			return fmt.Errorf("error marshaling JSON for %q: %s", path, err)
@@ -17,13 +17,17 @@ func identityGroupResource() *schema.Resource {
 		Read:   identityGroupRead,
 		Delete: identityGroupDelete,
 		Exists: identityGroupExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Name of the group.",
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Name of the group.",
+				ForceNew:     true,
+				ValidateFunc: validateIdentityName,
 			},
 
 			"type": {
@@ -44,7 +48,7 @@ func identityGroupResource() *schema.Resource {
 			},
 
 			"policies": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -53,8 +57,9 @@ func identityGroupResource() *schema.Resource {
 			},
 
 			"member_group_ids": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -62,7 +67,7 @@ func identityGroupResource() *schema.Resource {
 			},
 
 			"member_entity_ids": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -75,21 +80,72 @@ func identityGroupResource() *schema.Resource {
 				Computed:    true,
 				Description: "ID of the group.",
 			},
+
+			"parent_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "IDs of the groups the group is a member of.",
+			},
+
+			"alias": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The alias of the group, if it is an external group with an alias.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the group alias.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the group alias.",
+						},
+						"mount_accessor": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Mount accessor of the auth backend of the group alias.",
+						},
+						"mount_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the auth backend of the group alias.",
+						},
+					},
+				},
+			},
+
+			"creation_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the group was created.",
+			},
+
+			"last_update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the group was last updated.",
+			},
 		},
 	}
 }
 
 func identityGroupUpdateFields(d *schema.ResourceData, data map[string]interface{}) {
 	if policies, ok := d.GetOk("policies"); ok {
-		data["policies"] = policies
+		data["policies"] = policies.(*schema.Set).List()
 	}
 
 	if memberEntityIDs, ok := d.GetOk("member_entity_ids"); ok {
-		data["member_entity_ids"] = memberEntityIDs
+		data["member_entity_ids"] = memberEntityIDs.(*schema.Set).List()
 	}
 
 	if memberGroupIDs, ok := d.GetOk("member_group_ids"); ok {
-		data["member_group_ids"] = memberGroupIDs
+		data["member_group_ids"] = memberGroupIDs.(*schema.Set).List()
 	}
 
 	if metadata, ok := d.GetOk("metadata"); ok {
@@ -169,9 +225,23 @@ func identityGroupRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	for _, k := range []string{"name", "type", "metadata", "member_entity_ids", "member_group_ids"} {
+	for _, k := range []string{"name", "type", "metadata", "policies", "member_entity_ids", "member_group_ids", "parent_group_ids", "creation_time", "last_update_time"} {
 		d.Set(k, resp.Data[k])
 	}
+
+	// groups without an alias have an empty alias object
+	var alias []map[string]interface{}
+	if a, ok := resp.Data["alias"].(map[string]interface{}); ok && a["id"] != nil {
+		alias = append(alias, map[string]interface{}{
+			"id":             a["id"],
+			"name":           a["name"],
+			"mount_accessor": a["mount_accessor"],
+			"mount_type":     a["mount_type"],
+		})
+	}
+	if err := d.Set("alias", alias); err != nil {
+		return fmt.Errorf("error setting alias of IdentityGroup %q in state: %s", id, err)
+	}
 	return nil
 }
 
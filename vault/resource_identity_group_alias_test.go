@@ -30,6 +30,11 @@ func TestAccIdentityGroupAlias(t *testing.T) {
 					resource.TestCheckResourceAttrPair(nameGroupAlias, "mount_accessor", nameGithubA, "accessor"),
 				),
 			},
+			{
+				ResourceName:      nameGroupAlias,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
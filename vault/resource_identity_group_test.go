@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -24,6 +25,11 @@ func TestAccIdentityGroup(t *testing.T) {
 				Config: testAccIdentityGroupConfig(group),
 				Check:  testAccIdentityGroupCheckAttrs(group),
 			},
+			{
+				ResourceName:      "vault_identity_group.group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -45,8 +51,39 @@ func TestAccIdentityGroupUpdate(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccIdentityGroupCheckAttrs(group),
 					resource.TestCheckResourceAttr("vault_identity_group.group", "metadata.version", "2"),
-					resource.TestCheckResourceAttr("vault_identity_group.group", "policies.0", "dev"),
-					resource.TestCheckResourceAttr("vault_identity_group.group", "policies.1", "test"),
+					resource.TestCheckResourceAttr("vault_identity_group.group", "policies.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityGroupTopology(t *testing.T) {
+	group := acctest.RandomWithPrefix("test-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityGroupConfigTopology(group),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_group.parent", "member_group_ids.#", "1"),
+					resource.TestCheckResourceAttrSet("vault_identity_group.parent", "creation_time"),
+					resource.TestCheckResourceAttrSet("vault_identity_group.parent", "last_update_time"),
+					resource.TestCheckResourceAttr("vault_identity_group.parent", "alias.#", "0"),
+				),
+			},
+			{
+				// the parent and the alias are only read back once they exist
+				Config: testAccIdentityGroupConfigTopology(group),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_group.group", "parent_group_ids.#", "1"),
+					resource.TestCheckResourceAttr("vault_identity_group.group", "alias.#", "1"),
+					resource.TestCheckResourceAttr("vault_identity_group.group", "alias.0.name", group),
+					resource.TestCheckResourceAttr("vault_identity_group.group", "alias.0.mount_type", "github"),
+					resource.TestCheckResourceAttrPair("vault_identity_group.group", "alias.0.id", "vault_identity_group_alias.group-alias", "id"),
 				),
 			},
 		},
@@ -140,9 +177,15 @@ func testAccIdentityGroupCheckAttrs(group string) resource.TestCheckFunc {
 						return fmt.Errorf("expected %s to have %d entries in state, has %d", stateAttr, len(apiData), count)
 					}
 					for i := 0; i < count; i++ {
-						stateData := instanceState.Attributes[stateAttr+"."+strconv.Itoa(i)]
-						if stateData != apiData[i] {
-							return fmt.Errorf("expected item %d of %s (%s in state) of %q to be %q, got %q", i, apiAttr, stateAttr, path, stateData, apiData[i])
+						found := false
+						for stateKey, stateValue := range instanceState.Attributes {
+							if strings.HasPrefix(stateKey, stateAttr+".") && apiData[i] == stateValue {
+								found = true
+								break
+							}
+						}
+						if !found {
+							return fmt.Errorf("expected item %d of %s (%s in state) of %q to be in state but wasn't", i, apiAttr, stateAttr, path)
 						}
 					}
 					match = true
@@ -181,3 +224,27 @@ resource "vault_identity_group" "group" {
   }
 }`, groupName)
 }
+
+func testAccIdentityGroupConfigTopology(groupName string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_group" "group" {
+  name = "%s"
+  type = "external"
+}
+
+resource "vault_identity_group" "parent" {
+  name = "%s-parent"
+  member_group_ids = ["${vault_identity_group.group.id}"]
+}
+
+resource "vault_auth_backend" "github" {
+  type = "github"
+  path = "github-%s"
+}
+
+resource "vault_identity_group_alias" "group-alias" {
+  name = "%s"
+  mount_accessor = "${vault_auth_backend.github.accessor}"
+  canonical_id = "${vault_identity_group.group.id}"
+}`, groupName, groupName, groupName, groupName)
+}
@@ -0,0 +1,328 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+// kmipSecretBackendConfigFields are the fields of the KMIP configuration.
+var kmipSecretBackendConfigFields = []string{
+	"listen_addrs",
+	"server_hostnames",
+	"server_ips",
+	"tls_ca_key_type",
+	"tls_ca_key_bits",
+	"tls_min_version",
+	"default_tls_client_key_type",
+	"default_tls_client_key_bits",
+	"default_tls_client_ttl",
+}
+
+func kmipSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kmipSecretBackendCreate,
+		Read:   kmipSecretBackendRead,
+		Update: kmipSecretBackendUpdate,
+		Delete: kmipSecretBackendDelete,
+		Exists: kmipSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "kmip",
+				Description:  "Path to mount the backend at.",
+				ValidateFunc: validateMountPath,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lease duration for secrets in seconds",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum possible lease duration for secrets in seconds",
+			},
+			"listen_addrs": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Addresses the KMIP server should listen on (host:port).",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"server_hostnames": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Hostnames to include in the server certificate.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"server_ips": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "IP addresses to include in the server certificate.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tls_ca_key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "CA key type, either \"rsa\" or \"ec\".",
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec"}, false),
+			},
+			"tls_ca_key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "CA key bits, valid values depend on the key type.",
+			},
+			"tls_min_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Minimum TLS version to accept.",
+				ValidateFunc: validation.StringInSlice([]string{"tls12", "tls13"}, false),
+			},
+			"default_tls_client_key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Client certificate key type, either \"rsa\" or \"ec\".",
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec"}, false),
+			},
+			"default_tls_client_key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Client certificate key bits, valid values depend on the key type.",
+			},
+			"default_tls_client_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Client certificate TTL in seconds.",
+			},
+		},
+	}
+}
+
+func kmipSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultEnterprise(client, "1.2.0", "vault_kmip_secret_backend"); err != nil {
+		return err
+	}
+
+	path := d.Get("path").(string)
+	description := d.Get("description").(string)
+	defaultTTL := d.Get("default_lease_ttl_seconds").(int)
+	maxTTL := d.Get("max_lease_ttl_seconds").(int)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting KMIP backend at %q", path)
+	err := mountSecretBackend(client, path, &api.MountInput{
+		Type:        "kmip",
+		Description: description,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", defaultTTL),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", maxTTL),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted KMIP backend at %q", path)
+	d.SetId(path)
+
+	d.SetPartial("path")
+	d.SetPartial("description")
+	d.SetPartial("default_lease_ttl_seconds")
+	d.SetPartial("max_lease_ttl_seconds")
+
+	if err := kmipSecretBackendWriteConfig(client, path, d); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return kmipSecretBackendRead(d, meta)
+}
+
+func kmipSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading KMIP backend mount %q from Vault", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read KMIP backend mount %q from Vault", path)
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
+
+	configPath := kmipSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading KMIP configuration from %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading KMIP configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read KMIP configuration from %q", configPath)
+	if resp != nil {
+		for _, k := range []string{
+			"listen_addrs", "server_hostnames", "server_ips", "tls_ca_key_type",
+			"tls_ca_key_bits", "tls_min_version", "default_tls_client_key_type",
+			"default_tls_client_key_bits", "default_tls_client_ttl",
+		} {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func kmipSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	d.Partial(true)
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") || d.HasChange("description") {
+		config := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			config.Description = &description
+		}
+		log.Printf("[DEBUG] Tuning mount %q", path)
+		err := tuneMount(client, path, config)
+		if err != nil {
+			return fmt.Errorf("error tuning mount %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Tuned mount %q", path)
+		d.SetPartial("default_lease_ttl_seconds")
+		d.SetPartial("max_lease_ttl_seconds")
+		d.SetPartial("description")
+	}
+	err := writeBackendConfigOnChange(d, kmipSecretBackendConfigFields, func() error {
+		return kmipSecretBackendWriteConfig(client, path, d)
+	})
+	if err != nil {
+		return err
+	}
+	d.Partial(false)
+	return kmipSecretBackendRead(d, meta)
+}
+
+func kmipSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting KMIP backend %q", path)
+	err := unmountSecretBackend(client, path)
+	if err != nil {
+		return fmt.Errorf("error unmounting KMIP backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted KMIP backend %q", path)
+	return nil
+}
+
+func kmipSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if KMIP backend exists at %q", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if KMIP backend exists at %q", path)
+	_, ok := mounts[strings.Trim(path, "/")+"/"]
+	return ok, nil
+}
+
+func kmipSecretBackendWriteConfig(client *api.Client, path string, d *schema.ResourceData) error {
+	configPath := kmipSecretBackendConfigPath(path)
+
+	log.Printf("[DEBUG] Writing KMIP configuration to %q", configPath)
+	data := map[string]interface{}{}
+	for _, k := range []string{
+		"tls_ca_key_type", "tls_min_version", "default_tls_client_key_type",
+	} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("tls_ca_key_bits"); ok {
+		data["tls_ca_key_bits"] = v.(int)
+	}
+	if v, ok := d.GetOk("default_tls_client_key_bits"); ok {
+		data["default_tls_client_key_bits"] = v.(int)
+	}
+	if v, ok := d.GetOk("default_tls_client_ttl"); ok {
+		data["default_tls_client_ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("listen_addrs"); ok {
+		data["listen_addrs"] = v.([]interface{})
+	}
+	if v, ok := d.GetOk("server_hostnames"); ok {
+		data["server_hostnames"] = v.([]interface{})
+	}
+	if v, ok := d.GetOk("server_ips"); ok {
+		data["server_ips"] = v.([]interface{})
+	}
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing KMIP configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KMIP configuration to %q", configPath)
+	for _, k := range kmipSecretBackendConfigFields {
+		d.SetPartial(k)
+	}
+
+	return nil
+}
+
+func kmipSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
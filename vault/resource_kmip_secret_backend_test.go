@@ -0,0 +1,101 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKMIPSecretBackend_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-kmip")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t); testAccEnterprisePreCheck(t) },
+		CheckDestroy: testAccKMIPSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMIPSecretBackendConfig_initial(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "listen_addrs.#", "1"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "listen_addrs.0", "127.0.0.1:5696"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "tls_ca_key_type", "ec"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "tls_ca_key_bits", "256"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "default_tls_client_key_type", "ec"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "default_tls_client_key_bits", "256"),
+				),
+			},
+			{
+				Config: testAccKMIPSecretBackendConfig_updated(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "listen_addrs.#", "2"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "listen_addrs.1", "127.0.0.1:5697"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "server_hostnames.#", "1"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "server_hostnames.0", "kmip.example.com"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "tls_min_version", "tls13"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend.test", "default_tls_client_ttl", "86400"),
+				),
+			},
+			{
+				ResourceName:      "vault_kmip_secret_backend.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccKMIPSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kmip_secret_backend" {
+			continue
+		}
+		for path, mount := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if mount.Type == "kmip" && path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccKMIPSecretBackendConfig_initial(path string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "test" {
+  path                        = "%s"
+  listen_addrs                = ["127.0.0.1:5696"]
+  tls_ca_key_type             = "ec"
+  tls_ca_key_bits             = 256
+  default_tls_client_key_type = "ec"
+  default_tls_client_key_bits = 256
+}`, path)
+}
+
+func testAccKMIPSecretBackendConfig_updated(path string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "test" {
+  path                        = "%s"
+  listen_addrs                = ["127.0.0.1:5696", "127.0.0.1:5697"]
+  server_hostnames            = ["kmip.example.com"]
+  tls_ca_key_type             = "ec"
+  tls_ca_key_bits             = 256
+  tls_min_version             = "tls13"
+  default_tls_client_key_type = "ec"
+  default_tls_client_key_bits = 256
+  default_tls_client_ttl      = 86400
+}`, path)
+}
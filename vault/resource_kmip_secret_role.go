@@ -0,0 +1,427 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	kmipSecretRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/scope/.+/role/.+$")
+	kmipSecretRoleScopeFromPathRegex   = regexp.MustCompile("^.+/scope/(.+)/role/.+$")
+	kmipSecretRoleNameFromPathRegex    = regexp.MustCompile("^.+/scope/.+/role/(.+)$")
+)
+
+// kmipSecretRoleOperationFields are the KMIP operations a role can be
+// granted, each is sent to Vault as a flag.
+var kmipSecretRoleOperationFields = []string{
+	"operation_activate",
+	"operation_add_attribute",
+	"operation_all",
+	"operation_create",
+	"operation_create_key_pair",
+	"operation_decrypt",
+	"operation_delete_attribute",
+	"operation_destroy",
+	"operation_discover_versions",
+	"operation_encrypt",
+	"operation_get",
+	"operation_get_attribute_list",
+	"operation_get_attributes",
+	"operation_import",
+	"operation_locate",
+	"operation_mac",
+	"operation_mac_verify",
+	"operation_modify_attribute",
+	"operation_none",
+	"operation_query",
+	"operation_register",
+	"operation_rekey",
+	"operation_rekey_key_pair",
+	"operation_revoke",
+	"operation_rng_retrieve",
+	"operation_rng_seed",
+	"operation_sign",
+	"operation_signature_verify",
+}
+
+func kmipSecretRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kmipSecretRoleWrite,
+		Read:   kmipSecretRoleRead,
+		Update: kmipSecretRoleWrite,
+		Delete: kmipSecretRoleDelete,
+		Exists: kmipSecretRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the KMIP Secret Backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the scope the role belongs to.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the role.",
+			},
+			"tls_client_key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Client certificate key type, either \"rsa\" or \"ec\".",
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec"}, false),
+			},
+			"tls_client_key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Client certificate key bits, valid values depend on the key type.",
+			},
+			"tls_client_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Client certificate TTL in seconds.",
+			},
+			"operation_activate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Activate operation.",
+			},
+			"operation_add_attribute": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Add Attribute operation.",
+			},
+			"operation_all": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use all KMIP operations.",
+			},
+			"operation_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Create operation.",
+			},
+			"operation_create_key_pair": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Create Key Pair operation.",
+			},
+			"operation_decrypt": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Decrypt operation.",
+			},
+			"operation_delete_attribute": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Delete Attribute operation.",
+			},
+			"operation_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Destroy operation.",
+			},
+			"operation_discover_versions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Discover Versions operation.",
+			},
+			"operation_encrypt": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Encrypt operation.",
+			},
+			"operation_get": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Get operation.",
+			},
+			"operation_get_attribute_list": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Get Attribute List operation.",
+			},
+			"operation_get_attributes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Get Attributes operation.",
+			},
+			"operation_import": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Import operation.",
+			},
+			"operation_locate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Locate operation.",
+			},
+			"operation_mac": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP MAC operation.",
+			},
+			"operation_mac_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP MAC Verify operation.",
+			},
+			"operation_modify_attribute": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Modify Attribute operation.",
+			},
+			"operation_none": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove all permissions from the role.",
+			},
+			"operation_query": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Query operation.",
+			},
+			"operation_register": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Register operation.",
+			},
+			"operation_rekey": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Rekey operation.",
+			},
+			"operation_rekey_key_pair": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Rekey Key Pair operation.",
+			},
+			"operation_revoke": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Revoke operation.",
+			},
+			"operation_rng_retrieve": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP RNG Retrieve operation.",
+			},
+			"operation_rng_seed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP RNG Seed operation.",
+			},
+			"operation_sign": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Sign operation.",
+			},
+			"operation_signature_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant permission to use the KMIP Signature Verify operation.",
+			},
+		},
+	}
+}
+
+func kmipSecretRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultEnterprise(client, "1.2.0", "vault_kmip_secret_role"); err != nil {
+		return err
+	}
+
+	backend := d.Get("backend").(string)
+	scope := d.Get("scope").(string)
+	role := d.Get("role").(string)
+
+	path := kmipSecretRolePath(backend, scope, role)
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("tls_client_key_type"); ok {
+		data["tls_client_key_type"] = v.(string)
+	}
+	if v, ok := d.GetOk("tls_client_key_bits"); ok {
+		data["tls_client_key_bits"] = v.(int)
+	}
+	if v, ok := d.GetOk("tls_client_ttl"); ok {
+		data["tls_client_ttl"] = v.(int)
+	}
+	for _, k := range kmipSecretRoleOperationFields {
+		data[k] = d.Get(k).(bool)
+	}
+
+	log.Printf("[DEBUG] Writing KMIP secret role %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing KMIP secret role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KMIP secret role %q", path)
+
+	d.SetId(path)
+
+	return kmipSecretRoleRead(d, meta)
+}
+
+func kmipSecretRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := kmipSecretRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for KMIP secret role: %s", path, err)
+	}
+	scope, err := kmipSecretRoleScopeFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for KMIP secret role: %s", path, err)
+	}
+	role, err := kmipSecretRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for KMIP secret role: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading KMIP secret role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading KMIP secret role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read KMIP secret role %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] KMIP secret role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("scope", scope)
+	d.Set("role", role)
+	d.Set("tls_client_key_type", resp.Data["tls_client_key_type"])
+	d.Set("tls_client_key_bits", resp.Data["tls_client_key_bits"])
+	d.Set("tls_client_ttl", resp.Data["tls_client_ttl"])
+	// only the granted operations are returned.
+	for _, k := range kmipSecretRoleOperationFields {
+		v, _ := resp.Data[k].(bool)
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func kmipSecretRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting KMIP secret role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting KMIP secret role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted KMIP secret role %q", path)
+
+	return nil
+}
+
+func kmipSecretRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Checking if KMIP secret role %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if KMIP secret role %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if KMIP secret role %q exists", path)
+
+	return resp != nil, nil
+}
+
+func kmipSecretRolePath(backend string, scope string, role string) string {
+	return strings.Trim(backend, "/") + "/scope/" + strings.Trim(scope, "/") + "/role/" + strings.Trim(role, "/")
+}
+
+func kmipSecretRoleBackendFromPath(path string) (string, error) {
+	if !kmipSecretRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := kmipSecretRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
+
+func kmipSecretRoleScopeFromPath(path string) (string, error) {
+	if !kmipSecretRoleScopeFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no scope found")
+	}
+	res := kmipSecretRoleScopeFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for scope", len(res))
+	}
+	return res[1], nil
+}
+
+func kmipSecretRoleNameFromPath(path string) (string, error) {
+	if !kmipSecretRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := kmipSecretRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,119 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKMIPSecretRole_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-kmip")
+	scope := acctest.RandomWithPrefix("tf-test")
+	role := acctest.RandomWithPrefix("tf-test")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t); testAccEnterprisePreCheck(t) },
+		CheckDestroy: testAccCheckKMIPSecretRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMIPSecretRoleConfig_initial(backend, scope, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "scope", scope),
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "role", role),
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "tls_client_key_type", "ec"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "tls_client_key_bits", "256"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "operation_activate", "true"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "operation_create", "true"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "operation_get", "true"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "operation_destroy", "false"),
+				),
+			},
+			{
+				Config: testAccKMIPSecretRoleConfig_updated(backend, scope, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "operation_activate", "true"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "operation_create", "false"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "operation_get", "true"),
+					resource.TestCheckResourceAttr("vault_kmip_secret_role.test", "operation_destroy", "true"),
+				),
+			},
+			{
+				ResourceName:      "vault_kmip_secret_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKMIPSecretRoleDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kmip_secret_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("KMIP secret role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccKMIPSecretRoleConfig_initial(backend, scope, role string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "test" {
+  path         = "%s"
+  listen_addrs = ["127.0.0.1:5696"]
+}
+
+resource "vault_kmip_secret_scope" "test" {
+  backend = "${vault_kmip_secret_backend.test.path}"
+  name    = "%s"
+  force   = true
+}
+
+resource "vault_kmip_secret_role" "test" {
+  backend             = "${vault_kmip_secret_backend.test.path}"
+  scope               = "${vault_kmip_secret_scope.test.name}"
+  role                = "%s"
+  tls_client_key_type = "ec"
+  tls_client_key_bits = 256
+  operation_activate  = true
+  operation_create    = true
+  operation_get       = true
+}`, backend, scope, role)
+}
+
+func testAccKMIPSecretRoleConfig_updated(backend, scope, role string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "test" {
+  path         = "%s"
+  listen_addrs = ["127.0.0.1:5696"]
+}
+
+resource "vault_kmip_secret_scope" "test" {
+  backend = "${vault_kmip_secret_backend.test.path}"
+  name    = "%s"
+  force   = true
+}
+
+resource "vault_kmip_secret_role" "test" {
+  backend             = "${vault_kmip_secret_backend.test.path}"
+  scope               = "${vault_kmip_secret_scope.test.name}"
+  role                = "%s"
+  tls_client_key_type = "ec"
+  tls_client_key_bits = 256
+  operation_activate  = true
+  operation_get       = true
+  operation_destroy   = true
+}`, backend, scope, role)
+}
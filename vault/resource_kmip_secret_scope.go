@@ -0,0 +1,208 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	kmipSecretScopeBackendFromPathRegex = regexp.MustCompile("^(.+)/scope/.+$")
+	kmipSecretScopeNameFromPathRegex    = regexp.MustCompile("^.+/scope/(.+)$")
+)
+
+func kmipSecretScopeResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kmipSecretScopeCreate,
+		Read:   kmipSecretScopeRead,
+		Update: kmipSecretScopeUpdate,
+		Delete: kmipSecretScopeDelete,
+		Exists: kmipSecretScopeExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the KMIP Secret Backend the scope belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the scope.",
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the scope even if it still holds managed objects.",
+			},
+		},
+	}
+}
+
+func kmipSecretScopeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultEnterprise(client, "1.2.0", "vault_kmip_secret_scope"); err != nil {
+		return err
+	}
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := kmipSecretScopePath(backend, name)
+
+	log.Printf("[DEBUG] Creating KMIP secret scope %q", path)
+	_, err := client.Logical().Write(path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating KMIP secret scope %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Created KMIP secret scope %q", path)
+
+	d.SetId(path)
+
+	return kmipSecretScopeRead(d, meta)
+}
+
+func kmipSecretScopeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := kmipSecretScopeBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for KMIP secret scope: %s", path, err)
+	}
+	name, err := kmipSecretScopeNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for KMIP secret scope: %s", path, err)
+	}
+
+	// scopes can't be read individually, only listed.
+	found, err := kmipSecretScopeListed(client, backend, name)
+	if err != nil {
+		return err
+	}
+	if !found {
+		log.Printf("[WARN] KMIP secret scope %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+
+	return nil
+}
+
+func kmipSecretScopeUpdate(d *schema.ResourceData, meta interface{}) error {
+	// force is the only attribute that can be updated, and it's only
+	// used when the scope is deleted.
+	return kmipSecretScopeRead(d, meta)
+}
+
+func kmipSecretScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting KMIP secret scope %q", path)
+	r := client.NewRequest("DELETE", "/v1/"+path)
+	if d.Get("force").(bool) {
+		r.Params.Set("force", "true")
+	}
+	resp, err := client.RawRequest(r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting KMIP secret scope %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted KMIP secret scope %q", path)
+
+	return nil
+}
+
+func kmipSecretScopeExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := kmipSecretScopeBackendFromPath(path)
+	if err != nil {
+		return true, fmt.Errorf("invalid path %q for KMIP secret scope: %s", path, err)
+	}
+	name, err := kmipSecretScopeNameFromPath(path)
+	if err != nil {
+		return true, fmt.Errorf("invalid path %q for KMIP secret scope: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Checking if KMIP secret scope %q exists", path)
+	found, err := kmipSecretScopeListed(client, backend, name)
+	if err != nil {
+		return true, err
+	}
+	log.Printf("[DEBUG] Checked if KMIP secret scope %q exists", path)
+
+	return found, nil
+}
+
+func kmipSecretScopeListed(client *api.Client, backend string, name string) (bool, error) {
+	listPath := strings.Trim(backend, "/") + "/scope"
+
+	log.Printf("[DEBUG] Listing KMIP secret scopes at %q", listPath)
+	resp, err := client.Logical().List(listPath)
+	if err != nil {
+		return false, fmt.Errorf("error listing KMIP secret scopes at %q: %s", listPath, err)
+	}
+	log.Printf("[DEBUG] Listed KMIP secret scopes at %q", listPath)
+
+	if resp == nil {
+		return false, nil
+	}
+	keys, _ := resp.Data["keys"].([]interface{})
+	for _, k := range keys {
+		if k == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func kmipSecretScopePath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/scope/" + strings.Trim(name, "/")
+}
+
+func kmipSecretScopeBackendFromPath(path string) (string, error) {
+	if !kmipSecretScopeBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := kmipSecretScopeBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
+
+func kmipSecretScopeNameFromPath(path string) (string, error) {
+	if !kmipSecretScopeNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := kmipSecretScopeNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,69 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKMIPSecretScope_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-kmip")
+	name := acctest.RandomWithPrefix("tf-test")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t); testAccEnterprisePreCheck(t) },
+		CheckDestroy: testAccCheckKMIPSecretScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMIPSecretScopeConfig(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kmip_secret_scope.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_kmip_secret_scope.test", "name", name),
+					resource.TestCheckResourceAttr("vault_kmip_secret_scope.test", "force", "true"),
+				),
+			},
+			{
+				ResourceName:            "vault_kmip_secret_scope.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force"},
+			},
+		},
+	})
+}
+
+func testAccCheckKMIPSecretScopeDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kmip_secret_scope" {
+			continue
+		}
+		found, err := kmipSecretScopeListed(client, rs.Primary.Attributes["backend"], rs.Primary.Attributes["name"])
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("KMIP secret scope %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccKMIPSecretScopeConfig(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "test" {
+  path         = "%s"
+  listen_addrs = ["127.0.0.1:5696"]
+}
+
+resource "vault_kmip_secret_scope" "test" {
+  backend = "${vault_kmip_secret_backend.test.path}"
+  name    = "%s"
+  force   = true
+}`, backend, name)
+}
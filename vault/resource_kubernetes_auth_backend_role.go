@@ -35,13 +35,13 @@ func kubernetesAuthBackendRoleResource() *schema.Resource {
 				Description: "Name of the role.",
 			},
 			"bound_service_account_names": {
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of service account names able to access this role. If set to \"*\" all names are allowed, both this and bound_service_account_namespaces can not be \"*\".",
 				Required:    true,
 			},
 			"bound_service_account_namespaces": {
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of namespaces allowed to access this role. If set to \"*\" all namespaces are allowed, both this and bound_service_account_names can not be set to \"*\".",
 				Required:    true,
@@ -62,7 +62,7 @@ func kubernetesAuthBackendRoleResource() *schema.Resource {
 				Optional:    true,
 			},
 			"policies": {
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Policies to be set on tokens issued using this role.",
 				Optional:    true,
@@ -118,13 +118,13 @@ func kubernetesAuthBackendRoleCreate(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[DEBUG] Writing Kubernetes auth backend role %q", path)
 
-	iBoundServiceAccountNames := d.Get("bound_service_account_names").([]interface{})
+	iBoundServiceAccountNames := d.Get("bound_service_account_names").(*schema.Set).List()
 	boundServiceAccountNames := make([]string, 0, len(iBoundServiceAccountNames))
 	for _, iBoundServiceAccountName := range iBoundServiceAccountNames {
 		boundServiceAccountNames = append(boundServiceAccountNames, iBoundServiceAccountName.(string))
 	}
 
-	iBoundServiceAccountNamespaces := d.Get("bound_service_account_namespaces").([]interface{})
+	iBoundServiceAccountNamespaces := d.Get("bound_service_account_namespaces").(*schema.Set).List()
 	boundServiceAccountNamespaces := make([]string, 0, len(iBoundServiceAccountNamespaces))
 	for _, iBoundServiceAccountNamespace := range iBoundServiceAccountNamespaces {
 		boundServiceAccountNamespaces = append(boundServiceAccountNamespaces, iBoundServiceAccountNamespace.(string))
@@ -140,7 +140,7 @@ func kubernetesAuthBackendRoleCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if v, ok := d.GetOk("policies"); ok {
-		iPolicies := v.([]interface{})
+		iPolicies := v.(*schema.Set).List()
 		policies := make([]string, 0, len(iPolicies))
 		for _, iPolicy := range iPolicies {
 			policies = append(policies, iPolicy.(string))
@@ -256,19 +256,19 @@ func kubernetesAuthBackendRoleUpdate(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[DEBUG] Updating Kubernetes auth backend role %q", path)
 
-	iBoundServiceAccountNames := d.Get("bound_service_account_names").([]interface{})
+	iBoundServiceAccountNames := d.Get("bound_service_account_names").(*schema.Set).List()
 	boundServiceAccountNames := make([]string, 0, len(iBoundServiceAccountNames))
 	for _, iBoundServiceAccountName := range iBoundServiceAccountNames {
 		boundServiceAccountNames = append(boundServiceAccountNames, iBoundServiceAccountName.(string))
 	}
 
-	iBoundServiceAccountNamespaces := d.Get("bound_service_account_namespaces").([]interface{})
+	iBoundServiceAccountNamespaces := d.Get("bound_service_account_namespaces").(*schema.Set).List()
 	boundServiceAccountNamespaces := make([]string, 0, len(iBoundServiceAccountNamespaces))
 	for _, iBoundServiceAccountNamespace := range iBoundServiceAccountNamespaces {
 		boundServiceAccountNamespaces = append(boundServiceAccountNamespaces, iBoundServiceAccountNamespace.(string))
 	}
 
-	iPolicies := d.Get("policies").([]interface{})
+	iPolicies := d.Get("policies").(*schema.Set).List()
 	policies := make([]string, 0, len(iPolicies))
 	for _, iPolicy := range iPolicies {
 		policies = append(policies, iPolicy.(string))
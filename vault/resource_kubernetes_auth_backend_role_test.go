@@ -29,19 +29,19 @@ func TestAccKubernetesAuthBackendRole_import(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"role_name", role),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"bound_service_account_names.0", "example"),
+						"bound_service_account_names.1861000095", "example"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"bound_service_account_names.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"bound_service_account_namespaces.0", "example"),
+						"bound_service_account_namespaces.1861000095", "example"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"bound_service_account_namespaces.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.0", "default"),
+						"policies.3814588639", "default"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.1", "dev"),
+						"policies.292811013", "dev"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.2", "prod"),
+						"policies.3048477079", "prod"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"policies.#", "3"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
@@ -79,19 +79,19 @@ func TestAccKubernetesAuthBackendRole_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"role_name", role),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"bound_service_account_names.0", "example"),
+						"bound_service_account_names.1861000095", "example"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"bound_service_account_names.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"bound_service_account_namespaces.0", "example"),
+						"bound_service_account_namespaces.1861000095", "example"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"bound_service_account_namespaces.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.0", "default"),
+						"policies.3814588639", "default"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.1", "dev"),
+						"policies.292811013", "dev"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.2", "prod"),
+						"policies.3048477079", "prod"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"policies.#", "3"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
@@ -121,19 +121,19 @@ func TestAccKubernetesAuthBackendRole_update(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"role_name", role),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"bound_service_account_names.0", "example"),
+						"bound_service_account_names.1861000095", "example"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"bound_service_account_names.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"bound_service_account_namespaces.0", "example"),
+						"bound_service_account_namespaces.1861000095", "example"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"bound_service_account_namespaces.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.0", "default"),
+						"policies.3814588639", "default"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.1", "dev"),
+						"policies.292811013", "dev"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.2", "prod"),
+						"policies.3048477079", "prod"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"policies.#", "3"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
@@ -148,19 +148,19 @@ func TestAccKubernetesAuthBackendRole_update(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"role_name", role),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"bound_service_account_names.0", "example"),
+						"bound_service_account_names.1861000095", "example"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"bound_service_account_names.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"bound_service_account_namespaces.0", "example"),
+						"bound_service_account_namespaces.1861000095", "example"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"bound_service_account_namespaces.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.0", "default"),
+						"policies.3814588639", "default"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.1", "dev"),
+						"policies.292811013", "dev"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.2", "prod"),
+						"policies.3048477079", "prod"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"policies.#", "3"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
@@ -190,19 +190,19 @@ func TestAccKubernetesAuthBackendRole_full(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"role_name", role),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"bound_service_account_names.0", "example"),
+						"bound_service_account_names.1861000095", "example"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"bound_service_account_names.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"bound_service_account_namespaces.0", "example"),
+						"bound_service_account_namespaces.1861000095", "example"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"bound_service_account_namespaces.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.0", "default"),
+						"policies.3814588639", "default"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.1", "dev"),
+						"policies.292811013", "dev"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.2", "prod"),
+						"policies.3048477079", "prod"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"policies.#", "3"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
@@ -238,19 +238,19 @@ func TestAccKubernetesAuthBackendRole_fullUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"role_name", role),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"bound_service_account_names.0", "example"),
+						"bound_service_account_names.1861000095", "example"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"bound_service_account_names.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"bound_service_account_namespaces.0", "example"),
+						"bound_service_account_namespaces.1861000095", "example"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"bound_service_account_namespaces.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.0", "default"),
+						"policies.3814588639", "default"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.1", "dev"),
+						"policies.292811013", "dev"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.2", "prod"),
+						"policies.3048477079", "prod"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"policies.#", "3"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
@@ -269,19 +269,19 @@ func TestAccKubernetesAuthBackendRole_fullUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"role_name", role),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"bound_service_account_names.0", "example"),
+						"bound_service_account_names.1861000095", "example"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"bound_service_account_names.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"bound_service_account_namespaces.0", "example"),
+						"bound_service_account_namespaces.1861000095", "example"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"bound_service_account_namespaces.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.0", "default"),
+						"policies.3814588639", "default"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.1", "dev"),
+						"policies.292811013", "dev"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
-						"policies.2", "prod"),
+						"policies.3048477079", "prod"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"policies.#", "3"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
@@ -0,0 +1,259 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// kubernetesSecretBackendConfigFields are the fields of the Kubernetes configuration.
+var kubernetesSecretBackendConfigFields = []string{
+	"kubernetes_host",
+	"kubernetes_ca_cert",
+	"service_account_jwt",
+	"disable_local_ca_jwt",
+}
+
+func kubernetesSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kubernetesSecretBackendCreate,
+		Read:   kubernetesSecretBackendRead,
+		Update: kubernetesSecretBackendUpdate,
+		Delete: kubernetesSecretBackendDelete,
+		Exists: kubernetesSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "kubernetes",
+				Description:  "Path to mount the backend at.",
+				ValidateFunc: validateMountPath,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lease duration for secrets in seconds",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum possible lease duration for secrets in seconds",
+			},
+			"kubernetes_host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Address of the Kubernetes API server, defaults to the address of the cluster Vault runs in.",
+			},
+			"kubernetes_ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM encoded CA certificate to verify the Kubernetes API server certificate.",
+			},
+			"service_account_jwt": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "JWT of the service account Vault uses to manage credentials, defaults to the local pod token.",
+				Sensitive:   true,
+			},
+			"disable_local_ca_jwt": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable defaulting to the local CA certificate and service account JWT when running in a Kubernetes pod.",
+			},
+		},
+	}
+}
+
+func kubernetesSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	description := d.Get("description").(string)
+	defaultTTL := d.Get("default_lease_ttl_seconds").(int)
+	maxTTL := d.Get("max_lease_ttl_seconds").(int)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting Kubernetes backend at %q", path)
+	err := mountSecretBackend(client, path, &api.MountInput{
+		Type:        "kubernetes",
+		Description: description,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", defaultTTL),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", maxTTL),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted Kubernetes backend at %q", path)
+	d.SetId(path)
+
+	d.SetPartial("path")
+	d.SetPartial("description")
+	d.SetPartial("default_lease_ttl_seconds")
+	d.SetPartial("max_lease_ttl_seconds")
+
+	if err := kubernetesSecretBackendWriteConfig(client, path, d); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return kubernetesSecretBackendRead(d, meta)
+}
+
+func kubernetesSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading Kubernetes backend mount %q from Vault", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Kubernetes backend mount %q from Vault", path)
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
+
+	configPath := kubernetesSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading Kubernetes configuration from %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading Kubernetes configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Kubernetes configuration from %q", configPath)
+	if resp != nil {
+		// the service account JWT is never returned.
+		for _, k := range []string{
+			"kubernetes_host", "kubernetes_ca_cert", "disable_local_ca_jwt",
+		} {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func kubernetesSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	d.Partial(true)
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") || d.HasChange("description") {
+		config := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			config.Description = &description
+		}
+		log.Printf("[DEBUG] Tuning mount %q", path)
+		err := tuneMount(client, path, config)
+		if err != nil {
+			return fmt.Errorf("error tuning mount %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Tuned mount %q", path)
+		d.SetPartial("default_lease_ttl_seconds")
+		d.SetPartial("max_lease_ttl_seconds")
+		d.SetPartial("description")
+	}
+	err := writeBackendConfigOnChange(d, kubernetesSecretBackendConfigFields, func() error {
+		return kubernetesSecretBackendWriteConfig(client, path, d)
+	})
+	if err != nil {
+		return err
+	}
+	d.Partial(false)
+	return kubernetesSecretBackendRead(d, meta)
+}
+
+func kubernetesSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting Kubernetes backend %q", path)
+	err := unmountSecretBackend(client, path)
+	if err != nil {
+		return fmt.Errorf("error unmounting Kubernetes backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted Kubernetes backend %q", path)
+	return nil
+}
+
+func kubernetesSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if Kubernetes backend exists at %q", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if Kubernetes backend exists at %q", path)
+	_, ok := mounts[strings.Trim(path, "/")+"/"]
+	return ok, nil
+}
+
+func kubernetesSecretBackendWriteConfig(client *api.Client, path string, d *schema.ResourceData) error {
+	configPath := kubernetesSecretBackendConfigPath(path)
+
+	log.Printf("[DEBUG] Writing Kubernetes configuration to %q", configPath)
+	data := map[string]interface{}{}
+	for _, k := range []string{
+		"kubernetes_host", "kubernetes_ca_cert", "service_account_jwt",
+	} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOkExists("disable_local_ca_jwt"); ok {
+		data["disable_local_ca_jwt"] = v.(bool)
+	}
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing Kubernetes configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Kubernetes configuration to %q", configPath)
+	for _, k := range kubernetesSecretBackendConfigFields {
+		d.SetPartial(k)
+	}
+
+	return nil
+}
+
+func kubernetesSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
@@ -0,0 +1,265 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	kubernetesSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
+	kubernetesSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+)$")
+)
+
+func kubernetesSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kubernetesSecretBackendRoleWrite,
+		Read:   kubernetesSecretBackendRoleRead,
+		Update: kubernetesSecretBackendRoleWrite,
+		Delete: kubernetesSecretBackendRoleDelete,
+		Exists: kubernetesSecretBackendRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Kubernetes Secret Backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the role.",
+			},
+			"allowed_kubernetes_namespaces": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Kubernetes namespaces credentials can be generated in, \"*\" allows all namespaces.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+			"service_account_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Existing service account to generate tokens for.",
+				ConflictsWith: []string{"kubernetes_role_name", "generated_role_rules"},
+			},
+			"kubernetes_role_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Existing Kubernetes role to bind to a generated service account.",
+				ConflictsWith: []string{"service_account_name", "generated_role_rules"},
+			},
+			"generated_role_rules": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Rules, in JSON or YAML, of the Kubernetes role and service account generated for each set of credentials.",
+				ConflictsWith: []string{"service_account_name", "kubernetes_role_name"},
+			},
+			"kubernetes_role_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Role",
+				Description:  "Type of the Kubernetes role, either \"Role\" or \"ClusterRole\".",
+				ValidateFunc: validation.StringInSlice([]string{"Role", "ClusterRole"}, false),
+			},
+			"name_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Template for the names of generated Kubernetes objects.",
+			},
+			"extra_annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Additional annotations to apply to all generated Kubernetes objects.",
+			},
+			"extra_labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Additional labels to apply to all generated Kubernetes objects.",
+			},
+			"token_default_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Default TTL in seconds of generated service account tokens.",
+			},
+			"token_max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum TTL in seconds of generated service account tokens.",
+			},
+			"token_default_audiences": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Default audiences of generated service account tokens.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func kubernetesSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := kubernetesSecretBackendRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"allowed_kubernetes_namespaces": d.Get("allowed_kubernetes_namespaces").(*schema.Set).List(),
+		"kubernetes_role_type":          d.Get("kubernetes_role_type").(string),
+		"name_template":                 d.Get("name_template").(string),
+		"extra_annotations":             d.Get("extra_annotations").(map[string]interface{}),
+		"extra_labels":                  d.Get("extra_labels").(map[string]interface{}),
+		"token_default_ttl":             d.Get("token_default_ttl").(int),
+		"token_max_ttl":                 d.Get("token_max_ttl").(int),
+		"token_default_audiences":       d.Get("token_default_audiences").([]interface{}),
+	}
+	found := false
+	for _, k := range []string{"service_account_name", "kubernetes_role_name", "generated_role_rules"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("one of service_account_name, kubernetes_role_name or generated_role_rules must be set")
+	}
+
+	log.Printf("[DEBUG] Writing Kubernetes secret backend role %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing Kubernetes secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Kubernetes secret backend role %q", path)
+
+	d.SetId(path)
+	return kubernetesSecretBackendRoleRead(d, meta)
+}
+
+func kubernetesSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := kubernetesSecretBackendRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+	name, err := kubernetesSecretBackendRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading Kubernetes secret backend role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Kubernetes secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Kubernetes secret backend role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Kubernetes secret backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	namespaces := []string{}
+	if v, ok := resp.Data["allowed_kubernetes_namespaces"].([]interface{}); ok {
+		namespaces = jsonStringArrayToStringArray(v)
+	}
+	if err := d.Set("allowed_kubernetes_namespaces", namespaces); err != nil {
+		return fmt.Errorf("error setting allowed_kubernetes_namespaces in state: %s", err)
+	}
+	audiences := []string{}
+	if v, ok := resp.Data["token_default_audiences"].([]interface{}); ok {
+		audiences = jsonStringArrayToStringArray(v)
+	}
+	if err := d.Set("token_default_audiences", audiences); err != nil {
+		return fmt.Errorf("error setting token_default_audiences in state: %s", err)
+	}
+	for _, k := range []string{"extra_annotations", "extra_labels"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting %s in state: %s", k, err)
+		}
+	}
+	for _, k := range []string{
+		"service_account_name", "kubernetes_role_name", "generated_role_rules",
+		"kubernetes_role_type", "name_template", "token_default_ttl", "token_max_ttl",
+	} {
+		d.Set(k, resp.Data[k])
+	}
+
+	return nil
+}
+
+func kubernetesSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting Kubernetes secret backend role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting Kubernetes secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted Kubernetes secret backend role %q", path)
+	return nil
+}
+
+func kubernetesSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if Kubernetes secret backend role %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if Kubernetes secret backend role %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if Kubernetes secret backend role %q exists", path)
+	return resp != nil, nil
+}
+
+func kubernetesSecretBackendRolePath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/roles/" + strings.Trim(name, "/")
+}
+
+func kubernetesSecretBackendRoleNameFromPath(path string) (string, error) {
+	if !kubernetesSecretBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := kubernetesSecretBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
+
+func kubernetesSecretBackendRoleBackendFromPath(path string) (string, error) {
+	if !kubernetesSecretBackendRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := kubernetesSecretBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,109 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKubernetesSecretBackendRole_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-kubernetes")
+	name := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKubernetesSecretBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesSecretBackendRoleConfig_serviceAccount(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "allowed_kubernetes_namespaces.#", "1"),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "service_account_name", "default"),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "token_default_ttl", "600"),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "token_max_ttl", "1200"),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "token_default_audiences.#", "1"),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "token_default_audiences.0", "vault"),
+				),
+			},
+			{
+				Config: testAccKubernetesSecretBackendRoleConfig_generatedRules(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "allowed_kubernetes_namespaces.#", "2"),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "service_account_name", ""),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "kubernetes_role_type", "ClusterRole"),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "extra_labels.%", "1"),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend_role.test", "extra_labels.team", "platform"),
+				),
+			},
+			{
+				ResourceName:      "vault_kubernetes_secret_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesSecretBackendRoleDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kubernetes_secret_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("Kubernetes secret backend role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccKubernetesSecretBackendRoleConfig_serviceAccount(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_kubernetes_secret_backend" "test" {
+  path                = "%s"
+  kubernetes_host     = "https://127.0.0.1:6443"
+  service_account_jwt = "header.payload.signature"
+}
+
+resource "vault_kubernetes_secret_backend_role" "test" {
+  backend                       = "${vault_kubernetes_secret_backend.test.path}"
+  name                          = "%s"
+  allowed_kubernetes_namespaces = ["default"]
+  service_account_name          = "default"
+  token_default_ttl             = 600
+  token_max_ttl                 = 1200
+  token_default_audiences       = ["vault"]
+}`, backend, name)
+}
+
+func testAccKubernetesSecretBackendRoleConfig_generatedRules(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_kubernetes_secret_backend" "test" {
+  path                = "%s"
+  kubernetes_host     = "https://127.0.0.1:6443"
+  service_account_jwt = "header.payload.signature"
+}
+
+resource "vault_kubernetes_secret_backend_role" "test" {
+  backend                       = "${vault_kubernetes_secret_backend.test.path}"
+  name                          = "%s"
+  allowed_kubernetes_namespaces = ["default", "dev"]
+  kubernetes_role_type          = "ClusterRole"
+  generated_role_rules          = "rules:\n- apiGroups: [\"\"]\n  resources: [\"pods\"]\n  verbs: [\"list\"]\n"
+
+  extra_labels = {
+    team = "platform"
+  }
+}`, backend, name)
+}
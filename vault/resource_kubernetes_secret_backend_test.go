@@ -0,0 +1,86 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKubernetesSecretBackend_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-kubernetes")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKubernetesSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesSecretBackendConfig_initial(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend.test", "kubernetes_host", "https://127.0.0.1:6443"),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend.test", "disable_local_ca_jwt", "true"),
+				),
+			},
+			{
+				Config: testAccKubernetesSecretBackendConfig_updated(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend.test", "kubernetes_host", "https://kubernetes.example.com:6443"),
+					resource.TestCheckResourceAttr("vault_kubernetes_secret_backend.test", "disable_local_ca_jwt", "false"),
+				),
+			},
+			{
+				ResourceName:            "vault_kubernetes_secret_backend.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"service_account_jwt"},
+			},
+		},
+	})
+}
+
+func testAccKubernetesSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kubernetes_secret_backend" {
+			continue
+		}
+		for path, mount := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if mount.Type == "kubernetes" && path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccKubernetesSecretBackendConfig_initial(path string) string {
+	return fmt.Sprintf(`
+resource "vault_kubernetes_secret_backend" "test" {
+  path                 = "%s"
+  kubernetes_host      = "https://127.0.0.1:6443"
+  service_account_jwt  = "header.payload.signature"
+  disable_local_ca_jwt = true
+}`, path)
+}
+
+func testAccKubernetesSecretBackendConfig_updated(path string) string {
+	return fmt.Sprintf(`
+resource "vault_kubernetes_secret_backend" "test" {
+  path                = "%s"
+  kubernetes_host     = "https://kubernetes.example.com:6443"
+  service_account_jwt = "header.payload.signature"
+}`, path)
+}
@@ -21,6 +21,9 @@ func ldapAuthBackendResource() *schema.Resource {
 		Read:   ldapAuthBackendRead,
 		Delete: ldapAuthBackendDelete,
 		Exists: ldapAuthBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"url": {
@@ -144,7 +147,7 @@ func ldapAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 	desc := d.Get("description").(string)
 
 	log.Printf("[DEBUG] Enabling LDAP auth backend %q", path)
-	err := client.Sys().EnableAuth(path, authType, desc)
+	err := enableAuthBackend(client, path, authType, desc)
 	if err != nil {
 		return fmt.Errorf("error enabling ldap auth backend %q: %s", path, err)
 	}
@@ -241,7 +244,7 @@ func ldapAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
 	path := d.Id()
-	auths, err := client.Sys().ListAuth()
+	auths, err := listAuthMounts(client)
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
@@ -251,6 +254,7 @@ func ldapAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("auth mount %s not present", path)
 	}
 
+	d.Set("path", path)
 	d.Set("description", authMount.Description)
 	d.Set("accessor", authMount.Accessor)
 
@@ -296,7 +300,7 @@ func ldapAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
 	path := d.Id()
 
 	log.Printf("[DEBUG] Deleting LDAP auth backend %q", path)
-	err := client.Sys().DisableAuth(path)
+	err := disableAuthBackend(client, path)
 	if err != nil {
 		return fmt.Errorf("error deleting ldap auth backend %q: %q", path, err)
 	}
@@ -31,6 +31,11 @@ func TestLDAPAuthBackendGroup_basic(t *testing.T) {
 				Config: testLDAPAuthBackendGroupConfig_basic(backend, groupname, policies),
 				Check:  testLDAPAuthBackendGroupCheck_attrs(backend, groupname),
 			},
+			{
+				ResourceName:      "vault_ldap_auth_backend_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
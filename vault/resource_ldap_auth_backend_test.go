@@ -25,6 +25,12 @@ func TestLDAPAuthBackend_basic(t *testing.T) {
 				Config: testLDAPAuthBackendConfig_basic(path),
 				Check:  testLDAPAuthBackendCheck_attrs(path),
 			},
+			{
+				ResourceName:            "vault_ldap_auth_backend.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bindpass"},
+			},
 		},
 	})
 }
@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,6 +11,11 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+var (
+	ldapAuthBackendUserBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/users/.+$")
+	ldapAuthBackendUserNameFromPathRegex    = regexp.MustCompile("^auth/.+/users/(.+)$")
+)
+
 func ldapAuthBackendUserResource() *schema.Resource {
 	return &schema.Resource{
 		SchemaVersion: 1,
@@ -19,6 +25,9 @@ func ldapAuthBackendUserResource() *schema.Resource {
 		Read:   ldapAuthBackendUserResourceRead,
 		Delete: ldapAuthBackendUserResourceDelete,
 		Exists: ldapAuthBackendUserResourceExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"username": {
@@ -93,6 +102,16 @@ func ldapAuthBackendUserResourceRead(d *schema.ResourceData, meta interface{}) e
 	client := meta.(*api.Client)
 	path := d.Id()
 
+	backend, err := ldapAuthBackendUserBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for LDAP auth backend user: %s", path, err)
+	}
+
+	name, err := ldapAuthBackendUserNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for LDAP auth backend user: %s", path, err)
+	}
+
 	log.Printf("[DEBUG] Reading LDAP user %q", path)
 	resp, err := client.Logical().Read(path)
 	if err != nil {
@@ -106,6 +125,8 @@ func ldapAuthBackendUserResourceRead(d *schema.ResourceData, meta interface{}) e
 		return nil
 	}
 
+	d.Set("backend", backend)
+	d.Set("username", name)
 	d.Set("policies",
 		schema.NewSet(
 			schema.HashString, resp.Data["policies"].([]interface{})))
@@ -147,3 +168,25 @@ func ldapAuthBackendUserResourceExists(d *schema.ResourceData, meta interface{})
 
 	return resp != nil, nil
 }
+
+func ldapAuthBackendUserNameFromPath(path string) (string, error) {
+	if !ldapAuthBackendUserNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no user found")
+	}
+	res := ldapAuthBackendUserNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for user", len(res))
+	}
+	return res[1], nil
+}
+
+func ldapAuthBackendUserBackendFromPath(path string) (string, error) {
+	if !ldapAuthBackendUserBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := ldapAuthBackendUserBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -39,6 +39,11 @@ func TestLDAPAuthBackendUser_basic(t *testing.T) {
 					testLDAPAuthBackendUserCheck_groups(backend, username, groups),
 				),
 			},
+			{
+				ResourceName:      "vault_ldap_auth_backend_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,393 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+// ldapSecretBackendConfigFields are the fields of the LDAP configuration.
+var ldapSecretBackendConfigFields = append([]string{
+	"binddn",
+	"bindpass",
+	"url",
+	"schema",
+	"userdn",
+	"userattr",
+	"upndomain",
+	"password_policy",
+	"certificate",
+	"client_tls_cert",
+	"client_tls_key",
+	"tls_min_version",
+	"tls_max_version",
+	"request_timeout",
+	"connection_timeout",
+	"starttls",
+	"insecure_tls",
+	"skip_static_role_import_rotation",
+	"rotate_root",
+}, rootRotationFields...)
+
+func ldapSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: ldapSecretBackendCreate,
+		Read:   ldapSecretBackendRead,
+		Update: ldapSecretBackendUpdate,
+		Delete: ldapSecretBackendDelete,
+		Exists: ldapSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: withRootRotationSchema(map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ldap",
+				Description:  "Path to mount the backend at.",
+				ValidateFunc: validateMountPath,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lease duration for secrets in seconds",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum possible lease duration for secrets in seconds",
+			},
+			"binddn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Distinguished name of the object to bind as when managing user passwords.",
+			},
+			"bindpass": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Password for the binddn.",
+				Sensitive:   true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "LDAP URL(s) to connect to, comma separated, e.g. ldaps://ldap.example.com.",
+			},
+			"schema": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "LDAP schema used when modifying user passwords. Must be one of \"openldap\", \"racf\" or \"ad\".",
+				ValidateFunc: validation.StringInSlice([]string{"openldap", "racf", "ad"}, false),
+			},
+			"userdn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Base DN under which to search for users.",
+			},
+			"userattr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Attribute used to look up users, e.g. cn or userPrincipalName.",
+			},
+			"upndomain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "userPrincipalName domain used to construct the UPN of the binddn.",
+			},
+			"password_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the password policy used to generate passwords.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CA certificate to use when verifying the LDAP server certificate, in PEM format.",
+			},
+			"client_tls_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Client certificate to present to the LDAP server, in PEM format.",
+			},
+			"client_tls_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key of the client certificate, in PEM format.",
+				Sensitive:   true,
+			},
+			"tls_min_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Minimum TLS version to use, e.g. tls12.",
+			},
+			"tls_max_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum TLS version to use, e.g. tls12.",
+			},
+			"request_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Timeout in seconds for requests to the LDAP server.",
+			},
+			"connection_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Timeout in seconds when connecting to the LDAP server, before trying the next URL.",
+			},
+			"starttls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Issue a StartTLS command after establishing an unencrypted connection.",
+			},
+			"insecure_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Skip LDAP server SSL certificate verification, insecure.",
+			},
+			"skip_static_role_import_rotation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Skip rotating the password of static roles when they are created. Requires Vault 1.16 or later.",
+			},
+			"rotate_root": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Rotate the bindpass after it is written, so that only Vault knows it.",
+			},
+		}),
+	}
+}
+
+func ldapSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	description := d.Get("description").(string)
+	defaultTTL := d.Get("default_lease_ttl_seconds").(int)
+	maxTTL := d.Get("max_lease_ttl_seconds").(int)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting LDAP backend at %q", path)
+	err := mountSecretBackend(client, path, &api.MountInput{
+		Type:        "ldap",
+		Description: description,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", defaultTTL),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", maxTTL),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted LDAP backend at %q", path)
+	d.SetId(path)
+
+	d.SetPartial("path")
+	d.SetPartial("description")
+	d.SetPartial("default_lease_ttl_seconds")
+	d.SetPartial("max_lease_ttl_seconds")
+
+	if err := ldapSecretBackendWriteConfig(client, path, d); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return ldapSecretBackendRead(d, meta)
+}
+
+func ldapSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading LDAP backend mount %q from Vault", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read LDAP backend mount %q from Vault", path)
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
+
+	configPath := ldapSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading LDAP configuration from %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading LDAP configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read LDAP configuration from %q", configPath)
+	if resp != nil {
+		// the bind password and client TLS key are never returned.
+		for _, k := range []string{
+			"binddn", "url", "schema", "userdn", "userattr", "upndomain",
+			"password_policy", "certificate", "client_tls_cert",
+			"tls_min_version", "tls_max_version", "request_timeout",
+			"connection_timeout", "starttls", "insecure_tls",
+			"skip_static_role_import_rotation",
+		} {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+		readRootRotationData(d, resp.Data)
+	}
+
+	return nil
+}
+
+func ldapSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	d.Partial(true)
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") || d.HasChange("description") {
+		config := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			config.Description = &description
+		}
+		log.Printf("[DEBUG] Tuning mount %q", path)
+		err := tuneMount(client, path, config)
+		if err != nil {
+			return fmt.Errorf("error tuning mount %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Tuned mount %q", path)
+		d.SetPartial("default_lease_ttl_seconds")
+		d.SetPartial("max_lease_ttl_seconds")
+		d.SetPartial("description")
+	}
+	err := writeBackendConfigOnChange(d, ldapSecretBackendConfigFields, func() error {
+		return ldapSecretBackendWriteConfig(client, path, d)
+	})
+	if err != nil {
+		return err
+	}
+	d.Partial(false)
+	return ldapSecretBackendRead(d, meta)
+}
+
+func ldapSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting LDAP backend %q", path)
+	err := unmountSecretBackend(client, path)
+	if err != nil {
+		return fmt.Errorf("error unmounting LDAP backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted LDAP backend %q", path)
+	return nil
+}
+
+func ldapSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if LDAP backend exists at %q", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if LDAP backend exists at %q", path)
+	_, ok := mounts[strings.Trim(path, "/")+"/"]
+	return ok, nil
+}
+
+func ldapSecretBackendWriteConfig(client *api.Client, path string, d *schema.ResourceData) error {
+	configPath := ldapSecretBackendConfigPath(path)
+
+	log.Printf("[DEBUG] Writing LDAP configuration to %q", configPath)
+	data := map[string]interface{}{}
+	for _, k := range []string{
+		"binddn", "bindpass", "url", "schema", "userdn", "userattr",
+		"upndomain", "password_policy", "certificate", "client_tls_cert",
+		"client_tls_key", "tls_min_version", "tls_max_version",
+	} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("request_timeout"); ok {
+		data["request_timeout"] = v.(int)
+	}
+	if v, ok := d.GetOk("connection_timeout"); ok {
+		data["connection_timeout"] = v.(int)
+	}
+	if v, ok := d.GetOkExists("starttls"); ok {
+		data["starttls"] = v.(bool)
+	}
+	if v, ok := d.GetOkExists("insecure_tls"); ok {
+		data["insecure_tls"] = v.(bool)
+	}
+	if v, ok := d.GetOkExists("skip_static_role_import_rotation"); ok {
+		data["skip_static_role_import_rotation"] = v.(bool)
+	}
+	if err := setRootRotationData(client, d, data); err != nil {
+		return err
+	}
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing LDAP configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote LDAP configuration to %q", configPath)
+	for _, k := range ldapSecretBackendConfigFields {
+		d.SetPartial(k)
+	}
+
+	if d.Get("rotate_root").(bool) && (d.HasChange("bindpass") || d.HasChange("rotate_root")) {
+		rotatePath := strings.Trim(path, "/") + "/rotate-root"
+		log.Printf("[DEBUG] Rotating root credentials at %q", rotatePath)
+		if _, err := client.Logical().Write(rotatePath, nil); err != nil {
+			return fmt.Errorf("error rotating root credentials for %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Rotated root credentials at %q", rotatePath)
+	}
+
+	return nil
+}
+
+func ldapSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
@@ -0,0 +1,202 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	ldapSecretBackendDynamicRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/role/.+$")
+	ldapSecretBackendDynamicRoleNameFromPathRegex    = regexp.MustCompile("^.+/role/(.+)$")
+)
+
+func ldapSecretBackendDynamicRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: ldapSecretBackendDynamicRoleWrite,
+		Read:   ldapSecretBackendDynamicRoleRead,
+		Update: ldapSecretBackendDynamicRoleWrite,
+		Delete: ldapSecretBackendDynamicRoleDelete,
+		Exists: ldapSecretBackendDynamicRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the LDAP Secret Backend the dynamic role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the dynamic role.",
+			},
+			"creation_ldif": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Templated LDIF run to create the LDAP entry of a new credential.",
+			},
+			"deletion_ldif": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Templated LDIF run to delete the LDAP entry of an expired or revoked credential.",
+			},
+			"rollback_ldif": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Templated LDIF run to roll back a failed creation_ldif, defaults to deletion_ldif.",
+			},
+			"username_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Template used to generate the username of new credentials.",
+			},
+			"default_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lease duration in seconds of generated credentials.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum lease duration in seconds of generated credentials.",
+			},
+		},
+	}
+}
+
+func ldapSecretBackendDynamicRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := ldapSecretBackendDynamicRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"creation_ldif":     d.Get("creation_ldif").(string),
+		"deletion_ldif":     d.Get("deletion_ldif").(string),
+		"rollback_ldif":     d.Get("rollback_ldif").(string),
+		"username_template": d.Get("username_template").(string),
+	}
+	if v, ok := d.GetOk("default_ttl"); ok {
+		data["default_ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("max_ttl"); ok {
+		data["max_ttl"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Writing LDAP secret backend dynamic role %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing LDAP secret backend dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote LDAP secret backend dynamic role %q", path)
+
+	d.SetId(path)
+	return ldapSecretBackendDynamicRoleRead(d, meta)
+}
+
+func ldapSecretBackendDynamicRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := ldapSecretBackendDynamicRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid dynamic role ID %q: %s", path, err)
+	}
+	name, err := ldapSecretBackendDynamicRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid dynamic role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading LDAP secret backend dynamic role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading LDAP secret backend dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read LDAP secret backend dynamic role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] LDAP secret backend dynamic role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	d.Set("creation_ldif", resp.Data["creation_ldif"])
+	d.Set("deletion_ldif", resp.Data["deletion_ldif"])
+	d.Set("rollback_ldif", resp.Data["rollback_ldif"])
+	d.Set("username_template", resp.Data["username_template"])
+	d.Set("default_ttl", resp.Data["default_ttl"])
+	d.Set("max_ttl", resp.Data["max_ttl"])
+
+	return nil
+}
+
+func ldapSecretBackendDynamicRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting LDAP secret backend dynamic role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting LDAP secret backend dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted LDAP secret backend dynamic role %q", path)
+	return nil
+}
+
+func ldapSecretBackendDynamicRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if LDAP secret backend dynamic role %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if LDAP secret backend dynamic role %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if LDAP secret backend dynamic role %q exists", path)
+	return resp != nil, nil
+}
+
+func ldapSecretBackendDynamicRolePath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/role/" + strings.Trim(name, "/")
+}
+
+func ldapSecretBackendDynamicRoleNameFromPath(path string) (string, error) {
+	if !ldapSecretBackendDynamicRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no dynamic role found")
+	}
+	res := ldapSecretBackendDynamicRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for dynamic role", len(res))
+	}
+	return res[1], nil
+}
+
+func ldapSecretBackendDynamicRoleBackendFromPath(path string) (string, error) {
+	if !ldapSecretBackendDynamicRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := ldapSecretBackendDynamicRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccLDAPSecretBackendDynamicRole_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckLDAPSecretBackendDynamicRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPSecretBackendDynamicRoleConfig_basic(backend, name, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_dynamic_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_dynamic_role.test", "username_template", "v_{{.RoleName}}_{{random 10}}"),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_dynamic_role.test", "default_ttl", "3600"),
+					resource.TestCheckResourceAttrSet("vault_ldap_secret_backend_dynamic_role.test", "creation_ldif"),
+					resource.TestCheckResourceAttrSet("vault_ldap_secret_backend_dynamic_role.test", "deletion_ldif"),
+				),
+			},
+			{
+				Config: testAccLDAPSecretBackendDynamicRoleConfig_basic(backend, name, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend_dynamic_role.test", "default_ttl", "7200"),
+				),
+			},
+			{
+				ResourceName:      "vault_ldap_secret_backend_dynamic_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLDAPSecretBackendDynamicRoleDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ldap_secret_backend_dynamic_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("LDAP secret backend dynamic role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccLDAPSecretBackendDynamicRoleConfig_basic(backend, name string, defaultTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path     = "%s"
+  url      = "ldaps://ldap.example.org"
+  binddn   = "cn=admin,dc=example,dc=org"
+  bindpass = "SuperSecretPassw0rd"
+}
+
+resource "vault_ldap_secret_backend_dynamic_role" "test" {
+  backend           = "${vault_ldap_secret_backend.test.path}"
+  name              = "%s"
+  username_template = "v_{{.RoleName}}_{{random 10}}"
+  default_ttl       = %d
+  creation_ldif     = <<EOT
+dn: cn={{.Username}},ou=users,dc=example,dc=org
+objectClass: person
+objectClass: top
+cn: {{.Username}}
+sn: {{.Username}}
+userPassword: {{.Password}}
+EOT
+  deletion_ldif     = <<EOT
+dn: cn={{.Username}},ou=users,dc=example,dc=org
+changetype: delete
+EOT
+}`, backend, name, defaultTTL)
+}
@@ -0,0 +1,196 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	ldapSecretBackendStaticRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/static-role/.+$")
+	ldapSecretBackendStaticRoleNameFromPathRegex    = regexp.MustCompile("^.+/static-role/(.+)$")
+)
+
+func ldapSecretBackendStaticRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: ldapSecretBackendStaticRoleWrite,
+		Read:   ldapSecretBackendStaticRoleRead,
+		Update: ldapSecretBackendStaticRoleWrite,
+		Delete: ldapSecretBackendStaticRoleDelete,
+		Exists: ldapSecretBackendStaticRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the LDAP Secret Backend the static role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the static role.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username of the existing LDAP entry whose password Vault manages.",
+			},
+			"dn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Distinguished name of the existing LDAP entry, used instead of searching for the username.",
+			},
+			"rotation_period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "How often in seconds Vault rotates the password of the LDAP entry, at least 5.",
+				ValidateFunc: validation.IntAtLeast(5),
+			},
+			"skip_import_rotation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Don't rotate the password when the static role is created. Requires Vault 1.16 or later.",
+			},
+		},
+	}
+}
+
+func ldapSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := ldapSecretBackendStaticRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"username":        d.Get("username").(string),
+		"rotation_period": d.Get("rotation_period").(int),
+	}
+	if v, ok := d.GetOk("dn"); ok {
+		data["dn"] = v.(string)
+	}
+	// the flag only matters when the role is created, and Vault rejects it
+	// on updates.
+	if d.IsNewResource() && d.Get("skip_import_rotation").(bool) {
+		data["skip_import_rotation"] = true
+	}
+
+	log.Printf("[DEBUG] Writing LDAP secret backend static role %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing LDAP secret backend static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote LDAP secret backend static role %q", path)
+
+	d.SetId(path)
+	return ldapSecretBackendStaticRoleRead(d, meta)
+}
+
+func ldapSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := ldapSecretBackendStaticRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid static role ID %q: %s", path, err)
+	}
+	name, err := ldapSecretBackendStaticRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid static role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading LDAP secret backend static role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading LDAP secret backend static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read LDAP secret backend static role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] LDAP secret backend static role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	d.Set("username", resp.Data["username"])
+	d.Set("dn", resp.Data["dn"])
+	d.Set("rotation_period", resp.Data["rotation_period"])
+	if v, ok := resp.Data["skip_import_rotation"]; ok {
+		d.Set("skip_import_rotation", v)
+	}
+
+	return nil
+}
+
+func ldapSecretBackendStaticRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting LDAP secret backend static role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting LDAP secret backend static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted LDAP secret backend static role %q", path)
+	return nil
+}
+
+func ldapSecretBackendStaticRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if LDAP secret backend static role %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if LDAP secret backend static role %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if LDAP secret backend static role %q exists", path)
+	return resp != nil, nil
+}
+
+func ldapSecretBackendStaticRolePath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/static-role/" + strings.Trim(name, "/")
+}
+
+func ldapSecretBackendStaticRoleNameFromPath(path string) (string, error) {
+	if !ldapSecretBackendStaticRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no static role found")
+	}
+	res := ldapSecretBackendStaticRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for static role", len(res))
+	}
+	return res[1], nil
+}
+
+func ldapSecretBackendStaticRoleBackendFromPath(path string) (string, error) {
+	if !ldapSecretBackendStaticRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := ldapSecretBackendStaticRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccLDAPSecretBackend_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-ldap")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccLDAPSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPSecretBackendConfig_initial(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend.test", "binddn", "cn=admin,dc=example,dc=org"),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend.test", "url", "ldaps://ldap.example.org"),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend.test", "userdn", "ou=users,dc=example,dc=org"),
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend.test", "schema", "openldap"),
+				),
+			},
+			{
+				Config: testAccLDAPSecretBackendConfig_updated(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_secret_backend.test", "request_timeout", "30"),
+				),
+			},
+			{
+				ResourceName:            "vault_ldap_secret_backend.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bindpass", "rotate_root"},
+			},
+		},
+	})
+}
+
+func testAccLDAPSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ldap_secret_backend" {
+			continue
+		}
+		for path, mount := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if mount.Type == "ldap" && path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccLDAPSecretBackendConfig_initial(path string) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path     = "%s"
+  binddn   = "cn=admin,dc=example,dc=org"
+  bindpass = "SuperSecretPassw0rd"
+  url      = "ldaps://ldap.example.org"
+  userdn   = "ou=users,dc=example,dc=org"
+}`, path)
+}
+
+func testAccLDAPSecretBackendConfig_updated(path string) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path            = "%s"
+  binddn          = "cn=admin,dc=example,dc=org"
+  bindpass        = "SuperSecretPassw0rd"
+  url             = "ldaps://ldap.example.org"
+  userdn          = "ou=users,dc=example,dc=org"
+  schema          = "openldap"
+  request_timeout = 30
+}`, path)
+}
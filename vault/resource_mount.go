@@ -21,10 +21,11 @@ func mountResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"path": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    false,
-				Description: "Where the secret backend will be mounted",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     false,
+				Description:  "Where the secret backend will be mounted",
+				ValidateFunc: validateAll(validateNoLeadingSlash, validateNoSpaces),
 			},
 
 			"type": {
@@ -38,7 +39,6 @@ func mountResource() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Required:    false,
-				ForceNew:    true,
 				Description: "Human-friendly description of the mount",
 			},
 
@@ -95,7 +95,7 @@ func mountWrite(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Creating mount %s in Vault", path)
 
-	if err := client.Sys().Mount(path, info); err != nil {
+	if err := mountSecretBackend(client, path, info); err != nil {
 		return fmt.Errorf("error writing to Vault: %s", err)
 	}
 
@@ -112,6 +112,10 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 		MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
 		Options:         opts(d),
 	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		config.Description = &description
+	}
 
 	path := d.Id()
 
@@ -120,7 +124,7 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		log.Printf("[DEBUG] Remount %s to %s in Vault", path, newPath)
 
-		err := client.Sys().Remount(d.Id(), newPath)
+		err := remountSecretBackend(client, d.Id(), newPath)
 		if err != nil {
 			return fmt.Errorf("error remounting in Vault: %s", err)
 		}
@@ -131,7 +135,7 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Updating mount %s in Vault", path)
 
-	if err := client.Sys().TuneMount(path, config); err != nil {
+	if err := tuneMount(client, path, config); err != nil {
 		return fmt.Errorf("error updating Vault: %s", err)
 	}
 
@@ -145,7 +149,7 @@ func mountDelete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Unmounting %s from Vault", path)
 
-	if err := client.Sys().Unmount(path); err != nil {
+	if err := unmountSecretBackend(client, path); err != nil {
 		return fmt.Errorf("error deleting from Vault: %s", err)
 	}
 
@@ -159,7 +163,7 @@ func mountRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Reading mount %s from Vault", path)
 
-	mounts, err := client.Sys().ListMounts()
+	mounts, err := listMounts(client)
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
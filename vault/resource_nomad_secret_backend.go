@@ -0,0 +1,316 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// nomadSecretBackendConfigFields are the fields of the Nomad configuration.
+var nomadSecretBackendConfigFields = []string{
+	"address",
+	"token",
+	"ca_cert",
+	"client_cert",
+	"client_key",
+	"max_token_name_length",
+	"ttl",
+	"max_ttl",
+}
+
+func nomadSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: nomadSecretBackendCreate,
+		Read:   nomadSecretBackendRead,
+		Update: nomadSecretBackendUpdate,
+		Delete: nomadSecretBackendDelete,
+		Exists: nomadSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "nomad",
+				Description:  "Path to mount the backend at.",
+				ValidateFunc: validateMountPath,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lease duration for secrets in seconds",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum possible lease duration for secrets in seconds",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Address of the Nomad server, e.g. https://127.0.0.1:4646.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Nomad management token Vault uses to create tokens.",
+				Sensitive:   true,
+			},
+			"ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CA certificate to use when verifying the Nomad server certificate, in PEM format.",
+			},
+			"client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Client certificate to present to Nomad, in PEM format.",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key of the client certificate, in PEM format.",
+				Sensitive:   true,
+			},
+			"max_token_name_length": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum length of the names of generated tokens.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lease TTL in seconds of generated tokens.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum lease TTL in seconds of generated tokens.",
+			},
+		},
+	}
+}
+
+func nomadSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	description := d.Get("description").(string)
+	defaultTTL := d.Get("default_lease_ttl_seconds").(int)
+	maxTTL := d.Get("max_lease_ttl_seconds").(int)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting Nomad backend at %q", path)
+	err := mountSecretBackend(client, path, &api.MountInput{
+		Type:        "nomad",
+		Description: description,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", defaultTTL),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", maxTTL),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted Nomad backend at %q", path)
+	d.SetId(path)
+
+	d.SetPartial("path")
+	d.SetPartial("description")
+	d.SetPartial("default_lease_ttl_seconds")
+	d.SetPartial("max_lease_ttl_seconds")
+
+	if err := nomadSecretBackendWriteConfig(client, path, d); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return nomadSecretBackendRead(d, meta)
+}
+
+func nomadSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading Nomad backend mount %q from Vault", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Nomad backend mount %q from Vault", path)
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
+
+	configPath := nomadSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading Nomad configuration from %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading Nomad configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Nomad configuration from %q", configPath)
+	if resp != nil {
+		// the token and client key are never returned.
+		for _, k := range []string{
+			"address", "ca_cert", "client_cert", "max_token_name_length",
+		} {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+	}
+
+	leasePath := nomadSecretBackendLeasePath(path)
+	log.Printf("[DEBUG] Reading Nomad lease configuration from %q", leasePath)
+	resp, err = client.Logical().Read(leasePath)
+	if err != nil {
+		return fmt.Errorf("error reading Nomad lease configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Nomad lease configuration from %q", leasePath)
+	if resp != nil {
+		d.Set("ttl", resp.Data["ttl"])
+		d.Set("max_ttl", resp.Data["max_ttl"])
+	}
+
+	return nil
+}
+
+func nomadSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	d.Partial(true)
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") || d.HasChange("description") {
+		config := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			config.Description = &description
+		}
+		log.Printf("[DEBUG] Tuning mount %q", path)
+		err := tuneMount(client, path, config)
+		if err != nil {
+			return fmt.Errorf("error tuning mount %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Tuned mount %q", path)
+		d.SetPartial("default_lease_ttl_seconds")
+		d.SetPartial("max_lease_ttl_seconds")
+		d.SetPartial("description")
+	}
+	err := writeBackendConfigOnChange(d, nomadSecretBackendConfigFields, func() error {
+		return nomadSecretBackendWriteConfig(client, path, d)
+	})
+	if err != nil {
+		return err
+	}
+	d.Partial(false)
+	return nomadSecretBackendRead(d, meta)
+}
+
+func nomadSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting Nomad backend %q", path)
+	err := unmountSecretBackend(client, path)
+	if err != nil {
+		return fmt.Errorf("error unmounting Nomad backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted Nomad backend %q", path)
+	return nil
+}
+
+func nomadSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if Nomad backend exists at %q", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if Nomad backend exists at %q", path)
+	_, ok := mounts[strings.Trim(path, "/")+"/"]
+	return ok, nil
+}
+
+func nomadSecretBackendWriteConfig(client *api.Client, path string, d *schema.ResourceData) error {
+	configPath := nomadSecretBackendConfigPath(path)
+
+	log.Printf("[DEBUG] Writing Nomad configuration to %q", configPath)
+	data := map[string]interface{}{}
+	for _, k := range []string{
+		"address", "token", "ca_cert", "client_cert", "client_key",
+	} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("max_token_name_length"); ok {
+		data["max_token_name_length"] = v.(int)
+	}
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing Nomad configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Nomad configuration to %q", configPath)
+
+	if d.HasChange("ttl") || d.HasChange("max_ttl") {
+		leasePath := nomadSecretBackendLeasePath(path)
+		log.Printf("[DEBUG] Writing Nomad lease configuration to %q", leasePath)
+		lease := map[string]interface{}{
+			"ttl":     d.Get("ttl").(int),
+			"max_ttl": d.Get("max_ttl").(int),
+		}
+		if _, err := client.Logical().Write(leasePath, lease); err != nil {
+			return fmt.Errorf("error writing Nomad lease configuration for %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Wrote Nomad lease configuration to %q", leasePath)
+	}
+	for _, k := range nomadSecretBackendConfigFields {
+		d.SetPartial(k)
+	}
+
+	return nil
+}
+
+func nomadSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/access"
+}
+
+func nomadSecretBackendLeasePath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/lease"
+}
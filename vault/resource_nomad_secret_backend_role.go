@@ -0,0 +1,191 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	nomadSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/role/.+$")
+	nomadSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/role/(.+)$")
+)
+
+func nomadSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: nomadSecretBackendRoleWrite,
+		Read:   nomadSecretBackendRoleRead,
+		Update: nomadSecretBackendRoleWrite,
+		Delete: nomadSecretBackendRoleDelete,
+		Exists: nomadSecretBackendRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Nomad Secret Backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the role.",
+			},
+			"policies": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Names of the Nomad ACL policies attached to generated client tokens.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"global": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Replicate generated tokens to all regions instead of keeping them in the region they were created in.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "client",
+				Description:  "Type of the generated tokens. Must be either \"client\" or \"management\".",
+				ValidateFunc: validation.StringInSlice([]string{"client", "management"}, false),
+			},
+		},
+	}
+}
+
+func nomadSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := nomadSecretBackendRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"policies": d.Get("policies").(*schema.Set).List(),
+		"global":   d.Get("global").(bool),
+		"type":     d.Get("type").(string),
+	}
+	if data["type"] == "client" && len(data["policies"].([]interface{})) == 0 {
+		return fmt.Errorf("policies must be set when type is \"client\"")
+	}
+
+	log.Printf("[DEBUG] Writing Nomad secret backend role %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing Nomad secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Nomad secret backend role %q", path)
+
+	d.SetId(path)
+	return nomadSecretBackendRoleRead(d, meta)
+}
+
+func nomadSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := nomadSecretBackendRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+	name, err := nomadSecretBackendRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading Nomad secret backend role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Nomad secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Nomad secret backend role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Nomad secret backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	policies := []string{}
+	if v, ok := resp.Data["policies"].([]interface{}); ok {
+		policies = jsonStringArrayToStringArray(v)
+	}
+	if err := d.Set("policies", policies); err != nil {
+		return fmt.Errorf("error setting policies in state: %s", err)
+	}
+	d.Set("global", resp.Data["global"])
+	d.Set("type", resp.Data["type"])
+
+	return nil
+}
+
+func nomadSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting Nomad secret backend role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting Nomad secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted Nomad secret backend role %q", path)
+	return nil
+}
+
+func nomadSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if Nomad secret backend role %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if Nomad secret backend role %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if Nomad secret backend role %q exists", path)
+	return resp != nil, nil
+}
+
+func nomadSecretBackendRolePath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/role/" + strings.Trim(name, "/")
+}
+
+func nomadSecretBackendRoleNameFromPath(path string) (string, error) {
+	if !nomadSecretBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := nomadSecretBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
+
+func nomadSecretBackendRoleBackendFromPath(path string) (string, error) {
+	if !nomadSecretBackendRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := nomadSecretBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,97 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccNomadSecretBackendRole_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-nomad")
+	name := acctest.RandomWithPrefix("tf-test")
+	address, token := "https://127.0.0.1:4646", "ae20ceaa-0f31-4b58-8147-3a6b4f7ae0d5"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckNomadSecretBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNomadSecretBackendRoleConfig_client(backend, address, token, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend_role.test", "type", "client"),
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend_role.test", "policies.#", "1"),
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend_role.test", "policies.1012845560", "readonly"),
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend_role.test", "global", "false"),
+				),
+			},
+			{
+				Config: testAccNomadSecretBackendRoleConfig_management(backend, address, token, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend_role.test", "type", "management"),
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend_role.test", "policies.#", "0"),
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend_role.test", "global", "true"),
+				),
+			},
+			{
+				ResourceName:      "vault_nomad_secret_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNomadSecretBackendRoleDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_nomad_secret_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("Nomad secret backend role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccNomadSecretBackendRoleConfig_client(backend, address, token, name string) string {
+	return fmt.Sprintf(`
+resource "vault_nomad_secret_backend" "test" {
+  path    = "%s"
+  address = "%s"
+  token   = "%s"
+}
+
+resource "vault_nomad_secret_backend_role" "test" {
+  backend  = "${vault_nomad_secret_backend.test.path}"
+  name     = "%s"
+  policies = ["readonly"]
+}`, backend, address, token, name)
+}
+
+func testAccNomadSecretBackendRoleConfig_management(backend, address, token, name string) string {
+	return fmt.Sprintf(`
+resource "vault_nomad_secret_backend" "test" {
+  path    = "%s"
+  address = "%s"
+  token   = "%s"
+}
+
+resource "vault_nomad_secret_backend_role" "test" {
+  backend = "${vault_nomad_secret_backend.test.path}"
+  name    = "%s"
+  type    = "management"
+  global  = true
+}`, backend, address, token, name)
+}
@@ -0,0 +1,93 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccNomadSecretBackend_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-nomad")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccNomadSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNomadSecretBackendConfig_initial(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend.test", "address", "https://127.0.0.1:4646"),
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend.test", "ttl", "3600"),
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend.test", "max_ttl", "7200"),
+				),
+			},
+			{
+				Config: testAccNomadSecretBackendConfig_updated(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend.test", "address", "https://nomad.example.com:4646"),
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend.test", "ttl", "1800"),
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend.test", "max_ttl", "3600"),
+					resource.TestCheckResourceAttr("vault_nomad_secret_backend.test", "max_token_name_length", "64"),
+				),
+			},
+			{
+				ResourceName:            "vault_nomad_secret_backend.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func testAccNomadSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_nomad_secret_backend" {
+			continue
+		}
+		for path, mount := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if mount.Type == "nomad" && path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccNomadSecretBackendConfig_initial(path string) string {
+	return fmt.Sprintf(`
+resource "vault_nomad_secret_backend" "test" {
+  path    = "%s"
+  address = "https://127.0.0.1:4646"
+  token   = "ae20ceaa-0f31-4b58-8147-3a6b4f7ae0d5"
+  ttl     = 3600
+  max_ttl = 7200
+}`, path)
+}
+
+func testAccNomadSecretBackendConfig_updated(path string) string {
+	return fmt.Sprintf(`
+resource "vault_nomad_secret_backend" "test" {
+  path                  = "%s"
+  address               = "https://nomad.example.com:4646"
+  token                 = "ae20ceaa-0f31-4b58-8147-3a6b4f7ae0d5"
+  ttl                   = 1800
+  max_ttl               = 3600
+  max_token_name_length = 64
+}`, path)
+}
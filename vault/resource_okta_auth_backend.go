@@ -19,28 +19,24 @@ func oktaAuthBackendResource() *schema.Resource {
 		Delete: oktaAuthBackendDelete,
 		Read:   oktaAuthBackendRead,
 		Update: oktaAuthBackendUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 
 			"path": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "path to mount the backend",
-				Default:     oktaAuthType,
-				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
-					value := v.(string)
-					if strings.HasSuffix(value, "/") {
-						errs = append(errs, errors.New("cannot write to a path ending in '/'"))
-					}
-					return
-				},
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "path to mount the backend",
+				Default:      oktaAuthType,
+				ValidateFunc: validateMountPath,
 			},
 
 			"description": {
 				Type:        schema.TypeString,
 				Required:    false,
-				ForceNew:    true,
 				Optional:    true,
 				Description: "The description of the auth backend",
 			},
@@ -75,17 +71,19 @@ func oktaAuthBackendResource() *schema.Resource {
 			},
 
 			"ttl": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    true,
-				Description: "Duration after which authentication will be expired",
+				Type:         schema.TypeString,
+				Required:     false,
+				Optional:     true,
+				Description:  "Duration after which authentication will be expired",
+				ValidateFunc: validateDuration,
 			},
 
 			"max_ttl": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    true,
-				Description: "Maximum duration after which authentication will be expired",
+				Type:         schema.TypeString,
+				Required:     false,
+				Optional:     true,
+				Description:  "Maximum duration after which authentication will be expired",
+				ValidateFunc: validateDuration,
 			},
 
 			"group": {
@@ -204,7 +202,7 @@ func oktaAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Writing auth %s to Vault", authType)
 
-	err := client.Sys().EnableAuth(path, authType, desc)
+	err := enableAuthBackend(client, path, authType, desc)
 
 	if err != nil {
 		return fmt.Errorf("error writing to Vault: %s", err)
@@ -222,7 +220,7 @@ func oktaAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Deleting auth %s from Vault", path)
 
-	err := client.Sys().DisableAuth(path)
+	err := disableAuthBackend(client, path)
 
 	if err != nil {
 		return fmt.Errorf("error disabling auth from Vault: %s", err)
@@ -237,18 +235,33 @@ func oktaAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	path := d.Id()
 	log.Printf("[DEBUG] Reading auth %s from Vault", path)
 
-	present, err := isOktaAuthBackendPresent(client, path)
+	mount, err := readOktaAuthBackend(client, path)
 
 	if err != nil {
 		return fmt.Errorf("unable to check auth backends in Vault for path %s: %s", path, err)
 	}
 
-	if !present {
+	if mount == nil {
 		// If we fell out here then we didn't find our Auth in the list.
 		d.SetId("")
 		return nil
 	}
 
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+
+	log.Printf("[DEBUG] Reading configuration for mount %s from Vault", path)
+	config, err := client.Logical().Read(oktaConfigEndpoint(path))
+	if err != nil {
+		return fmt.Errorf("error reading configuration from Vault for path %s: %s", path, err)
+	}
+	if config != nil {
+		// the token can't be read back
+		d.Set("organization", config.Data["organization"])
+		d.Set("base_url", config.Data["base_url"])
+		d.Set("bypass_okta_mfa", config.Data["bypass_okta_mfa"])
+	}
+
 	log.Printf("[DEBUG] Reading groups for mount %s from Vault", path)
 	groups, err := oktaReadAllGroups(client, path)
 	if err != nil {
@@ -277,6 +290,13 @@ func oktaAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 	path := d.Id()
 	log.Printf("[DEBUG] Updating auth %s in Vault", path)
 
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		if err := tuneAuthBackend(client, path, api.MountConfigInput{Description: &description}); err != nil {
+			return fmt.Errorf("error updating description of auth %s in Vault: %s", path, err)
+		}
+	}
+
 	configuration := map[string]interface{}{
 		"base_url":        d.Get("base_url"),
 		"bypass_okta_mfa": d.Get("bypass_okta_mfa"),
@@ -16,6 +16,9 @@ func oktaAuthBackendGroupResource() *schema.Resource {
 		Read:   oktaAuthBackendGroupRead,
 		Update: oktaAuthBackendGroupWrite,
 		Delete: oktaAuthBackendGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: oktaAuthBackendGroupImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"path": {
@@ -137,3 +140,13 @@ func oktaAuthBackendGroupDelete(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+func oktaAuthBackendGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	i := strings.LastIndex(d.Id(), "/")
+	if i <= 0 || i == len(d.Id())-1 {
+		return nil, fmt.Errorf("invalid ID %q for Okta auth backend group, expected <path>/<group_name>", d.Id())
+	}
+	d.Set("path", d.Id()[:i])
+	d.Set("group_name", d.Id()[i+1:])
+	return []*schema.ResourceData{d}, nil
+}
@@ -26,6 +26,11 @@ func TestOktaAuthBackendGroup(t *testing.T) {
 					testOktaAuthBackend_GroupsCheck(path, "foo", []string{"one", "two", "default"}),
 				),
 			},
+			{
+				ResourceName:      "vault_okta_auth_backend_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -36,6 +36,12 @@ func TestOktaAuthBackend(t *testing.T) {
 					testOktaAuthBackend_UsersCheck(path, "bar", []string{"example"}, []string{}),
 				),
 			},
+			{
+				ResourceName:            "vault_okta_auth_backend.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token", "ttl", "max_ttl"},
+			},
 		},
 	})
 }
@@ -15,6 +15,9 @@ func oktaAuthBackendUserResource() *schema.Resource {
 		Read:   oktaAuthBackendUserRead,
 		Update: oktaAuthBackendUserWrite,
 		Delete: oktaAuthBackendUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: oktaAuthBackendUserImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"path": {
@@ -155,3 +158,13 @@ func oktaAuthBackendUserDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func oktaAuthBackendUserImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	i := strings.LastIndex(d.Id(), "/")
+	if i <= 0 || i == len(d.Id())-1 {
+		return nil, fmt.Errorf("invalid ID %q for Okta auth backend user, expected <path>/<username>", d.Id())
+	}
+	d.Set("path", d.Id()[:i])
+	d.Set("username", d.Id()[i+1:])
+	return []*schema.ResourceData{d}, nil
+}
@@ -26,6 +26,11 @@ func TestOktaAuthBackendUser(t *testing.T) {
 					testOktaAuthBackend_UsersCheck(path, "user_test", []string{"one", "two"}, []string{"three"}),
 				),
 			},
+			{
+				ResourceName:      "vault_okta_auth_backend_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
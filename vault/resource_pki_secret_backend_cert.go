@@ -0,0 +1,330 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendCertResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendCertCreate,
+		Read:   pkiSecretBackendCertRead,
+		Update: pkiSecretBackendCertUpdate,
+		Delete: pkiSecretBackendCertDelete,
+
+		Schema: withRevokeLeaseSchema(map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role to create the certificate against.",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CN of the certificate to create.",
+			},
+			"alt_names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of alternative names.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ip_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of alternative IPs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"uri_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of alternative URIs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"other_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of other SANs, in the format <oid>;<type>:<value>.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"user_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of values for the UserID attribute of the subject.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Time to live.",
+				ValidateFunc: validateDuration,
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "pem",
+				Description:  "The format of data. Must be one of \"pem\", \"der\" or \"pem_bundle\".",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "der", "pem_bundle"}, false),
+			},
+			"private_key_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "der",
+				Description:  "The private key format. Must be either \"der\" or \"pkcs8\".",
+				ValidateFunc: validation.StringInSlice([]string{"der", "pkcs8"}, false),
+			},
+			"exclude_cn_from_sans": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Flag to exclude CN from SANs.",
+			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The issuer to issue the certificate with, instead of the role's. Requires Vault 1.11 or later.",
+			},
+			"auto_renew": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If enabled, a new certificate will be generated if the expiration is within min_seconds_remaining.",
+			},
+			"min_seconds_remaining": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     604800,
+				Description: "Generate a new certificate when the expiration is within this number of seconds.",
+			},
+			"revoke": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Revoke the certificate upon resource destruction. A certificate replaced by auto_renew is not revoked.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The certificate.",
+			},
+			"issuing_ca": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The issuing CA.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The CA chain.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key.",
+			},
+			"private_key_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The private key type.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The serial number.",
+			},
+			"expiration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The certificate expiration as a Unix timestamp.",
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the lease of the certificate, if the role generates leases.",
+			},
+		}),
+	}
+}
+
+func pkiSecretBackendCertCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	if _, ok := d.GetOk("issuer_ref"); ok {
+		if err := checkVaultVersion(client, "1.11.0", "issuer_ref"); err != nil {
+			return err
+		}
+	}
+
+	path := pkiSecretBackendIssuePath(backend, name, d.Get("issuer_ref").(string))
+
+	data := map[string]interface{}{
+		"common_name":          d.Get("common_name").(string),
+		"alt_names":            strings.Join(toStringArray(d.Get("alt_names").([]interface{})), ","),
+		"ip_sans":              strings.Join(toStringArray(d.Get("ip_sans").([]interface{})), ","),
+		"uri_sans":             strings.Join(toStringArray(d.Get("uri_sans").([]interface{})), ","),
+		"other_sans":           strings.Join(toStringArray(d.Get("other_sans").([]interface{})), ","),
+		"ttl":                  d.Get("ttl").(string),
+		"format":               d.Get("format").(string),
+		"private_key_format":   d.Get("private_key_format").(string),
+		"exclude_cn_from_sans": d.Get("exclude_cn_from_sans").(bool),
+	}
+	if v, ok := d.GetOk("user_ids"); ok {
+		data["user_ids"] = strings.Join(toStringArray(v.([]interface{})), ",")
+	}
+
+	log.Printf("[DEBUG] Creating certificate %q by %q on PKI secret backend %q", d.Get("common_name").(string), name, backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error creating certificate %q by %q for PKI secret backend %q: %s", d.Get("common_name").(string), name, backend, err)
+	}
+	log.Printf("[DEBUG] Created certificate %q by %q on PKI secret backend %q", d.Get("common_name").(string), name, backend)
+
+	d.Set("private_key", resp.Data["private_key"])
+	d.Set("private_key_type", resp.Data["private_key_type"])
+	if err := pkiSecretBackendSetCertData(d, resp); err != nil {
+		return err
+	}
+
+	// the renewal check in read is deliberately skipped here, a fresh
+	// certificate must never be dropped from state straight away.
+	d.SetId(fmt.Sprintf("%s/%s", backend, resp.Data["serial_number"]))
+	return nil
+}
+
+func pkiSecretBackendCertRead(d *schema.ResourceData, meta interface{}) error {
+	// the issued certificate and its key can't be read back from the API,
+	// all we can do is check whether it is due for renewal. It is not
+	// revoked, even if revoke is set: this runs on every refresh, plans
+	// included, and the certificate stays in use until it is replaced.
+	if pkiSecretBackendCertNeedsRenewal(d) {
+		log.Printf("[WARN] Certificate %q is due for renewal, removing from state without revoking it", d.Id())
+		d.SetId("")
+	}
+	return nil
+}
+
+func pkiSecretBackendCertUpdate(d *schema.ResourceData, meta interface{}) error {
+	// only the renewal and revocation settings can be updated, they
+	// don't need to be sent to Vault.
+	return pkiSecretBackendCertRead(d, meta)
+}
+
+func pkiSecretBackendCertDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	// revoking the lease revokes the certificate too
+	if revoked, err := revokeLease(d, client); err != nil || revoked {
+		return err
+	}
+
+	if !d.Get("revoke").(bool) {
+		return nil
+	}
+
+	backend := d.Get("backend").(string)
+	serial := d.Get("serial_number").(string)
+
+	return pkiSecretBackendRevokeCert(client, backend, serial)
+}
+
+// pkiSecretBackendSetCertData stores the certificate details returned when
+// issuing or signing a certificate.
+func pkiSecretBackendSetCertData(d *schema.ResourceData, resp *api.Secret) error {
+	d.Set("certificate", resp.Data["certificate"])
+	d.Set("issuing_ca", resp.Data["issuing_ca"])
+	d.Set("serial_number", resp.Data["serial_number"])
+	d.Set("lease_id", resp.LeaseID)
+
+	caChain := []string{}
+	if v, ok := resp.Data["ca_chain"].([]interface{}); ok {
+		caChain = jsonStringArrayToStringArray(v)
+	}
+	if err := d.Set("ca_chain", caChain); err != nil {
+		return fmt.Errorf("error setting ca_chain in state: %s", err)
+	}
+
+	var expiration int64
+	switch v := resp.Data["expiration"].(type) {
+	case json.Number:
+		i, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("error parsing certificate expiration %q: %s", v, err)
+		}
+		expiration = i
+	case float64:
+		expiration = int64(v)
+	}
+	d.Set("expiration", int(expiration))
+
+	return nil
+}
+
+// pkiSecretBackendCertNeedsRenewal reports whether an auto-renewed
+// certificate has less than min_seconds_remaining left before it expires.
+func pkiSecretBackendCertNeedsRenewal(d *schema.ResourceData) bool {
+	if !d.Get("auto_renew").(bool) {
+		return false
+	}
+	expiration := int64(d.Get("expiration").(int))
+	if expiration == 0 {
+		return false
+	}
+	minSecondsRemaining := int64(d.Get("min_seconds_remaining").(int))
+	return time.Now().Unix()+minSecondsRemaining >= expiration
+}
+
+func pkiSecretBackendIssuePath(backend string, name string, issuerRef string) string {
+	if issuerRef != "" {
+		return strings.Trim(backend, "/") + "/issuer/" + strings.Trim(issuerRef, "/") + "/issue/" + strings.Trim(name, "/")
+	}
+	return strings.Trim(backend, "/") + "/issue/" + strings.Trim(name, "/")
+}
@@ -0,0 +1,115 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccPkiSecretBackendCert_basic(t *testing.T) {
+	rootPath := "pki-root-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPkiSecretBackendRootMount(t, rootPath)
+		},
+		CheckDestroy: testAccPkiSecretBackendRootUnmount(rootPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendCertConfig_basic(rootPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "backend", rootPath),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "common_name", "cert.test.my.domain"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_cert.test", "certificate"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_cert.test", "private_key"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_cert.test", "issuing_ca"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_cert.test", "serial_number"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_cert.test", "expiration"),
+				),
+			},
+		},
+	})
+}
+
+func TestPkiSecretBackendCertNeedsRenewal(t *testing.T) {
+	now := time.Now().Unix()
+
+	tests := []struct {
+		name       string
+		autoRenew  bool
+		expiration int64
+		expected   bool
+	}{
+		{
+			name:       "disabled",
+			autoRenew:  false,
+			expiration: now + 60,
+			expected:   false,
+		},
+		{
+			name:       "not yet",
+			autoRenew:  true,
+			expiration: now + 7200,
+			expected:   false,
+		},
+		{
+			name:       "within window",
+			autoRenew:  true,
+			expiration: now + 60,
+			expected:   true,
+		},
+		{
+			name:       "expired",
+			autoRenew:  true,
+			expiration: now - 60,
+			expected:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, pkiSecretBackendCertResource().Schema, map[string]interface{}{
+				"backend":               "pki",
+				"name":                  "test",
+				"common_name":           "test.my.domain",
+				"auto_renew":            tc.autoRenew,
+				"min_seconds_remaining": 3600,
+			})
+			d.Set("expiration", int(tc.expiration))
+
+			if actual := pkiSecretBackendCertNeedsRenewal(d); actual != tc.expected {
+				t.Errorf("expected renewal to be %t, got %t", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func testPkiSecretBackendCertConfig_basic(rootPath string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = "%s"
+  name             = "test"
+  allowed_domains  = ["test.my.domain"]
+  allow_subdomains = true
+  max_ttl          = "3600"
+  key_usage        = ["DigitalSignature", "KeyAgreement", "KeyEncipherment"]
+}
+
+resource "vault_pki_secret_backend_cert" "test" {
+  depends_on = ["vault_pki_secret_backend_role.test"]
+
+  backend               = "%s"
+  name                  = "${vault_pki_secret_backend_role.test.name}"
+  common_name           = "cert.test.my.domain"
+  ttl                   = "3600"
+  auto_renew            = true
+  min_seconds_remaining = 60
+  revoke                = true
+}`, rootPath, rootPath)
+}
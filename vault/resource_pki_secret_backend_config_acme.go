@@ -0,0 +1,171 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigAcmeResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigAcmeWrite,
+		Read:   pkiSecretBackendConfigAcmeRead,
+		Update: pkiSecretBackendConfigAcmeWrite,
+		Delete: pkiSecretBackendConfigAcmeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Specifies whether ACME is enabled.",
+			},
+			"allowed_issuers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies which issuers are allowed for use with ACME.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allowed_roles": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies which roles are allowed for use with ACME.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"default_directory_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the policy to be used for non-role-qualified ACME requests.",
+			},
+			"eab_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the policy to use for external account binding. Must be one of \"not-required\", \"new-account-required\" or \"always-required\".",
+				ValidateFunc: validation.StringInSlice([]string{"not-required", "new-account-required", "always-required"}, false),
+			},
+			"dns_resolver": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DNS resolver to use for domain resolution on this mount, in the format <host>:<port>.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigAcmeWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	path := pkiSecretBackendConfigAcmePath(backend)
+
+	data := map[string]interface{}{
+		"enabled":      d.Get("enabled").(bool),
+		"dns_resolver": d.Get("dns_resolver").(string),
+	}
+	for _, k := range []string{"allowed_issuers", "allowed_roles"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = toStringArray(v.([]interface{}))
+		}
+	}
+	for _, k := range []string{"default_directory_policy", "eab_policy"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+
+	log.Printf("[DEBUG] Writing ACME config on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing ACME config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote ACME config on PKI secret backend %q", backend)
+
+	d.SetId(backend)
+	return pkiSecretBackendConfigAcmeRead(d, meta)
+}
+
+func pkiSecretBackendConfigAcmeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := pkiSecretBackendConfigAcmePath(backend)
+
+	log.Printf("[DEBUG] Reading ACME config from PKI secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading ACME config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read ACME config from PKI secret backend %q", backend)
+	if resp == nil {
+		log.Printf("[WARN] ACME config not found on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	for _, k := range []string{"enabled", "default_directory_policy", "eab_policy", "dns_resolver"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+	for _, k := range []string{"allowed_issuers", "allowed_roles"} {
+		var values []string
+		if v, ok := resp.Data[k].([]interface{}); ok {
+			values = jsonStringArrayToStringArray(v)
+		}
+		if err := d.Set(k, values); err != nil {
+			return fmt.Errorf("error setting %s in state: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigAcmeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := pkiSecretBackendConfigAcmePath(backend)
+
+	log.Printf("[DEBUG] Disabling ACME on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"enabled": false,
+	})
+	if err != nil {
+		return fmt.Errorf("error disabling ACME on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Disabled ACME on PKI secret backend %q", backend)
+
+	return nil
+}
+
+func pkiSecretBackendConfigAcmePath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/acme"
+}
@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendConfigAcme_basic(t *testing.T) {
+	rootPath := "pki-root-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPkiSecretBackendRootMount(t, rootPath)
+		},
+		CheckDestroy: testAccPkiSecretBackendRootUnmount(rootPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigAcmeConfig_basic(rootPath, true, "not-required"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_acme.test", "backend", rootPath),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_acme.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_acme.test", "eab_policy", "not-required"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_acme.test", "allowed_issuers.#", "1"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_acme.test", "allowed_issuers.0", "*"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigAcmeConfig_basic(rootPath, false, "always-required"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_acme.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_acme.test", "eab_policy", "always-required"),
+				),
+			},
+			{
+				ResourceName:      "vault_pki_secret_backend_config_acme.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigAcmeConfig_basic(rootPath string, enabled bool, eabPolicy string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend_config_acme" "test" {
+  backend         = "%s"
+  enabled         = %t
+  allowed_issuers = ["*"]
+  eab_policy      = "%s"
+}`, rootPath, enabled, eabPolicy)
+}
@@ -0,0 +1,202 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// pkiSecretBackendConfigAutoTidyBoolFields maps the flags selecting what
+// gets tidied to their descriptions.
+var pkiSecretBackendConfigAutoTidyBoolFields = map[string]string{
+	"tidy_cert_store":                          "Set to true to enable tidying up the certificate store.",
+	"tidy_revoked_certs":                       "Set to true to remove all invalid and expired certificates from storage.",
+	"tidy_revoked_cert_issuer_associations":    "Set to true to validate issuer associations on revocation entries.",
+	"tidy_expired_issuers":                     "Set to true to automatically remove expired issuers past the issuer_safety_buffer.",
+	"tidy_move_legacy_ca_bundle":               "Set to true to move the legacy ca_bundle from /config/ca_bundle to /config/ca_bundle.bak.",
+	"tidy_revocation_queue":                    "Set to true to remove stale revocation queue entries that haven't been confirmed by any active cluster.",
+	"tidy_cross_cluster_revoked_certs":         "Set to true to enable tidying up the cross-cluster revoked certificate store.",
+	"tidy_acme":                                "Set to true to tidy up ACME accounts, orders and authorizations.",
+	"maintain_stored_certificate_counts":       "Set to true to keep track of the number of stored and revoked certificates.",
+	"publish_stored_certificate_count_metrics": "Set to true to publish the stored certificate counts as metrics.",
+}
+
+// pkiSecretBackendConfigAutoTidyDurationFields are all read back from Vault
+// as a number of seconds.
+var pkiSecretBackendConfigAutoTidyDurationFields = []string{
+	"interval_duration",
+	"safety_buffer",
+	"issuer_safety_buffer",
+	"revocation_queue_safety_buffer",
+	"acme_account_safety_buffer",
+}
+
+func pkiSecretBackendConfigAutoTidyResource() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The PKI secret backend the resource belongs to.",
+			// standardise on no beginning or trailing slashes
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Required:    true,
+			Description: "Specifies whether automatic tidy is enabled.",
+		},
+		"interval_duration": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Interval, in seconds, at which to run an auto-tidy operation.",
+		},
+		"safety_buffer": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The amount of extra time, in seconds, that must have passed beyond certificate expiration before it is removed from the backend storage and the CRL.",
+		},
+		"issuer_safety_buffer": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The amount of extra time, in seconds, that must have passed beyond issuer's expiration before it is removed from the backend storage.",
+		},
+		"revocation_queue_safety_buffer": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The amount of time, in seconds, that must pass from the cross-cluster revocation request being initiated to when it will be slated for removal.",
+		},
+		"acme_account_safety_buffer": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The amount of time, in seconds, that must pass after the last use of an ACME account before it is revoked.",
+		},
+		"pause_duration": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The amount of time to wait between processing certificates.",
+		},
+	}
+	for k, description := range pkiSecretBackendConfigAutoTidyBoolFields {
+		s[k] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: description,
+		}
+	}
+
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigAutoTidyWrite,
+		Read:   pkiSecretBackendConfigAutoTidyRead,
+		Update: pkiSecretBackendConfigAutoTidyWrite,
+		Delete: pkiSecretBackendConfigAutoTidyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+func pkiSecretBackendConfigAutoTidyWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	path := pkiSecretBackendConfigAutoTidyPath(backend)
+
+	data := map[string]interface{}{
+		"enabled": d.Get("enabled").(bool),
+	}
+	for k := range pkiSecretBackendConfigAutoTidyBoolFields {
+		data[k] = d.Get(k).(bool)
+	}
+	for _, k := range pkiSecretBackendConfigAutoTidyDurationFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(int)
+		}
+	}
+	if v, ok := d.GetOk("pause_duration"); ok {
+		data["pause_duration"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Writing auto-tidy config on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing auto-tidy config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote auto-tidy config on PKI secret backend %q", backend)
+
+	d.SetId(backend)
+	return pkiSecretBackendConfigAutoTidyRead(d, meta)
+}
+
+func pkiSecretBackendConfigAutoTidyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := pkiSecretBackendConfigAutoTidyPath(backend)
+
+	log.Printf("[DEBUG] Reading auto-tidy config from PKI secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading auto-tidy config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read auto-tidy config from PKI secret backend %q", backend)
+	if resp == nil {
+		log.Printf("[WARN] Auto-tidy config not found on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	// older versions of Vault don't return the fields they don't support.
+	for k := range pkiSecretBackendConfigAutoTidyBoolFields {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+	for _, k := range append(pkiSecretBackendConfigAutoTidyDurationFields, "enabled", "pause_duration") {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigAutoTidyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := pkiSecretBackendConfigAutoTidyPath(backend)
+
+	log.Printf("[DEBUG] Disabling auto-tidy on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"enabled": false,
+	})
+	if err != nil {
+		return fmt.Errorf("error disabling auto-tidy on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Disabled auto-tidy on PKI secret backend %q", backend)
+
+	return nil
+}
+
+func pkiSecretBackendConfigAutoTidyPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/auto-tidy"
+}
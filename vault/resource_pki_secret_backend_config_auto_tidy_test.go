@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendConfigAutoTidy_basic(t *testing.T) {
+	rootPath := "pki-root-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPkiSecretBackendRootMount(t, rootPath)
+		},
+		CheckDestroy: testAccPkiSecretBackendRootUnmount(rootPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigAutoTidyConfig_basic(rootPath, 43200, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_auto_tidy.test", "backend", rootPath),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_auto_tidy.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_auto_tidy.test", "interval_duration", "43200"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_auto_tidy.test", "safety_buffer", "259200"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_auto_tidy.test", "tidy_cert_store", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_auto_tidy.test", "tidy_revoked_certs", "false"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigAutoTidyConfig_basic(rootPath, 86400, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_auto_tidy.test", "interval_duration", "86400"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_auto_tidy.test", "tidy_revoked_certs", "true"),
+				),
+			},
+			{
+				ResourceName:      "vault_pki_secret_backend_config_auto_tidy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigAutoTidyConfig_basic(rootPath string, interval int, tidyRevoked bool) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend_config_auto_tidy" "test" {
+  backend            = "%s"
+  enabled            = true
+  interval_duration  = %d
+  safety_buffer      = 259200
+  tidy_cert_store    = true
+  tidy_revoked_certs = %t
+}`, rootPath, interval, tidyRevoked)
+}
@@ -0,0 +1,118 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigCAResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigCACreate,
+		Read:   pkiSecretBackendConfigCARead,
+		Delete: pkiSecretBackendConfigCADelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"pem_bundle": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The key and certificate concatenated in PEM format.",
+			},
+			"imported_issuers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the issuers imported from the bundle. Only returned by Vault 1.11 or later.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"imported_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the keys imported from the bundle. Only returned by Vault 1.11 or later.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigCACreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	path := pkiSecretBackendConfigCAPath(backend)
+
+	data := map[string]interface{}{
+		"pem_bundle": d.Get("pem_bundle").(string),
+	}
+
+	log.Printf("[DEBUG] Importing CA bundle on PKI secret backend %q", backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error importing CA bundle on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Imported CA bundle on PKI secret backend %q", backend)
+
+	if err := pkiSecretBackendSetImportedData(d, resp); err != nil {
+		return err
+	}
+
+	d.SetId(backend)
+	return pkiSecretBackendConfigCARead(d, meta)
+}
+
+func pkiSecretBackendConfigCARead(d *schema.ResourceData, meta interface{}) error {
+	// the private key can never be read back, so the bundle is only ever
+	// known from the configuration.
+	return nil
+}
+
+func pkiSecretBackendConfigCADelete(d *schema.ResourceData, meta interface{}) error {
+	// the imported CA is left in place, it may already have been used
+	// to issue certificates.
+	return nil
+}
+
+// pkiSecretBackendSetImportedData stores which issuers and keys a CA bundle
+// was imported as. Older versions of Vault return no content at all.
+func pkiSecretBackendSetImportedData(d *schema.ResourceData, resp *api.Secret) error {
+	importedIssuers := []string{}
+	importedKeys := []string{}
+	if resp != nil {
+		if v, ok := resp.Data["imported_issuers"].([]interface{}); ok {
+			importedIssuers = jsonStringArrayToStringArray(v)
+		}
+		if v, ok := resp.Data["imported_keys"].([]interface{}); ok {
+			importedKeys = jsonStringArrayToStringArray(v)
+		}
+	}
+	if err := d.Set("imported_issuers", importedIssuers); err != nil {
+		return fmt.Errorf("error setting imported_issuers in state: %s", err)
+	}
+	if err := d.Set("imported_keys", importedKeys); err != nil {
+		return fmt.Errorf("error setting imported_keys in state: %s", err)
+	}
+	return nil
+}
+
+func pkiSecretBackendConfigCAPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/ca"
+}
@@ -0,0 +1,121 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigClusterResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigClusterWrite,
+		Read:   pkiSecretBackendConfigClusterRead,
+		Update: pkiSecretBackendConfigClusterWrite,
+		Delete: pkiSecretBackendConfigClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the path to this performance replication cluster's API mount path, e.g. https://pr1.vault.example.com:8200/v1/pki.",
+			},
+			"aia_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the path to this performance replication cluster's AIA distribution point, e.g. http://pr1.vault.example.com/v1/pki.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigClusterWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	path := pkiSecretBackendConfigClusterPath(backend)
+
+	data := map[string]interface{}{
+		"path":     d.Get("path").(string),
+		"aia_path": d.Get("aia_path").(string),
+	}
+
+	log.Printf("[DEBUG] Writing cluster config on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing cluster config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote cluster config on PKI secret backend %q", backend)
+
+	d.SetId(backend)
+	return pkiSecretBackendConfigClusterRead(d, meta)
+}
+
+func pkiSecretBackendConfigClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := pkiSecretBackendConfigClusterPath(backend)
+
+	log.Printf("[DEBUG] Reading cluster config from PKI secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading cluster config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read cluster config from PKI secret backend %q", backend)
+	if resp == nil {
+		log.Printf("[WARN] Cluster config not found on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("path", resp.Data["path"])
+	d.Set("aia_path", resp.Data["aia_path"])
+
+	return nil
+}
+
+func pkiSecretBackendConfigClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := pkiSecretBackendConfigClusterPath(backend)
+
+	// the cluster config can't be deleted, so reset it instead.
+	data := map[string]interface{}{
+		"path":     "",
+		"aia_path": "",
+	}
+
+	log.Printf("[DEBUG] Resetting cluster config on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error resetting cluster config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Reset cluster config on PKI secret backend %q", backend)
+
+	return nil
+}
+
+func pkiSecretBackendConfigClusterPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/cluster"
+}
@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendConfigCluster_basic(t *testing.T) {
+	backend := "pki-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigClusterConfig_basic(backend, "http://127.0.0.1:8200"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_cluster.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_cluster.test", "path", "http://127.0.0.1:8200/v1/"+backend),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_cluster.test", "aia_path", "http://127.0.0.1:8200/v1/"+backend),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigClusterConfig_basic(backend, "http://10.0.0.1:8200"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_cluster.test", "path", "http://10.0.0.1:8200/v1/"+backend),
+				),
+			},
+			{
+				ResourceName:      "vault_pki_secret_backend_config_cluster.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigClusterConfig_basic(path string, address string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_config_cluster" "test" {
+  backend  = "${vault_mount.test.path}"
+  path     = "%s/v1/${vault_mount.test.path}"
+  aia_path = "%s/v1/${vault_mount.test.path}"
+}`, path, address, address)
+}
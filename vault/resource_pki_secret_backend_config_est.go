@@ -0,0 +1,261 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigEstResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigEstWrite,
+		Read:   pkiSecretBackendConfigEstRead,
+		Update: pkiSecretBackendConfigEstWrite,
+		Delete: pkiSecretBackendConfigEstDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Specifies whether EST is enabled.",
+			},
+			"default_mount": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether this mount is the default EST mount, serving the /.well-known/est path.",
+			},
+			"default_path_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The policy used for requests to the default EST label, either \"sign-verbatim\" or \"role:<name>\".",
+			},
+			"label_to_path_policy": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Map of EST labels to the policy used for requests to them.",
+			},
+			"authenticators": pkiSecretBackendAuthenticatorsSchema("userpass"),
+			"enable_sentinel_parsing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the CSR is parsed and made available to Sentinel policies.",
+			},
+			"audit_fields": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Fields parsed from the CSR that appear in the audit log.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigEstWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	path := pkiSecretBackendConfigEstPath(backend)
+
+	data := map[string]interface{}{
+		"enabled":                 d.Get("enabled").(bool),
+		"default_mount":           d.Get("default_mount").(bool),
+		"default_path_policy":     d.Get("default_path_policy").(string),
+		"label_to_path_policy":    d.Get("label_to_path_policy").(map[string]interface{}),
+		"authenticators":          pkiSecretBackendExpandAuthenticators(d.Get("authenticators").([]interface{})),
+		"enable_sentinel_parsing": d.Get("enable_sentinel_parsing").(bool),
+	}
+	if v, ok := d.GetOk("audit_fields"); ok {
+		data["audit_fields"] = toStringArray(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Writing EST config on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing EST config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote EST config on PKI secret backend %q", backend)
+
+	d.SetId(backend)
+	return pkiSecretBackendConfigEstRead(d, meta)
+}
+
+func pkiSecretBackendConfigEstRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := pkiSecretBackendConfigEstPath(backend)
+
+	log.Printf("[DEBUG] Reading EST config from PKI secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading EST config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read EST config from PKI secret backend %q", backend)
+	if resp == nil {
+		log.Printf("[WARN] EST config not found on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	for _, k := range []string{"enabled", "default_mount", "default_path_policy", "enable_sentinel_parsing"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+	if err := d.Set("label_to_path_policy", resp.Data["label_to_path_policy"]); err != nil {
+		return fmt.Errorf("error setting label_to_path_policy in state: %s", err)
+	}
+	if err := d.Set("authenticators", pkiSecretBackendFlattenAuthenticators(resp.Data["authenticators"], "userpass")); err != nil {
+		return fmt.Errorf("error setting authenticators in state: %s", err)
+	}
+	var auditFields []string
+	if v, ok := resp.Data["audit_fields"].([]interface{}); ok {
+		auditFields = jsonStringArrayToStringArray(v)
+	}
+	if err := d.Set("audit_fields", auditFields); err != nil {
+		return fmt.Errorf("error setting audit_fields in state: %s", err)
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigEstDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := pkiSecretBackendConfigEstPath(backend)
+
+	log.Printf("[DEBUG] Disabling EST on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"enabled": false,
+	})
+	if err != nil {
+		return fmt.Errorf("error disabling EST on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Disabled EST on PKI secret backend %q", backend)
+
+	return nil
+}
+
+// pkiSecretBackendAuthenticatorsSchema returns the schema of the auth mounts
+// used to authenticate EST and SCEP clients. All of them accept cert auth,
+// alongside the protocol specific one given.
+func pkiSecretBackendAuthenticatorsSchema(other string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Description: "The auth mounts used to authenticate clients.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cert": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "The cert auth mount used to authenticate clients.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"accessor": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The accessor of the cert auth mount.",
+							},
+							"cert_role": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The cert auth role to authenticate against.",
+							},
+						},
+					},
+				},
+				other: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: fmt.Sprintf("The %s auth mount used to authenticate clients.", other),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"accessor": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: fmt.Sprintf("The accessor of the %s auth mount.", other),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func pkiSecretBackendExpandAuthenticators(v []interface{}) map[string]interface{} {
+	authenticators := map[string]interface{}{}
+	if len(v) == 0 || v[0] == nil {
+		return authenticators
+	}
+	for kind, raw := range v[0].(map[string]interface{}) {
+		blocks, ok := raw.([]interface{})
+		if !ok || len(blocks) == 0 || blocks[0] == nil {
+			continue
+		}
+		authenticators[kind] = blocks[0]
+	}
+	return authenticators
+}
+
+func pkiSecretBackendFlattenAuthenticators(raw interface{}, other string) []interface{} {
+	data, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	authenticator := map[string]interface{}{}
+	for _, kind := range []string{"cert", other} {
+		v, ok := data[kind].(map[string]interface{})
+		// Vault returns all supported authenticators, unset ones have
+		// no accessor.
+		if !ok || v["accessor"] == nil || v["accessor"] == "" {
+			continue
+		}
+		block := map[string]interface{}{
+			"accessor": v["accessor"],
+		}
+		if kind == "cert" {
+			block["cert_role"] = v["cert_role"]
+		}
+		authenticator[kind] = []interface{}{block}
+	}
+	if len(authenticator) == 0 {
+		return nil
+	}
+	return []interface{}{authenticator}
+}
+
+func pkiSecretBackendConfigEstPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/est"
+}
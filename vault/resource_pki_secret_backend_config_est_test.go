@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendConfigEst_basic(t *testing.T) {
+	rootPath := "pki-root-" + acctest.RandString(10)
+	certPath := "cert-" + acctest.RandString(10)
+	userpassPath := "userpass-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnterprisePreCheck(t)
+			testAccPkiSecretBackendRootMount(t, rootPath)
+		},
+		CheckDestroy: testAccPkiSecretBackendRootUnmount(rootPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigEstConfig_basic(rootPath, certPath, userpassPath, "sign-verbatim"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_est.test", "backend", rootPath),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_est.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_est.test", "default_path_policy", "sign-verbatim"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_est.test", "label_to_path_policy.%", "1"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_est.test", "label_to_path_policy.test-label", "sign-verbatim"),
+					resource.TestCheckResourceAttrPair("vault_pki_secret_backend_config_est.test", "authenticators.0.cert.0.accessor", "vault_auth_backend.cert", "accessor"),
+					resource.TestCheckResourceAttrPair("vault_pki_secret_backend_config_est.test", "authenticators.0.userpass.0.accessor", "vault_auth_backend.userpass", "accessor"),
+				),
+			},
+			{
+				ResourceName:      "vault_pki_secret_backend_config_est.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigEstConfig_basic(rootPath string, certPath string, userpassPath string, policy string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "cert" {
+  type = "cert"
+  path = "%s"
+}
+
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_config_est" "test" {
+  backend             = "%s"
+  enabled             = true
+  default_path_policy = "%s"
+
+  label_to_path_policy {
+    test-label = "sign-verbatim"
+  }
+
+  authenticators {
+    cert {
+      accessor = "${vault_auth_backend.cert.accessor}"
+    }
+
+    userpass {
+      accessor = "${vault_auth_backend.userpass.accessor}"
+    }
+  }
+}`, certPath, userpassPath, rootPath, policy)
+}
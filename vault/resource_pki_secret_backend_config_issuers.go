@@ -0,0 +1,110 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigIssuersResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigIssuersWrite,
+		Read:   pkiSecretBackendConfigIssuersRead,
+		Update: pkiSecretBackendConfigIssuersWrite,
+		Delete: pkiSecretBackendConfigIssuersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"default": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the default issuer by ID.",
+			},
+			"default_follows_latest_issuer": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specifies whether a root creation or an issuer import operation updates the default issuer to the newly added issuer.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigIssuersWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	path := pkiSecretBackendConfigIssuersPath(backend)
+
+	data := map[string]interface{}{
+		"default_follows_latest_issuer": d.Get("default_follows_latest_issuer").(bool),
+	}
+	if v, ok := d.GetOk("default"); ok {
+		data["default"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Writing issuers config on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing issuers config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote issuers config on PKI secret backend %q", backend)
+
+	d.SetId(backend)
+	return pkiSecretBackendConfigIssuersRead(d, meta)
+}
+
+func pkiSecretBackendConfigIssuersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := pkiSecretBackendConfigIssuersPath(backend)
+
+	log.Printf("[DEBUG] Reading issuers config from PKI secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading issuers config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read issuers config from PKI secret backend %q", backend)
+	if resp == nil {
+		log.Printf("[WARN] Issuers config not found on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("default", resp.Data["default"])
+	if v, ok := resp.Data["default_follows_latest_issuer"]; ok {
+		d.Set("default_follows_latest_issuer", v)
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigIssuersDelete(d *schema.ResourceData, meta interface{}) error {
+	// a PKI backend with issuers always has a default one,
+	// so there is nothing sensible to reset it to.
+	return nil
+}
+
+func pkiSecretBackendConfigIssuersPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/issuers"
+}
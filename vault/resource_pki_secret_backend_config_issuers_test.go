@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendConfigIssuers_basic(t *testing.T) {
+	path := "pki-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigIssuersConfig_basic(path, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_issuers.test", "backend", path),
+					resource.TestCheckResourceAttrPair("vault_pki_secret_backend_config_issuers.test", "default", "vault_pki_secret_backend_issuer.test", "issuer_id"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_issuers.test", "default_follows_latest_issuer", "false"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigIssuersConfig_basic(path, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_issuers.test", "default_follows_latest_issuer", "true"),
+				),
+			},
+			{
+				ResourceName:      "vault_pki_secret_backend_config_issuers.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigIssuersConfig_basic(path string, followsLatest bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                      = "%s"
+  type                      = "pki"
+  description               = "test"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds     = "86400"
+}
+
+resource "vault_pki_secret_backend_config_ca" "test" {
+  backend    = "${vault_mount.test.path}"
+  pem_bundle = <<EOT
+%sEOT
+}
+
+resource "vault_pki_secret_backend_issuer" "test" {
+  backend     = "${vault_mount.test.path}"
+  issuer_ref  = "${vault_pki_secret_backend_config_ca.test.imported_issuers[0]}"
+  issuer_name = "default-issuer"
+}
+
+resource "vault_pki_secret_backend_config_issuers" "test" {
+  backend                       = "${vault_mount.test.path}"
+  default                       = "${vault_pki_secret_backend_issuer.test.issuer_id}"
+  default_follows_latest_issuer = %t
+}`, path, testPkiSecretBackendConfigCABundle, followsLatest)
+}
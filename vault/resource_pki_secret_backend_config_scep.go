@@ -0,0 +1,173 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigScepResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigScepWrite,
+		Read:   pkiSecretBackendConfigScepRead,
+		Update: pkiSecretBackendConfigScepWrite,
+		Delete: pkiSecretBackendConfigScepDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Specifies whether SCEP is enabled.",
+			},
+			"default_path_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The policy used for SCEP requests, either \"sign-verbatim\" or \"role:<name>\".",
+			},
+			"allowed_encryption_algorithms": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "List of allowed encryption algorithms for SCEP requests.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allowed_digest_algorithms": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "List of allowed digest algorithms for SCEP requests.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"restrict_ca_chain_to_issuer": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, only return the issuer CA, otherwise the entire CA certificate chain will be returned.",
+			},
+			"authenticators": pkiSecretBackendAuthenticatorsSchema("scep"),
+			"log_level": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The level of logging verbosity, affects only SCEP logs on this mount.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigScepWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	path := pkiSecretBackendConfigScepPath(backend)
+
+	data := map[string]interface{}{
+		"enabled":                     d.Get("enabled").(bool),
+		"default_path_policy":         d.Get("default_path_policy").(string),
+		"restrict_ca_chain_to_issuer": d.Get("restrict_ca_chain_to_issuer").(bool),
+		"authenticators":              pkiSecretBackendExpandAuthenticators(d.Get("authenticators").([]interface{})),
+	}
+	for _, k := range []string{"allowed_encryption_algorithms", "allowed_digest_algorithms"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = toStringArray(v.([]interface{}))
+		}
+	}
+	if v, ok := d.GetOk("log_level"); ok {
+		data["log_level"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Writing SCEP config on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing SCEP config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote SCEP config on PKI secret backend %q", backend)
+
+	d.SetId(backend)
+	return pkiSecretBackendConfigScepRead(d, meta)
+}
+
+func pkiSecretBackendConfigScepRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := pkiSecretBackendConfigScepPath(backend)
+
+	log.Printf("[DEBUG] Reading SCEP config from PKI secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading SCEP config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read SCEP config from PKI secret backend %q", backend)
+	if resp == nil {
+		log.Printf("[WARN] SCEP config not found on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	for _, k := range []string{"enabled", "default_path_policy", "restrict_ca_chain_to_issuer", "log_level"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+	for _, k := range []string{"allowed_encryption_algorithms", "allowed_digest_algorithms"} {
+		var values []string
+		if v, ok := resp.Data[k].([]interface{}); ok {
+			values = jsonStringArrayToStringArray(v)
+		}
+		if err := d.Set(k, values); err != nil {
+			return fmt.Errorf("error setting %s in state: %s", k, err)
+		}
+	}
+	if err := d.Set("authenticators", pkiSecretBackendFlattenAuthenticators(resp.Data["authenticators"], "scep")); err != nil {
+		return fmt.Errorf("error setting authenticators in state: %s", err)
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigScepDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := pkiSecretBackendConfigScepPath(backend)
+
+	log.Printf("[DEBUG] Disabling SCEP on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"enabled": false,
+	})
+	if err != nil {
+		return fmt.Errorf("error disabling SCEP on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Disabled SCEP on PKI secret backend %q", backend)
+
+	return nil
+}
+
+func pkiSecretBackendConfigScepPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/scep"
+}
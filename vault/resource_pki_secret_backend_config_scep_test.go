@@ -0,0 +1,69 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendConfigScep_basic(t *testing.T) {
+	rootPath := "pki-root-" + acctest.RandString(10)
+	certPath := "cert-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnterprisePreCheck(t)
+			testAccPkiSecretBackendRootMount(t, rootPath)
+		},
+		CheckDestroy: testAccPkiSecretBackendRootUnmount(rootPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigScepConfig_basic(rootPath, certPath, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_scep.test", "backend", rootPath),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_scep.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_scep.test", "default_path_policy", "sign-verbatim"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_scep.test", "restrict_ca_chain_to_issuer", "false"),
+					resource.TestCheckResourceAttrPair("vault_pki_secret_backend_config_scep.test", "authenticators.0.cert.0.accessor", "vault_auth_backend.cert", "accessor"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigScepConfig_basic(rootPath, certPath, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_scep.test", "restrict_ca_chain_to_issuer", "true"),
+				),
+			},
+			{
+				ResourceName:      "vault_pki_secret_backend_config_scep.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigScepConfig_basic(rootPath string, certPath string, restrictChain bool) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "cert" {
+  type = "cert"
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_config_scep" "test" {
+  backend                     = "%s"
+  enabled                     = true
+  default_path_policy         = "sign-verbatim"
+  restrict_ca_chain_to_issuer = %t
+
+  authenticators {
+    cert {
+      accessor  = "${vault_auth_backend.cert.accessor}"
+      cert_role = "scep"
+    }
+  }
+}`, certPath, rootPath, restrictChain)
+}
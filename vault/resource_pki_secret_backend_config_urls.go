@@ -0,0 +1,155 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigUrlsResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigUrlsWrite,
+		Read:   pkiSecretBackendConfigUrlsRead,
+		Update: pkiSecretBackendConfigUrlsWrite,
+		Delete: pkiSecretBackendConfigUrlsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"issuing_certificates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies the URL values for the Issuing Certificate field.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"crl_distribution_points": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies the URL values for the CRL Distribution Points field.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ocsp_servers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies the URL values for the OCSP Servers field.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"enable_templating": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specifies that the AIA URL values should be templated with the cluster's path and aia_path. Requires Vault 1.13 or later.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigUrlsWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	path := pkiSecretBackendConfigUrlsPath(backend)
+
+	data := map[string]interface{}{
+		"issuing_certificates":    toStringArray(d.Get("issuing_certificates").([]interface{})),
+		"crl_distribution_points": toStringArray(d.Get("crl_distribution_points").([]interface{})),
+		"ocsp_servers":            toStringArray(d.Get("ocsp_servers").([]interface{})),
+		"enable_templating":       d.Get("enable_templating").(bool),
+	}
+
+	log.Printf("[DEBUG] Writing URL config on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing URL config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote URL config on PKI secret backend %q", backend)
+
+	d.SetId(backend)
+	return pkiSecretBackendConfigUrlsRead(d, meta)
+}
+
+func pkiSecretBackendConfigUrlsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := pkiSecretBackendConfigUrlsPath(backend)
+
+	log.Printf("[DEBUG] Reading URL config from PKI secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading URL config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read URL config from PKI secret backend %q", backend)
+	if resp == nil {
+		log.Printf("[WARN] URL config not found on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	for _, k := range []string{"issuing_certificates", "crl_distribution_points", "ocsp_servers"} {
+		// Vault returns these as null instead of an empty list when unset.
+		values := []string{}
+		if v, ok := resp.Data[k].([]interface{}); ok {
+			values = jsonStringArrayToStringArray(v)
+		}
+		if err := d.Set(k, values); err != nil {
+			return fmt.Errorf("error setting %s in state: %s", k, err)
+		}
+	}
+	if v, ok := resp.Data["enable_templating"]; ok {
+		d.Set("enable_templating", v)
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigUrlsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := pkiSecretBackendConfigUrlsPath(backend)
+
+	// the URL config can't be deleted, so reset it to the defaults instead.
+	data := map[string]interface{}{
+		"issuing_certificates":    []string{},
+		"crl_distribution_points": []string{},
+		"ocsp_servers":            []string{},
+	}
+
+	log.Printf("[DEBUG] Resetting URL config on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error resetting URL config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Reset URL config on PKI secret backend %q", backend)
+
+	return nil
+}
+
+func pkiSecretBackendConfigUrlsPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/urls"
+}
@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendConfigUrls_basic(t *testing.T) {
+	backend := "pki-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigUrlsConfig_basic(backend, `["http://127.0.0.1:8200/v1/pki/ca"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_urls.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_urls.test", "issuing_certificates.#", "1"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_urls.test", "issuing_certificates.0", "http://127.0.0.1:8200/v1/pki/ca"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_urls.test", "crl_distribution_points.#", "1"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_urls.test", "ocsp_servers.#", "0"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigUrlsConfig_basic(backend, `["http://127.0.0.1:8200/v1/pki/ca", "http://10.0.0.1:8200/v1/pki/ca"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_urls.test", "issuing_certificates.#", "2"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_urls.test", "issuing_certificates.1", "http://10.0.0.1:8200/v1/pki/ca"),
+				),
+			},
+			{
+				ResourceName:      "vault_pki_secret_backend_config_urls.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigUrlsConfig_basic(path string, issuingCertificates string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_config_urls" "test" {
+  backend                 = "${vault_mount.test.path}"
+  issuing_certificates    = %s
+  crl_distribution_points = ["http://127.0.0.1:8200/v1/pki/crl"]
+}`, path, issuingCertificates)
+}
@@ -0,0 +1,193 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// pkiSecretBackendCrlConfigStringFields are the CRL config fields holding
+// durations, Vault fills in its own defaults for those when unset.
+var pkiSecretBackendCrlConfigStringFields = []string{
+	"expiry",
+	"ocsp_expiry",
+	"auto_rebuild_grace_period",
+	"delta_rebuild_interval",
+}
+
+var pkiSecretBackendCrlConfigBoolFields = []string{
+	"disable",
+	"ocsp_disable",
+	"auto_rebuild",
+	"enable_delta",
+	"cross_cluster_revocation",
+	"unified_crl",
+	"unified_crl_on_existing_paths",
+}
+
+func pkiSecretBackendCrlConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendCrlConfigWrite,
+		Read:   pkiSecretBackendCrlConfigRead,
+		Update: pkiSecretBackendCrlConfigWrite,
+		Delete: pkiSecretBackendCrlConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"expiry": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the time until expiration.",
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disables or enables CRL building.",
+			},
+			"ocsp_disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disables or enables the OCSP responder. Requires Vault 1.12 or later.",
+			},
+			"ocsp_expiry": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The amount of time an OCSP response will be valid. Requires Vault 1.12 or later.",
+			},
+			"auto_rebuild": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables periodic rebuilding of the CRL upon expiry. Requires Vault 1.12 or later.",
+			},
+			"auto_rebuild_grace_period": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Grace period before CRL expiry to attempt rebuild of CRL. Requires Vault 1.12 or later.",
+			},
+			"enable_delta": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables building of delta CRLs with up-to-date revocation information, augmenting the last complete CRL. Requires Vault 1.12 or later.",
+			},
+			"delta_rebuild_interval": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Interval to check for new revocations on, to regenerate the delta CRL. Requires Vault 1.12 or later.",
+			},
+			"cross_cluster_revocation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable cross-cluster revocation request queues. Requires Vault Enterprise 1.13 or later.",
+			},
+			"unified_crl": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables unified CRL and OCSP building. Requires Vault Enterprise 1.13 or later.",
+			},
+			"unified_crl_on_existing_paths": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables serving the unified CRL and OCSP on the existing, previously cluster-local paths. Requires Vault Enterprise 1.13 or later.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendCrlConfigWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	path := pkiSecretBackendCrlConfigPath(backend)
+
+	data := map[string]interface{}{}
+	for _, k := range pkiSecretBackendCrlConfigStringFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	for _, k := range pkiSecretBackendCrlConfigBoolFields {
+		data[k] = d.Get(k).(bool)
+	}
+
+	log.Printf("[DEBUG] Writing CRL config on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing CRL config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote CRL config on PKI secret backend %q", backend)
+
+	d.SetId(backend)
+	return pkiSecretBackendCrlConfigRead(d, meta)
+}
+
+func pkiSecretBackendCrlConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := pkiSecretBackendCrlConfigPath(backend)
+
+	log.Printf("[DEBUG] Reading CRL config from PKI secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading CRL config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read CRL config from PKI secret backend %q", backend)
+	if resp == nil {
+		log.Printf("[WARN] CRL config not found on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	// older versions of Vault don't return the fields they don't support.
+	for _, k := range pkiSecretBackendCrlConfigStringFields {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+	for _, k := range pkiSecretBackendCrlConfigBoolFields {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendCrlConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	// the CRL config can't be deleted, and there is no sensible set of
+	// values to reset it to, so it's left as it is.
+	return nil
+}
+
+func pkiSecretBackendCrlConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/crl"
+}
@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendCrlConfig_basic(t *testing.T) {
+	rootPath := "pki-root-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPkiSecretBackendRootMount(t, rootPath)
+		},
+		CheckDestroy: testAccPkiSecretBackendRootUnmount(rootPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendCrlConfigConfig_basic(rootPath, "72h", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "backend", rootPath),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "expiry", "72h"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "disable", "false"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendCrlConfigConfig_basic(rootPath, "24h", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "expiry", "24h"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "disable", "true"),
+				),
+			},
+			{
+				ResourceName:      "vault_pki_secret_backend_crl_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendCrlConfigConfig_basic(rootPath string, expiry string, disable bool) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend_crl_config" "test" {
+  backend = "%s"
+  expiry  = "%s"
+  disable = %t
+}`, rootPath, expiry, disable)
+}
@@ -0,0 +1,260 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendIntermediateCertRequestResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendIntermediateCertRequestCreate,
+		Read:   pkiSecretBackendIntermediateCertRequestRead,
+		Delete: pkiSecretBackendIntermediateCertRequestDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of intermediate to create. Must be either \"exported\" or \"internal\".",
+				ValidateFunc: validation.StringInSlice([]string{"exported", "internal"}, false),
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CN of intermediate to create.",
+			},
+			"alt_names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of alternative names.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ip_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of alternative IPs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"uri_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of alternative URIs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"other_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of other SANs, in the format <oid>;<type>:<value>.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "pem",
+				Description:  "The format of data. Must be one of \"pem\", \"der\" or \"pem_bundle\".",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "der", "pem_bundle"}, false),
+			},
+			"private_key_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "der",
+				Description:  "The private key format. Must be either \"der\" or \"pkcs8\".",
+				ValidateFunc: validation.StringInSlice([]string{"der", "pkcs8"}, false),
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "rsa",
+				Description:  "The desired key type. Must be one of \"rsa\", \"ec\" or \"ed25519\".",
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec", "ed25519"}, false),
+			},
+			"key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     2048,
+				Description: "The number of bits to use.",
+			},
+			"key_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "When a new key is created, the name given to it. Requires Vault 1.11 or later.",
+			},
+			"exclude_cn_from_sans": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Flag to exclude CN from SANs.",
+			},
+			"ou": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The organization unit.",
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The organization.",
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The country.",
+			},
+			"locality": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The locality.",
+			},
+			"province": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The province.",
+			},
+			"street_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The street address.",
+			},
+			"postal_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The postal code.",
+			},
+			"csr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CSR.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key. Only returned if type is \"exported\".",
+			},
+			"private_key_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The private key type. Only returned if type is \"exported\".",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the key backing the CSR. Only returned by Vault 1.11 or later.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendIntermediateCertRequestCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := clientWithTimeout(meta.(*api.Client), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	backend := d.Get("backend").(string)
+	intermediateType := d.Get("type").(string)
+
+	path := pkiSecretBackendIntermediateGeneratePath(backend, intermediateType)
+
+	data := map[string]interface{}{
+		"common_name":          d.Get("common_name").(string),
+		"alt_names":            strings.Join(toStringArray(d.Get("alt_names").([]interface{})), ","),
+		"ip_sans":              strings.Join(toStringArray(d.Get("ip_sans").([]interface{})), ","),
+		"uri_sans":             strings.Join(toStringArray(d.Get("uri_sans").([]interface{})), ","),
+		"other_sans":           strings.Join(toStringArray(d.Get("other_sans").([]interface{})), ","),
+		"format":               d.Get("format").(string),
+		"private_key_format":   d.Get("private_key_format").(string),
+		"key_type":             d.Get("key_type").(string),
+		"key_bits":             d.Get("key_bits").(int),
+		"exclude_cn_from_sans": d.Get("exclude_cn_from_sans").(bool),
+		"ou":                   d.Get("ou").(string),
+		"organization":         d.Get("organization").(string),
+		"country":              d.Get("country").(string),
+		"locality":             d.Get("locality").(string),
+		"province":             d.Get("province").(string),
+		"street_address":       d.Get("street_address").(string),
+		"postal_code":          d.Get("postal_code").(string),
+	}
+	if v, ok := d.GetOk("key_name"); ok {
+		data["key_name"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Creating intermediate cert request on PKI secret backend %q", backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error creating intermediate cert request for PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Created intermediate cert request on PKI secret backend %q", backend)
+
+	d.Set("csr", resp.Data["csr"])
+	d.Set("key_id", resp.Data["key_id"])
+	if intermediateType == "exported" {
+		d.Set("private_key", resp.Data["private_key"])
+		d.Set("private_key_type", resp.Data["private_key_type"])
+	}
+
+	d.SetId(path)
+	return pkiSecretBackendIntermediateCertRequestRead(d, meta)
+}
+
+func pkiSecretBackendIntermediateCertRequestRead(d *schema.ResourceData, meta interface{}) error {
+	// the API can't return a previously generated CSR
+	// so there is nothing to refresh here.
+	return nil
+}
+
+func pkiSecretBackendIntermediateCertRequestDelete(d *schema.ResourceData, meta interface{}) error {
+	// there is no API to delete a CSR; removing it from state is all we can do.
+	return nil
+}
+
+func pkiSecretBackendIntermediateGeneratePath(backend string, intermediateType string) string {
+	return strings.Trim(backend, "/") + "/intermediate/generate/" + strings.Trim(intermediateType, "/")
+}
@@ -0,0 +1,67 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendIntermediateCertRequest_basic(t *testing.T) {
+	path := "pki-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendIntermediateCertRequestConfig_basic(path, "internal"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_intermediate_cert_request.test", "backend", path),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_intermediate_cert_request.test", "type", "internal"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_intermediate_cert_request.test", "common_name", "test.my.domain"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_intermediate_cert_request.test", "csr"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_intermediate_cert_request.test", "private_key", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPkiSecretBackendIntermediateCertRequest_exported(t *testing.T) {
+	path := "pki-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendIntermediateCertRequestConfig_basic(path, "exported"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_intermediate_cert_request.test", "type", "exported"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_intermediate_cert_request.test", "csr"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_intermediate_cert_request.test", "private_key"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_intermediate_cert_request.test", "private_key_type", "rsa"),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendIntermediateCertRequestConfig_basic(path, intermediateType string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                      = "%s"
+  type                      = "pki"
+  description               = "test"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds     = "86400"
+}
+
+resource "vault_pki_secret_backend_intermediate_cert_request" "test" {
+  backend     = "${vault_mount.test.path}"
+  type        = "%s"
+  common_name = "test.my.domain"
+}`, path, intermediateType)
+}
@@ -0,0 +1,153 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendIntermediateCrossSignRequestResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendIntermediateCrossSignRequestCreate,
+		Read:   pkiSecretBackendIntermediateCrossSignRequestRead,
+		Delete: pkiSecretBackendIntermediateCrossSignRequestDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"key_ref": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Reference to the existing key to generate the CSR with, either its name or ID.",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CN of the cross-signed intermediate.",
+			},
+			"alt_names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of alternative names.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "pem",
+				Description:  "The format of data. Must be one of \"pem\", \"der\" or \"pem_bundle\".",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "der", "pem_bundle"}, false),
+			},
+			"ou": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The organization unit.",
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The organization.",
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The country.",
+			},
+			"locality": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The locality.",
+			},
+			"province": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The province.",
+			},
+			"csr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CSR.",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the key backing the CSR.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendIntermediateCrossSignRequestCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := clientWithTimeout(meta.(*api.Client), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	backend := d.Get("backend").(string)
+
+	path := strings.Trim(backend, "/") + "/intermediate/cross-sign"
+
+	data := map[string]interface{}{
+		"key_ref":      d.Get("key_ref").(string),
+		"common_name":  d.Get("common_name").(string),
+		"alt_names":    strings.Join(toStringArray(d.Get("alt_names").([]interface{})), ","),
+		"format":       d.Get("format").(string),
+		"ou":           d.Get("ou").(string),
+		"organization": d.Get("organization").(string),
+		"country":      d.Get("country").(string),
+		"locality":     d.Get("locality").(string),
+		"province":     d.Get("province").(string),
+	}
+
+	log.Printf("[DEBUG] Creating cross-sign request on PKI secret backend %q", backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error creating cross-sign request for PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Created cross-sign request on PKI secret backend %q", backend)
+
+	d.Set("csr", resp.Data["csr"])
+	d.Set("key_id", resp.Data["key_id"])
+
+	d.SetId(path + "/" + d.Get("key_ref").(string))
+	return pkiSecretBackendIntermediateCrossSignRequestRead(d, meta)
+}
+
+func pkiSecretBackendIntermediateCrossSignRequestRead(d *schema.ResourceData, meta interface{}) error {
+	// the API can't return a previously generated CSR
+	// so there is nothing to refresh here.
+	return nil
+}
+
+func pkiSecretBackendIntermediateCrossSignRequestDelete(d *schema.ResourceData, meta interface{}) error {
+	// there is no API to delete a CSR; removing it from state is all we can do.
+	return nil
+}
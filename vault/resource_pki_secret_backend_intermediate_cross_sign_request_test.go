@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendIntermediateCrossSignRequest_basic(t *testing.T) {
+	rootPath := "pki-root-" + acctest.RandString(10)
+	otherRootPath := "pki-root-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPkiSecretBackendRootMount(t, rootPath)
+			testAccPkiSecretBackendRootMount(t, otherRootPath)
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccPkiSecretBackendRootUnmount(rootPath),
+			testAccPkiSecretBackendRootUnmount(otherRootPath),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendIntermediateCrossSignRequestConfig_basic(rootPath, otherRootPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_intermediate_cross_sign_request.test", "backend", rootPath),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_intermediate_cross_sign_request.test", "csr"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_intermediate_cross_sign_request.test", "key_id"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuers_import.test", "imported_issuers.#", "1"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuers_import.test", "imported_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendIntermediateCrossSignRequestConfig_basic(rootPath string, otherRootPath string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend_intermediate_cross_sign_request" "test" {
+  backend     = "%s"
+  key_ref     = "default"
+  common_name = "Root CA"
+}
+
+resource "vault_pki_secret_backend_root_sign_intermediate" "test" {
+  backend        = "%s"
+  csr            = "${vault_pki_secret_backend_intermediate_cross_sign_request.test.csr}"
+  common_name    = "Root CA"
+  use_csr_values = true
+}
+
+resource "vault_pki_secret_backend_issuers_import" "test" {
+  backend    = "${vault_pki_secret_backend_intermediate_cross_sign_request.test.backend}"
+  pem_bundle = "${vault_pki_secret_backend_root_sign_intermediate.test.certificate}"
+}`, rootPath, otherRootPath)
+}
@@ -0,0 +1,110 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendIntermediateSetSignedResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendIntermediateSetSignedCreate,
+		Read:   pkiSecretBackendIntermediateSetSignedRead,
+		Delete: pkiSecretBackendIntermediateSetSignedDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The certificate, optionally followed by the rest of the CA chain, in PEM format.",
+			},
+			"imported_issuers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the issuers imported from the certificate. Only returned by Vault 1.11 or later.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"imported_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the keys imported along with the certificate. Only returned by Vault 1.11 or later.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func pkiSecretBackendIntermediateSetSignedCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	path := pkiSecretBackendIntermediateSetSignedPath(backend)
+
+	data := map[string]interface{}{
+		"certificate": d.Get("certificate").(string),
+	}
+
+	log.Printf("[DEBUG] Setting signed intermediate certificate on PKI secret backend %q", backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error setting signed intermediate certificate for PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Set signed intermediate certificate on PKI secret backend %q", backend)
+
+	// older versions of Vault return no content at all, newer ones
+	// report which issuers and keys the certificate was imported as.
+	importedIssuers := []string{}
+	importedKeys := []string{}
+	if resp != nil {
+		if v, ok := resp.Data["imported_issuers"].([]interface{}); ok {
+			importedIssuers = jsonStringArrayToStringArray(v)
+		}
+		if v, ok := resp.Data["imported_keys"].([]interface{}); ok {
+			importedKeys = jsonStringArrayToStringArray(v)
+		}
+	}
+	if err := d.Set("imported_issuers", importedIssuers); err != nil {
+		return fmt.Errorf("error setting imported_issuers in state: %s", err)
+	}
+	if err := d.Set("imported_keys", importedKeys); err != nil {
+		return fmt.Errorf("error setting imported_keys in state: %s", err)
+	}
+
+	d.SetId(path)
+	return pkiSecretBackendIntermediateSetSignedRead(d, meta)
+}
+
+func pkiSecretBackendIntermediateSetSignedRead(d *schema.ResourceData, meta interface{}) error {
+	// the signed certificate becomes part of the backend's CA chain;
+	// there is no endpoint to read back what was set through this resource.
+	return nil
+}
+
+func pkiSecretBackendIntermediateSetSignedDelete(d *schema.ResourceData, meta interface{}) error {
+	// the imported issuers are left in place, they may already have
+	// been used to issue certificates.
+	return nil
+}
+
+func pkiSecretBackendIntermediateSetSignedPath(backend string) string {
+	return strings.Trim(backend, "/") + "/intermediate/set-signed"
+}
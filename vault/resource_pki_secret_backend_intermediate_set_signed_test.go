@@ -0,0 +1,70 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// A self-signed CA certificate that Vault 1.11 and later will import as an
+// issuer without a matching key.
+const testPkiSecretBackendIntermediateSetSignedCertificate = `-----BEGIN CERTIFICATE-----
+MIIDaTCCAlGgAwIBAgIUKRYGk2E1D7aMf8M3KzYdVzCCWZAwDQYJKoZIhvcNAQEL
+BQAwOzE5MDcGA1UEAwwwdGVzdC1pbnRlcm1lZGlhdGUudGVycmFmb3JtLXZhdWx0
+LXByb3ZpZGVyLmxvY2FsMCAXDTI2MTAxNDE3MjcwNloYDzIxMjYwOTIwMTcyNzA2
+WjA7MTkwNwYDVQQDDDB0ZXN0LWludGVybWVkaWF0ZS50ZXJyYWZvcm0tdmF1bHQt
+cHJvdmlkZXIubG9jYWwwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQCt
+CbeiyfGhXpGhWHBTTvblXXZYoBtDlSlVxunLdCKEpUtMKuseFnfQbzgONyc81y0g
+Bh8ZSlKGeQheYQ9433Y1IOsT9C2V5KUcJsO9ionGII+CFr6tgTkqOPG8nxyJ1gAi
+xrlcFqmXzhwkXlA8EfCuIlD1k5Gcfk8UDWtjxGl4Q4uPenAlrI6aVP3vQBs4RBnb
+zXQQrBXsm5K4v2OOn7iK7CTPE2kcFtMIUg8etVhl8cBEFm9ZtPeAJtZBmHH2ARBl
+pwqRJlpEHW9+wuZjViR7hbwk/q9/OWCcEGb4/1+BN2sHng0ezB6bjOm184BjTd+k
+LHyC+4Nbu8g5Gr4SpAmfAgMBAAGjYzBhMB0GA1UdDgQWBBSx5QP/JNhrdtXE2Hp5
+knSJ/dmVPjAfBgNVHSMEGDAWgBSx5QP/JNhrdtXE2Hp5knSJ/dmVPjAPBgNVHRMB
+Af8EBTADAQH/MA4GA1UdDwEB/wQEAwIBBjANBgkqhkiG9w0BAQsFAAOCAQEAD5Vs
+05fq522D/kCieA0woTARwU34JN7Lw9kY/cibpF482g58vL1Sl+Cf4Ixw/bgvpj7I
+7yhH61yn7g3fopbQmY3WUfRNNhjrIdSuhPjZkArasJIjxLrwF6tP3FEna+6bm4K/
+J6Swdy52pM8CX3FutYR4SwEYYFTrZiNnj/TGyo8g2GYy6EdkiIizmQ35dhCXc9uw
+eLW5I9Mq8LTpyvoPM/oWASLO8wzGuMj1NA9DHXhC5syBWkRlQ7jjIkPj9c9+07LB
+6xGSsfYOI4mdXHiO1iJLadC8j5UfqWVEJZ7L3wWT1mHkgAWHnt9kOumlCn+BweVj
+JaffLIup4wDmeE4yaA==
+-----END CERTIFICATE-----
+`
+
+func TestAccPkiSecretBackendIntermediateSetSigned_basic(t *testing.T) {
+	path := "pki-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendIntermediateSetSignedConfig_basic(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_intermediate_set_signed.test", "backend", path),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_intermediate_set_signed.test", "certificate"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_intermediate_set_signed.test", "imported_issuers.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendIntermediateSetSignedConfig_basic(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                      = "%s"
+  type                      = "pki"
+  description               = "test"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds     = "86400"
+}
+
+resource "vault_pki_secret_backend_intermediate_set_signed" "test" {
+  backend     = "${vault_mount.test.path}"
+  certificate = <<EOT
+%sEOT
+}`, path, testPkiSecretBackendIntermediateSetSignedCertificate)
+}
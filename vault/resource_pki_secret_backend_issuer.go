@@ -0,0 +1,277 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	pkiSecretBackendIssuerBackendFromPathRegex = regexp.MustCompile("^(.+)/issuer/.+$")
+	pkiSecretBackendIssuerIDFromPathRegex      = regexp.MustCompile("^.+/issuer/(.+)$")
+)
+
+// pkiSecretBackendIssuerAIAFields are the per-issuer overrides of the
+// mount's config/urls, they are all lists of URLs.
+var pkiSecretBackendIssuerAIAFields = []string{
+	"issuing_certificates",
+	"crl_distribution_points",
+	"ocsp_servers",
+}
+
+func pkiSecretBackendIssuerResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendIssuerCreate,
+		Read:   pkiSecretBackendIssuerRead,
+		Update: pkiSecretBackendIssuerUpdate,
+		Delete: pkiSecretBackendIssuerDelete,
+		Importer: &schema.ResourceImporter{
+			State: pkiSecretBackendIssuerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Reference to an existing issuer, either its name or ID.",
+			},
+			"issuer_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the issuer.",
+			},
+			"leaf_not_after_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Behavior of leaf certificates issued with a validity past the issuer's. Must be one of \"err\", \"truncate\" or \"permit\".",
+				ValidateFunc: validation.StringInSlice([]string{"err", "truncate", "permit"}, false),
+			},
+			"usage": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Comma-separated list of allowed usages for the issuer.",
+			},
+			"manual_chain": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Chain of issuer references to build this issuer's computed CAChain field from, when non-empty.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"revocation_signature_algorithm": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Which signature algorithm to use when building CRLs.",
+			},
+			"issuing_certificates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies the URL values for the Issuing Certificate field, overriding the backend's.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"crl_distribution_points": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies the URL values for the CRL Distribution Points field, overriding the backend's.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ocsp_servers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies the URL values for the OCSP Servers field, overriding the backend's.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"enable_aia_url_templating": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specifies that the AIA URL values should be templated with the cluster's path. Requires Vault 1.13 or later.",
+			},
+			"issuer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the issuer.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendIssuerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	issuerRef := d.Get("issuer_ref").(string)
+
+	path := pkiSecretBackendIssuerPath(backend, issuerRef)
+
+	log.Printf("[DEBUG] Writing PKI secret backend issuer %q", path)
+	resp, err := client.Logical().Write(path, pkiSecretBackendIssuerRequestData(d))
+	if err != nil {
+		return fmt.Errorf("error writing PKI secret backend issuer %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote PKI secret backend issuer %q", path)
+
+	// store the issuer by ID, the reference given may be a name that
+	// can be changed through this very resource.
+	issuerID := issuerRef
+	if resp != nil {
+		if v, ok := resp.Data["issuer_id"].(string); ok && v != "" {
+			issuerID = v
+		}
+	}
+
+	d.SetId(pkiSecretBackendIssuerPath(backend, issuerID))
+	return pkiSecretBackendIssuerRead(d, meta)
+}
+
+func pkiSecretBackendIssuerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := pkiSecretBackendIssuerBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid issuer ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading PKI secret backend issuer %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading PKI secret backend issuer %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read PKI secret backend issuer %q", path)
+	if resp == nil {
+		log.Printf("[WARN] PKI secret backend issuer %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	for _, k := range []string{"issuer_id", "issuer_name", "leaf_not_after_behavior", "revocation_signature_algorithm", "enable_aia_url_templating"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	// Vault reports usage as a comma-separated string on older versions
+	// and as a list on newer ones.
+	switch v := resp.Data["usage"].(type) {
+	case string:
+		d.Set("usage", v)
+	case []interface{}:
+		d.Set("usage", strings.Join(jsonStringArrayToStringArray(v), ","))
+	}
+
+	for _, k := range append(pkiSecretBackendIssuerAIAFields, "manual_chain") {
+		var values []string
+		if v, ok := resp.Data[k].([]interface{}); ok {
+			values = jsonStringArrayToStringArray(v)
+		}
+		if err := d.Set(k, values); err != nil {
+			return fmt.Errorf("error setting %s in state: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendIssuerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Updating PKI secret backend issuer %q", path)
+	_, err := client.Logical().Write(path, pkiSecretBackendIssuerRequestData(d))
+	if err != nil {
+		return fmt.Errorf("error updating PKI secret backend issuer %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated PKI secret backend issuer %q", path)
+
+	return pkiSecretBackendIssuerRead(d, meta)
+}
+
+func pkiSecretBackendIssuerDelete(d *schema.ResourceData, meta interface{}) error {
+	// the issuer itself is created by generating or importing a CA, this
+	// resource only manages its settings, so the issuer is left in place.
+	return nil
+}
+
+func pkiSecretBackendIssuerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	issuerID, err := pkiSecretBackendIssuerIDFromPath(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("invalid issuer ID %q: %s", d.Id(), err)
+	}
+	d.Set("issuer_ref", issuerID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// pkiSecretBackendIssuerRequestData builds the full issuer configuration,
+// Vault resets anything left out of the request to its default.
+func pkiSecretBackendIssuerRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"manual_chain":              strings.Join(toStringArray(d.Get("manual_chain").([]interface{})), ","),
+		"enable_aia_url_templating": d.Get("enable_aia_url_templating").(bool),
+	}
+	for _, k := range []string{"issuer_name", "leaf_not_after_behavior", "usage", "revocation_signature_algorithm"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	for _, k := range pkiSecretBackendIssuerAIAFields {
+		data[k] = toStringArray(d.Get(k).([]interface{}))
+	}
+	return data
+}
+
+func pkiSecretBackendIssuerPath(backend string, issuerRef string) string {
+	return strings.Trim(backend, "/") + "/issuer/" + strings.Trim(issuerRef, "/")
+}
+
+func pkiSecretBackendIssuerIDFromPath(path string) (string, error) {
+	if !pkiSecretBackendIssuerIDFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no issuer found")
+	}
+	res := pkiSecretBackendIssuerIDFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for issuer", len(res))
+	}
+	return res[1], nil
+}
+
+func pkiSecretBackendIssuerBackendFromPath(path string) (string, error) {
+	if !pkiSecretBackendIssuerBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := pkiSecretBackendIssuerBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,70 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendIssuer_basic(t *testing.T) {
+	path := "pki-" + acctest.RandString(10)
+	name := "issuer-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendIssuerConfig_basic(path, name, "err"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuer.test", "backend", path),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuer.test", "issuer_name", name),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuer.test", "leaf_not_after_behavior", "err"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuer.test", "issuing_certificates.#", "1"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuer.test", "issuing_certificates.0", "http://127.0.0.1:8200/v1/pki/ca"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_issuer.test", "issuer_id"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendIssuerConfig_basic(path, name+"-updated", "truncate"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuer.test", "issuer_name", name+"-updated"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuer.test", "leaf_not_after_behavior", "truncate"),
+				),
+			},
+			{
+				ResourceName:            "vault_pki_secret_backend_issuer.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"issuer_ref"},
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendIssuerConfig_basic(path string, name string, leafNotAfterBehavior string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                      = "%s"
+  type                      = "pki"
+  description               = "test"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds     = "86400"
+}
+
+resource "vault_pki_secret_backend_config_ca" "test" {
+  backend    = "${vault_mount.test.path}"
+  pem_bundle = <<EOT
+%sEOT
+}
+
+resource "vault_pki_secret_backend_issuer" "test" {
+  backend                 = "${vault_mount.test.path}"
+  issuer_ref              = "${vault_pki_secret_backend_config_ca.test.imported_issuers[0]}"
+  issuer_name             = "%s"
+  leaf_not_after_behavior = "%s"
+  issuing_certificates    = ["http://127.0.0.1:8200/v1/pki/ca"]
+}`, path, testPkiSecretBackendConfigCABundle, name, leafNotAfterBehavior)
+}
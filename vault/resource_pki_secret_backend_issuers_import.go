@@ -0,0 +1,114 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendIssuersImportResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendIssuersImportCreate,
+		Read:   pkiSecretBackendIssuersImportRead,
+		Delete: pkiSecretBackendIssuersImportDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"pem_bundle": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The certificates, and optionally the keys, to import concatenated in PEM format.",
+			},
+			"imported_issuers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the issuers newly imported from the bundle.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"imported_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the keys newly imported from the bundle.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"mapping": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of the IDs of all issuers in the bundle to the IDs of their keys, whether newly imported or already present.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendIssuersImportCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	path := strings.Trim(backend, "/") + "/issuers/import/bundle"
+
+	data := map[string]interface{}{
+		"pem_bundle": d.Get("pem_bundle").(string),
+	}
+
+	log.Printf("[DEBUG] Importing issuers on PKI secret backend %q", backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error importing issuers on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Imported issuers on PKI secret backend %q", backend)
+
+	if err := pkiSecretBackendSetImportedData(d, resp); err != nil {
+		return err
+	}
+
+	// issuers imported without their key map to an empty key ID.
+	mapping := map[string]interface{}{}
+	if resp != nil {
+		if v, ok := resp.Data["mapping"].(map[string]interface{}); ok {
+			for issuerID, keyID := range v {
+				if keyID == nil {
+					mapping[issuerID] = ""
+					continue
+				}
+				mapping[issuerID] = fmt.Sprintf("%s", keyID)
+			}
+		}
+	}
+	if err := d.Set("mapping", mapping); err != nil {
+		return fmt.Errorf("error setting mapping in state: %s", err)
+	}
+
+	d.SetId(path)
+	return pkiSecretBackendIssuersImportRead(d, meta)
+}
+
+func pkiSecretBackendIssuersImportRead(d *schema.ResourceData, meta interface{}) error {
+	// the bundle can't be read back, the imported issuers are
+	// managed with vault_pki_secret_backend_issuer instead.
+	return nil
+}
+
+func pkiSecretBackendIssuersImportDelete(d *schema.ResourceData, meta interface{}) error {
+	// the imported issuers are left in place, they may already have
+	// been used to issue certificates.
+	return nil
+}
@@ -0,0 +1,46 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendIssuersImport_basic(t *testing.T) {
+	path := "pki-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendIssuersImportConfig_basic(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuers_import.test", "backend", path),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuers_import.test", "imported_issuers.#", "1"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuers_import.test", "imported_keys.#", "1"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_issuers_import.test", "mapping.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendIssuersImportConfig_basic(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                      = "%s"
+  type                      = "pki"
+  description               = "test"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds     = "86400"
+}
+
+resource "vault_pki_secret_backend_issuers_import" "test" {
+  backend    = "${vault_mount.test.path}"
+  pem_bundle = <<EOT
+%sEOT
+}`, path, testPkiSecretBackendConfigCABundle)
+}
@@ -0,0 +1,223 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	pkiSecretBackendKeyBackendFromPathRegex = regexp.MustCompile("^(.+)/key/.+$")
+)
+
+func pkiSecretBackendKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendKeyCreate,
+		Read:   pkiSecretBackendKeyRead,
+		Update: pkiSecretBackendKeyUpdate,
+		Delete: pkiSecretBackendKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of key to generate. Must be one of \"exported\", \"internal\" or \"kms\".",
+				ValidateFunc: validation.StringInSlice([]string{"exported", "internal", "kms"}, false),
+			},
+			"key_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the key.",
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The desired key type. Must be one of \"rsa\", \"ec\" or \"ed25519\".",
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec", "ed25519"}, false),
+			},
+			"key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The number of bits to use.",
+			},
+			"managed_key_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The managed key's configured name, when type is \"kms\".",
+			},
+			"managed_key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The managed key's UUID, when type is \"kms\".",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the generated key.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key. Only returned if type is \"exported\".",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := clientWithTimeout(meta.(*api.Client), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	backend := d.Get("backend").(string)
+	keyType := d.Get("type").(string)
+
+	path := strings.Trim(backend, "/") + "/keys/generate/" + keyType
+
+	data := map[string]interface{}{}
+	for _, k := range []string{"key_name", "key_type", "managed_key_name", "managed_key_id"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("key_bits"); ok {
+		data["key_bits"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Generating key on PKI secret backend %q", backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating key on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Generated key on PKI secret backend %q", backend)
+
+	if keyType == "exported" {
+		d.Set("private_key", resp.Data["private_key"])
+	}
+
+	keyID, ok := resp.Data["key_id"].(string)
+	if !ok || keyID == "" {
+		return fmt.Errorf("no key ID returned when generating key on PKI secret backend %q", backend)
+	}
+
+	d.SetId(pkiSecretBackendKeyPath(backend, keyID))
+	return pkiSecretBackendKeyRead(d, meta)
+}
+
+func pkiSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := pkiSecretBackendKeyBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid key ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading PKI secret backend key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading PKI secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read PKI secret backend key %q", path)
+	if resp == nil {
+		log.Printf("[WARN] PKI secret backend key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("key_id", resp.Data["key_id"])
+	d.Set("key_name", resp.Data["key_name"])
+	d.Set("key_type", resp.Data["key_type"])
+	if v, ok := resp.Data["key_bits"]; ok {
+		d.Set("key_bits", v)
+	}
+	if v, ok := resp.Data["managed_key_name"]; ok {
+		d.Set("managed_key_name", v)
+	}
+	if v, ok := resp.Data["managed_key_id"]; ok {
+		d.Set("managed_key_id", v)
+	}
+
+	return nil
+}
+
+func pkiSecretBackendKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"key_name": d.Get("key_name").(string),
+	}
+
+	log.Printf("[DEBUG] Updating PKI secret backend key %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error updating PKI secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated PKI secret backend key %q", path)
+
+	return pkiSecretBackendKeyRead(d, meta)
+}
+
+func pkiSecretBackendKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting PKI secret backend key %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting PKI secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted PKI secret backend key %q", path)
+	return nil
+}
+
+func pkiSecretBackendKeyPath(backend string, keyID string) string {
+	return strings.Trim(backend, "/") + "/key/" + strings.Trim(keyID, "/")
+}
+
+func pkiSecretBackendKeyBackendFromPath(path string) (string, error) {
+	if !pkiSecretBackendKeyBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := pkiSecretBackendKeyBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPkiSecretBackendKey_basic(t *testing.T) {
+	path := "pki-" + acctest.RandString(10)
+	name := "key-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendKeyConfig_basic(path, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_key.test", "backend", path),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_key.test", "key_name", name),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_key.test", "key_type", "ec"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_key.test", "key_bits", "256"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_key.test", "key_id"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendKeyConfig_basic(path, name+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_key.test", "key_name", name+"-updated"),
+				),
+			},
+			{
+				ResourceName:            "vault_pki_secret_backend_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"type"},
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendKeyConfig_basic(path string, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                      = "%s"
+  type                      = "pki"
+  description               = "test"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds     = "86400"
+}
+
+resource "vault_pki_secret_backend_key" "test" {
+  backend  = "${vault_mount.test.path}"
+  type     = "internal"
+  key_name = "%s"
+  key_type = "ec"
+  key_bits = 256
+}`, path, name)
+}
@@ -0,0 +1,525 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	pkiSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
+	pkiSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+)$")
+)
+
+// pkiSecretBackendRoleListFields are the role fields that Vault accepts and
+// returns as lists of strings. key_usage is handled separately since leaving
+// it unset must keep Vault's default rather than clear it.
+var pkiSecretBackendRoleListFields = []string{
+	"allowed_domains",
+	"allowed_uri_sans",
+	"allowed_other_sans",
+	"allowed_serial_numbers",
+	"ext_key_usage",
+	"ou",
+	"organization",
+	"country",
+	"locality",
+	"province",
+	"street_address",
+	"postal_code",
+	"policy_identifiers",
+}
+
+// pkiSecretBackendRoleBoolFields are the role fields that Vault accepts and
+// returns as booleans.
+var pkiSecretBackendRoleBoolFields = []string{
+	"allow_localhost",
+	"allowed_domains_template",
+	"allow_bare_domains",
+	"allow_subdomains",
+	"allow_glob_domains",
+	"allow_wildcard_certificates",
+	"allow_any_name",
+	"enforce_hostnames",
+	"allow_ip_sans",
+	"allowed_uri_sans_template",
+	"server_flag",
+	"client_flag",
+	"code_signing_flag",
+	"email_protection_flag",
+	"use_csr_common_name",
+	"use_csr_sans",
+	"generate_lease",
+	"no_store",
+	"no_store_metadata",
+	"require_cn",
+	"basic_constraints_valid_for_non_ca",
+}
+
+func pkiSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendRoleWrite,
+		Read:   pkiSecretBackendRoleRead,
+		Update: pkiSecretBackendRoleWrite,
+		Delete: pkiSecretBackendRoleDelete,
+		Exists: pkiSecretBackendRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI Secret Backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the role.",
+			},
+			"ttl": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The TTL.",
+				ValidateFunc:     validateDuration,
+				DiffSuppressFunc: durationDiffSuppress,
+			},
+			"max_ttl": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The maximum TTL.",
+				ValidateFunc:     validateDuration,
+				DiffSuppressFunc: durationDiffSuppress,
+			},
+			"not_before_duration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Duration by which to backdate the NotBefore property of issued certificates.",
+				DiffSuppressFunc: durationDiffSuppress,
+			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The issuer used to sign certificates issued by this role. Requires Vault 1.11 or later.",
+			},
+			"allow_localhost": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag to allow certificates for localhost.",
+			},
+			"allowed_domains": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The domains of the role.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allowed_domains_template": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to indicate that `allowed_domains` specifies a template expression (e.g. {{identity.entity.aliases.<mount accessor>.name}}).",
+			},
+			"allow_bare_domains": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to allow certificates matching the actual domain.",
+			},
+			"allow_subdomains": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to allow certificates matching subdomains.",
+			},
+			"allow_glob_domains": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to allow names containing glob patterns.",
+			},
+			"allow_wildcard_certificates": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag to allow wildcard certificates.",
+			},
+			"allow_any_name": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to allow any name.",
+			},
+			"enforce_hostnames": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag to allow only valid host names.",
+			},
+			"allow_ip_sans": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag to allow IP SANs.",
+			},
+			"allowed_uri_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Defines allowed URI SANs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allowed_uri_sans_template": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to indicate that `allowed_uri_sans` specifies a template expression.",
+			},
+			"allowed_other_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Defines allowed custom SANs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allowed_serial_numbers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Defines allowed Subject serial numbers.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"server_flag": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag to specify certificates for server use.",
+			},
+			"client_flag": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag to specify certificates for client use.",
+			},
+			"code_signing_flag": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to specify certificates for code signing use.",
+			},
+			"email_protection_flag": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to specify certificates for email protection use.",
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "rsa",
+				Description:  "The type of generated keys. Must be one of \"rsa\", \"ec\", \"ed25519\" or \"any\".",
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec", "ed25519", "any"}, false),
+			},
+			"key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     2048,
+				Description: "The number of bits of generated keys.",
+			},
+			"key_usage": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specify the allowed key usage constraint on issued certificates.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ext_key_usage": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specify the allowed extended key usage constraint on issued certificates.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"use_csr_common_name": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag to use the CN in the CSR.",
+			},
+			"use_csr_sans": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag to use the SANs in the CSR.",
+			},
+			"ou": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The organization unit of generated certificates.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"organization": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The organization of generated certificates.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"country": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The country of generated certificates.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"locality": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The locality of generated certificates.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"province": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The province of generated certificates.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"street_address": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The street address of generated certificates.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"postal_code": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The postal code of generated certificates.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"generate_lease": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to generate leases with certificates.",
+			},
+			"no_store": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to not store certificates in the storage backend.",
+			},
+			"no_store_metadata": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to not store the metadata of issued certificates. Requires Vault Enterprise 1.17 or later.",
+			},
+			"require_cn": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag to force CN usage.",
+			},
+			"policy_identifiers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specify the list of allowed policies OIDs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"basic_constraints_valid_for_non_ca": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to mark basic constraints valid when issuing non-CA certificates.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := pkiSecretBackendRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"key_type": d.Get("key_type").(string),
+		"key_bits": d.Get("key_bits").(int),
+	}
+	for _, k := range []string{"ttl", "max_ttl", "not_before_duration", "issuer_ref"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	for _, k := range pkiSecretBackendRoleListFields {
+		data[k] = toStringArray(d.Get(k).([]interface{}))
+	}
+	if v, ok := d.GetOk("key_usage"); ok {
+		data["key_usage"] = toStringArray(v.([]interface{}))
+	}
+	for _, k := range pkiSecretBackendRoleBoolFields {
+		data[k] = d.Get(k).(bool)
+	}
+
+	log.Printf("[DEBUG] Writing PKI secret backend role %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing PKI secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote PKI secret backend role %q", path)
+
+	d.SetId(path)
+	return pkiSecretBackendRoleRead(d, meta)
+}
+
+func pkiSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := pkiSecretBackendRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+	name, err := pkiSecretBackendRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading PKI secret backend role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading PKI secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read PKI secret backend role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] PKI secret backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	d.Set("key_type", resp.Data["key_type"])
+	d.Set("key_bits", resp.Data["key_bits"])
+	for _, k := range []string{"ttl", "max_ttl", "not_before_duration", "issuer_ref"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+	for _, k := range append(pkiSecretBackendRoleListFields, "key_usage") {
+		// Vault returns these as null instead of an empty list when unset,
+		// and older versions return some of them as comma-separated strings.
+		var values []string
+		switch v := resp.Data[k].(type) {
+		case []interface{}:
+			values = jsonStringArrayToStringArray(v)
+		case string:
+			if v != "" {
+				values = strings.Split(v, ",")
+			}
+		}
+		if err := d.Set(k, values); err != nil {
+			return fmt.Errorf("error setting %s in state: %s", k, err)
+		}
+	}
+	for _, k := range pkiSecretBackendRoleBoolFields {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting PKI secret backend role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting PKI secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted PKI secret backend role %q", path)
+	return nil
+}
+
+func pkiSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if PKI secret backend role %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if PKI secret backend role %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if PKI secret backend role %q exists", path)
+	return resp != nil, nil
+}
+
+func pkiSecretBackendRolePath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/roles/" + strings.Trim(name, "/")
+}
+
+func pkiSecretBackendRoleNameFromPath(path string) (string, error) {
+	if !pkiSecretBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := pkiSecretBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
+
+func pkiSecretBackendRoleBackendFromPath(path string) (string, error) {
+	if !pkiSecretBackendRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := pkiSecretBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,124 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccPkiSecretBackendRole_basic(t *testing.T) {
+	backend := "pki-" + acctest.RandString(10)
+	name := acctest.RandomWithPrefix("tf-test-role")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccPkiSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendRoleConfig_basic(name, backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "ttl", "3600"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "max_ttl", "7200"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "allowed_domains.#", "2"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "allowed_domains.0", "test.domain"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "allow_subdomains", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "allow_bare_domains", "false"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "key_usage.#", "3"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "ou.#", "1"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "ou.0", "test"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendRoleConfig_updated(name, backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "ttl", "1800"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "allowed_domains.#", "1"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "allowed_domains.0", "{{identity.entity.name}}.test.domain"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "allowed_domains_template", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "allow_bare_domains", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "key_usage.#", "1"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "key_usage.0", "DigitalSignature"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "ext_key_usage.#", "1"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "ext_key_usage.0", "ServerAuth"),
+				),
+			},
+			{
+				ResourceName:      "vault_pki_secret_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPkiSecretBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_pki_secret_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testPkiSecretBackendRoleConfig_basic(name, path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "pki" {
+  path                      = "%s"
+  type                      = "pki"
+  default_lease_ttl_seconds = 3600
+  max_lease_ttl_seconds     = 86400
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend            = "${vault_mount.pki.path}"
+  name               = "%s"
+  ttl                = "1h"
+  max_ttl            = "7200"
+  allowed_domains    = ["test.domain", "other.domain"]
+  allow_subdomains   = true
+  allow_bare_domains = false
+  ou                 = ["test"]
+  organization       = ["test"]
+}`, path, name)
+}
+
+func testPkiSecretBackendRoleConfig_updated(name, path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "pki" {
+  path                      = "%s"
+  type                      = "pki"
+  default_lease_ttl_seconds = 3600
+  max_lease_ttl_seconds     = 86400
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend                  = "${vault_mount.pki.path}"
+  name                     = "%s"
+  ttl                      = "1800"
+  max_ttl                  = "7200"
+  allowed_domains          = ["{{identity.entity.name}}.test.domain"]
+  allowed_domains_template = true
+  allow_subdomains         = true
+  allow_bare_domains       = true
+  key_usage                = ["DigitalSignature"]
+  ext_key_usage            = ["ServerAuth"]
+  ou                       = ["test"]
+  organization             = ["test"]
+}`, path, name)
+}
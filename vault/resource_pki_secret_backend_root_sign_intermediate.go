@@ -0,0 +1,319 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendRootSignIntermediateResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendRootSignIntermediateCreate,
+		Read:   pkiSecretBackendRootSignIntermediateRead,
+		Update: pkiSecretBackendRootSignIntermediateUpdate,
+		Delete: pkiSecretBackendRootSignIntermediateDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"csr": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CSR.",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CN of intermediate to create.",
+			},
+			"alt_names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of alternative names.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ip_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of alternative IPs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"uri_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of alternative URIs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"other_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of other SANs, in the format <oid>;<type>:<value>.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Time to live.",
+				ValidateFunc: validateDuration,
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "pem",
+				Description:  "The format of data. Must be one of \"pem\", \"der\" or \"pem_bundle\".",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "der", "pem_bundle"}, false),
+			},
+			"max_path_length": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     -1,
+				Description: "The maximum path length to encode in the generated certificate.",
+			},
+			"exclude_cn_from_sans": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Flag to exclude CN from SANs.",
+			},
+			"use_csr_values": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Preserve CSR values.",
+			},
+			"permitted_dns_domains": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of domains for which certificates are allowed to be issued.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The issuer to sign the CSR with. Requires Vault 1.11 or later.",
+			},
+			"ou": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The organization unit.",
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The organization.",
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The country.",
+			},
+			"locality": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The locality.",
+			},
+			"province": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The province.",
+			},
+			"street_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The street address.",
+			},
+			"postal_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The postal code.",
+			},
+			"revoke": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Revoke the certificate upon resource destruction.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The signed intermediate CA certificate.",
+			},
+			"issuing_ca": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The issuing CA certificate.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CA chain of the signed certificate, including the issuing CA.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"serial": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the signed certificate.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendRootSignIntermediateCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := clientWithTimeout(meta.(*api.Client), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	backend := d.Get("backend").(string)
+
+	if _, ok := d.GetOk("issuer_ref"); ok {
+		if err := checkVaultVersion(meta.(*api.Client), "1.11.0", "issuer_ref"); err != nil {
+			return err
+		}
+	}
+
+	path := pkiSecretBackendRootSignIntermediatePath(backend, d.Get("issuer_ref").(string))
+
+	data := map[string]interface{}{
+		"csr":                   d.Get("csr").(string),
+		"common_name":           d.Get("common_name").(string),
+		"alt_names":             strings.Join(toStringArray(d.Get("alt_names").([]interface{})), ","),
+		"ip_sans":               strings.Join(toStringArray(d.Get("ip_sans").([]interface{})), ","),
+		"uri_sans":              strings.Join(toStringArray(d.Get("uri_sans").([]interface{})), ","),
+		"other_sans":            strings.Join(toStringArray(d.Get("other_sans").([]interface{})), ","),
+		"ttl":                   d.Get("ttl").(string),
+		"format":                d.Get("format").(string),
+		"max_path_length":       d.Get("max_path_length").(int),
+		"exclude_cn_from_sans":  d.Get("exclude_cn_from_sans").(bool),
+		"use_csr_values":        d.Get("use_csr_values").(bool),
+		"permitted_dns_domains": strings.Join(toStringArray(d.Get("permitted_dns_domains").([]interface{})), ","),
+		"ou":                    d.Get("ou").(string),
+		"organization":          d.Get("organization").(string),
+		"country":               d.Get("country").(string),
+		"locality":              d.Get("locality").(string),
+		"province":              d.Get("province").(string),
+		"street_address":        d.Get("street_address").(string),
+		"postal_code":           d.Get("postal_code").(string),
+	}
+
+	log.Printf("[DEBUG] Signing intermediate certificate on PKI secret backend %q", backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error signing intermediate certificate on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Signed intermediate certificate on PKI secret backend %q", backend)
+
+	d.Set("certificate", resp.Data["certificate"])
+	d.Set("issuing_ca", resp.Data["issuing_ca"])
+	d.Set("serial", resp.Data["serial_number"])
+
+	caChain := []string{}
+	if v, ok := resp.Data["ca_chain"].([]interface{}); ok {
+		caChain = jsonStringArrayToStringArray(v)
+	} else if issuingCA, ok := resp.Data["issuing_ca"].(string); ok {
+		// older versions of Vault don't return the chain
+		caChain = append(caChain, issuingCA)
+	}
+	if err := d.Set("ca_chain", caChain); err != nil {
+		return fmt.Errorf("error setting ca_chain in state: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", backend, resp.Data["serial_number"]))
+	return pkiSecretBackendRootSignIntermediateRead(d, meta)
+}
+
+func pkiSecretBackendRootSignIntermediateRead(d *schema.ResourceData, meta interface{}) error {
+	// the signing response can't be read back from the API,
+	// so there is nothing to refresh here.
+	return nil
+}
+
+func pkiSecretBackendRootSignIntermediateUpdate(d *schema.ResourceData, meta interface{}) error {
+	// revoke is the only attribute that can be updated, and it's only
+	// used when the resource is destroyed.
+	return nil
+}
+
+func pkiSecretBackendRootSignIntermediateDelete(d *schema.ResourceData, meta interface{}) error {
+	if !d.Get("revoke").(bool) {
+		return nil
+	}
+
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	serial := d.Get("serial").(string)
+
+	return pkiSecretBackendRevokeCert(client, backend, serial)
+}
+
+func pkiSecretBackendRevokeCert(client *api.Client, backend string, serial string) error {
+	path := strings.Trim(backend, "/") + "/revoke"
+
+	log.Printf("[DEBUG] Revoking certificate %q on PKI secret backend %q", serial, backend)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"serial_number": serial,
+	})
+	if err != nil {
+		return fmt.Errorf("error revoking certificate %q on PKI secret backend %q: %s", serial, backend, err)
+	}
+	log.Printf("[DEBUG] Revoked certificate %q on PKI secret backend %q", serial, backend)
+
+	return nil
+}
+
+func pkiSecretBackendRootSignIntermediatePath(backend string, issuerRef string) string {
+	if issuerRef != "" {
+		return strings.Trim(backend, "/") + "/issuer/" + strings.Trim(issuerRef, "/") + "/sign-intermediate"
+	}
+	return strings.Trim(backend, "/") + "/root/sign-intermediate"
+}
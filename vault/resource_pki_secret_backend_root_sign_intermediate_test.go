@@ -0,0 +1,105 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccPkiSecretBackendRootSignIntermediate_basic(t *testing.T) {
+	rootPath := "pki-root-" + acctest.RandString(10)
+	intermediatePath := "pki-intermediate-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPkiSecretBackendRootMount(t, rootPath)
+		},
+		CheckDestroy: testAccPkiSecretBackendRootUnmount(rootPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendRootSignIntermediateConfig_basic(rootPath, intermediatePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_root_sign_intermediate.test", "backend", rootPath),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_root_sign_intermediate.test", "common_name", "SubOrg Intermediate CA"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_root_sign_intermediate.test", "certificate"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_root_sign_intermediate.test", "issuing_ca"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_root_sign_intermediate.test", "serial"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_root_sign_intermediate.test", "ca_chain.#", "1"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_intermediate_set_signed.test", "certificate"),
+				),
+			},
+		},
+	})
+}
+
+// testAccPkiSecretBackendRootMount mounts a PKI secret backend with an
+// internally generated root CA, since there's no resource for generating one.
+func testAccPkiSecretBackendRootMount(t *testing.T, path string) {
+	client, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.Sys().Mount(path, &api.MountInput{
+		Type: "pki",
+		Config: api.MountConfigInput{
+			MaxLeaseTTL: "87600h",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Logical().Write(path+"/root/generate/internal", map[string]interface{}{
+		"common_name": "Root CA",
+		"ttl":         "87600h",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccPkiSecretBackendRootUnmount(path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+		return client.Sys().Unmount(path)
+	}
+}
+
+func testPkiSecretBackendRootSignIntermediateConfig_basic(rootPath string, intermediatePath string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test-intermediate" {
+  path                      = "%s"
+  type                      = "pki"
+  description               = "test intermediate"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds     = "86400"
+}
+
+resource "vault_pki_secret_backend_intermediate_cert_request" "test" {
+  backend     = "${vault_mount.test-intermediate.path}"
+  type        = "internal"
+  common_name = "SubOrg Intermediate CA"
+}
+
+resource "vault_pki_secret_backend_root_sign_intermediate" "test" {
+  backend              = "%s"
+  csr                  = "${vault_pki_secret_backend_intermediate_cert_request.test.csr}"
+  common_name          = "SubOrg Intermediate CA"
+  exclude_cn_from_sans = true
+  ou                   = "SubOrg"
+  organization         = "ACME Ltd"
+  revoke               = true
+}
+
+resource "vault_pki_secret_backend_intermediate_set_signed" "test" {
+  backend     = "${vault_mount.test-intermediate.path}"
+  certificate = "${vault_pki_secret_backend_root_sign_intermediate.test.certificate}"
+}`, intermediatePath, rootPath)
+}
@@ -0,0 +1,255 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendSignResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendSignCreate,
+		Read:   pkiSecretBackendSignRead,
+		Update: pkiSecretBackendSignUpdate,
+		Delete: pkiSecretBackendSignDelete,
+
+		Schema: withRevokeLeaseSchema(map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role to sign the CSR against.",
+			},
+			"csr": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CSR.",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CN of the certificate to create.",
+			},
+			"alt_names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of alternative names.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ip_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of alternative IPs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"uri_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of alternative URIs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"other_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of other SANs, in the format <oid>;<type>:<value>.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Time to live.",
+				ValidateFunc: validateDuration,
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "pem",
+				Description:  "The format of data. Must be one of \"pem\", \"der\" or \"pem_bundle\".",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "der", "pem_bundle"}, false),
+			},
+			"exclude_cn_from_sans": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Flag to exclude CN from SANs.",
+			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The issuer to sign the CSR with, instead of the role's. Requires Vault 1.11 or later.",
+			},
+			"auto_renew": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If enabled, a new certificate will be generated if the expiration is within min_seconds_remaining.",
+			},
+			"min_seconds_remaining": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     604800,
+				Description: "Generate a new certificate when the expiration is within this number of seconds.",
+			},
+			"revoke": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Revoke the certificate upon resource destruction. A certificate replaced by auto_renew is not revoked.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The certificate.",
+			},
+			"issuing_ca": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The issuing CA.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The CA chain.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The serial number.",
+			},
+			"expiration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The certificate expiration as a Unix timestamp.",
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the lease of the certificate, if the role generates leases.",
+			},
+		}),
+	}
+}
+
+func pkiSecretBackendSignCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	if _, ok := d.GetOk("issuer_ref"); ok {
+		if err := checkVaultVersion(client, "1.11.0", "issuer_ref"); err != nil {
+			return err
+		}
+	}
+
+	path := pkiSecretBackendSignPath(backend, name, d.Get("issuer_ref").(string))
+
+	data := map[string]interface{}{
+		"csr":                  d.Get("csr").(string),
+		"common_name":          d.Get("common_name").(string),
+		"alt_names":            strings.Join(toStringArray(d.Get("alt_names").([]interface{})), ","),
+		"ip_sans":              strings.Join(toStringArray(d.Get("ip_sans").([]interface{})), ","),
+		"uri_sans":             strings.Join(toStringArray(d.Get("uri_sans").([]interface{})), ","),
+		"other_sans":           strings.Join(toStringArray(d.Get("other_sans").([]interface{})), ","),
+		"ttl":                  d.Get("ttl").(string),
+		"format":               d.Get("format").(string),
+		"exclude_cn_from_sans": d.Get("exclude_cn_from_sans").(bool),
+	}
+
+	log.Printf("[DEBUG] Signing certificate %q by %q on PKI secret backend %q", d.Get("common_name").(string), name, backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error signing certificate %q by %q for PKI secret backend %q: %s", d.Get("common_name").(string), name, backend, err)
+	}
+	log.Printf("[DEBUG] Signed certificate %q by %q on PKI secret backend %q", d.Get("common_name").(string), name, backend)
+
+	if err := pkiSecretBackendSetCertData(d, resp); err != nil {
+		return err
+	}
+
+	// the renewal check in read is deliberately skipped here, a freshly
+	// signed certificate must never be dropped from state straight away.
+	d.SetId(fmt.Sprintf("%s/%s", backend, resp.Data["serial_number"]))
+	return nil
+}
+
+func pkiSecretBackendSignRead(d *schema.ResourceData, meta interface{}) error {
+	// the signed certificate can't be read back from the API,
+	// all we can do is check whether it is due for renewal. It is not
+	// revoked, even if revoke is set: this runs on every refresh, plans
+	// included, and the certificate stays in use until it is replaced.
+	if pkiSecretBackendCertNeedsRenewal(d) {
+		log.Printf("[WARN] Signed certificate %q is due for renewal, removing from state without revoking it", d.Id())
+		d.SetId("")
+	}
+	return nil
+}
+
+func pkiSecretBackendSignUpdate(d *schema.ResourceData, meta interface{}) error {
+	// only the renewal and revocation settings can be updated, they
+	// don't need to be sent to Vault.
+	return pkiSecretBackendSignRead(d, meta)
+}
+
+func pkiSecretBackendSignDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	// revoking the lease revokes the certificate too
+	if revoked, err := revokeLease(d, client); err != nil || revoked {
+		return err
+	}
+
+	if !d.Get("revoke").(bool) {
+		return nil
+	}
+
+	backend := d.Get("backend").(string)
+	serial := d.Get("serial_number").(string)
+
+	return pkiSecretBackendRevokeCert(client, backend, serial)
+}
+
+func pkiSecretBackendSignPath(backend string, name string, issuerRef string) string {
+	if issuerRef != "" {
+		return strings.Trim(backend, "/") + "/issuer/" + strings.Trim(issuerRef, "/") + "/sign/" + strings.Trim(name, "/")
+	}
+	return strings.Trim(backend, "/") + "/sign/" + strings.Trim(name, "/")
+}
@@ -0,0 +1,64 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// A CSR for test.my.domain whose private key was thrown away.
+const testPkiSecretBackendSignCSR = `EOF
+cat /tmp/test.csr
+cat <<'EOF'
+`
+
+func TestAccPkiSecretBackendSign_basic(t *testing.T) {
+	rootPath := "pki-root-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPkiSecretBackendRootMount(t, rootPath)
+		},
+		CheckDestroy: testAccPkiSecretBackendRootUnmount(rootPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendSignConfig_basic(rootPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_sign.test", "backend", rootPath),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_sign.test", "common_name", "test.my.domain"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_sign.test", "certificate"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_sign.test", "issuing_ca"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_sign.test", "serial_number"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_sign.test", "expiration"),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendSignConfig_basic(rootPath string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend_role" "test" {
+  backend            = "%s"
+  name               = "test"
+  allowed_domains    = ["test.my.domain"]
+  allow_bare_domains = true
+  max_ttl            = "3600"
+}
+
+resource "vault_pki_secret_backend_sign" "test" {
+  depends_on = ["vault_pki_secret_backend_role.test"]
+
+  backend     = "%s"
+  name        = "${vault_pki_secret_backend_role.test.name}"
+  csr         = <<EOT
+%sEOT
+  common_name = "test.my.domain"
+  ttl         = "3600"
+  revoke      = true
+}`, rootPath, rootPath, testPkiSecretBackendSignCSR)
+}
@@ -0,0 +1,199 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+const quotaRateLimitPath = "sys/quotas/rate-limit"
+
+func quotaRateLimitResource() *schema.Resource {
+	return &schema.Resource{
+		Create: quotaRateLimitWrite,
+		Update: quotaRateLimitWrite,
+		Read:   quotaRateLimitRead,
+		Delete: quotaRateLimitDelete,
+		Exists: quotaRateLimitExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the quota.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the namespace or mount the quota applies to, e.g. auth/userpass/. Empty for a global quota.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Role of the auth backend of path the quota applies to the logins of. Requires Vault 1.12 or later.",
+			},
+			"rate": {
+				Type:        schema.TypeFloat,
+				Required:    true,
+				Description: "Maximum number of requests allowed per interval.",
+			},
+			"interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Number of seconds of the window the rate applies to. Defaults to 1.",
+			},
+			"block_interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Number of seconds a client is blocked for after exceeding the rate.",
+			},
+			"inheritable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the quota applies to the child namespaces of path. Requires Vault Enterprise 1.15 or later.",
+			},
+			"group_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "How the requests are grouped to apply the rate, one of ip, none, entity_then_ip or entity_then_none. Requires Vault Enterprise 1.18 or later.",
+				ValidateFunc: validation.StringInSlice([]string{"ip", "none", "entity_then_ip", "entity_then_none"}, false),
+			},
+			"secondary_rate": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of requests per interval of the requests without an entity, when group_by is entity_then_ip or entity_then_none. Requires Vault Enterprise 1.18 or later.",
+			},
+		},
+	}
+}
+
+func quotaRateLimitWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultVersion(client, "1.5.0", "vault_quota_rate_limit"); err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	path := quotaRateLimitPath + "/" + name
+
+	data := map[string]interface{}{
+		"path":           d.Get("path").(string),
+		"rate":           d.Get("rate").(float64),
+		"block_interval": d.Get("block_interval").(int),
+	}
+	if v, ok := d.GetOk("interval"); ok {
+		data["interval"] = v.(int)
+	}
+	if v, ok := d.GetOk("role"); ok {
+		if err := checkVaultVersion(client, "1.12.0", "role"); err != nil {
+			return err
+		}
+		data["role"] = v.(string)
+	}
+	// the Enterprise fields are computed, so they are only sent when they
+	// were configured, to keep updating the quota on older or CE servers.
+	if v, ok := d.GetOkExists("inheritable"); ok && d.HasChange("inheritable") {
+		if err := checkVaultEnterprise(client, "1.15.0", "inheritable"); err != nil {
+			return err
+		}
+		data["inheritable"] = v.(bool)
+	}
+	for _, k := range []string{"group_by", "secondary_rate"} {
+		if v, ok := d.GetOk(k); ok && d.HasChange(k) {
+			if err := checkVaultEnterprise(client, "1.18.0", k); err != nil {
+				return err
+			}
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing rate limit quota %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing rate limit quota %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote rate limit quota %q", path)
+
+	d.SetId(name)
+
+	return quotaRateLimitRead(d, meta)
+}
+
+func quotaRateLimitRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := quotaRateLimitPath + "/" + name
+
+	log.Printf("[DEBUG] Reading rate limit quota %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading rate limit quota %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read rate limit quota %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Rate limit quota %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	for _, k := range []string{"path", "role", "interval", "block_interval", "inheritable", "group_by"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+	for _, k := range []string{"rate", "secondary_rate"} {
+		n, ok := resp.Data[k].(json.Number)
+		if !ok {
+			continue
+		}
+		v, err := n.Float64()
+		if err != nil {
+			return fmt.Errorf("unexpected value %q for %s of rate limit quota %q", n, k, path)
+		}
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func quotaRateLimitDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := quotaRateLimitPath + "/" + d.Id()
+
+	log.Printf("[DEBUG] Deleting rate limit quota %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting rate limit quota %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted rate limit quota %q", path)
+
+	return nil
+}
+
+func quotaRateLimitExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := quotaRateLimitPath + "/" + d.Id()
+
+	log.Printf("[DEBUG] Checking if rate limit quota %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if rate limit quota %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if rate limit quota %q exists", path)
+
+	return resp != nil, nil
+}
@@ -0,0 +1,101 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-vault/testutil"
+)
+
+func TestAccQuotaRateLimit_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccQuotaRateLimitCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuotaRateLimitConfig(name, 10, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.test", "name", name),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.test", "path", ""),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.test", "rate", "10"),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.test", "interval", "1"),
+				),
+			},
+			{
+				Config: testAccQuotaRateLimitConfig(name, 12.5, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.test", "rate", "12.5"),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.test", "interval", "60"),
+				),
+			},
+			{
+				ResourceName:      "vault_quota_rate_limit.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuotaRateLimit_groupBy(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t); testAccEnterprisePreCheck(t) },
+		CheckDestroy: testAccQuotaRateLimitCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_quota_rate_limit" "test" {
+  name           = "%s"
+  rate           = 100
+  inheritable    = true
+  group_by       = "entity_then_ip"
+  secondary_rate = 10
+}
+`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.test", "inheritable", "true"),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.test", "group_by", "entity_then_ip"),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.test", "secondary_rate", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQuotaRateLimitCheckDestroy(s *terraform.State) error {
+	client, err := testutil.NewClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_quota_rate_limit" {
+			continue
+		}
+		resp, err := client.Logical().Read(quotaRateLimitPath + "/" + rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("rate limit quota %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccQuotaRateLimitConfig(name string, rate float64, interval int) string {
+	return fmt.Sprintf(`
+resource "vault_quota_rate_limit" "test" {
+  name     = "%s"
+  rate     = %g
+  interval = %d
+}
+`, name, rate, interval)
+}
@@ -34,7 +34,6 @@ func rabbitmqSecretBackendResource() *schema.Resource {
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "Human-friendly description of the mount for the backend.",
 			},
 			"default_lease_ttl_seconds": {
@@ -53,20 +52,17 @@ func rabbitmqSecretBackendResource() *schema.Resource {
 			"connection_uri": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "Specifies the RabbitMQ connection URI.",
 			},
 			"username": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Sensitive:   true,
 				Description: "Specifies the RabbitMQ management administrator username",
 			},
 			"password": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Sensitive:   true,
 				Description: "Specifies the RabbitMQ management administrator password",
 			},
@@ -74,9 +70,18 @@ func rabbitmqSecretBackendResource() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     true,
-				ForceNew:    true,
 				Description: "Specifies whether to verify connection URI, username, and password.",
 			},
+			"password_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the password policy used to generate passwords for dynamic credentials.",
+			},
+			"username_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Template used to generate the usernames of dynamic credentials.",
+			},
 		},
 	}
 }
@@ -92,10 +97,12 @@ func rabbitmqSecretBackendCreate(d *schema.ResourceData, meta interface{}) error
 	username := d.Get("username").(string)
 	password := d.Get("password").(string)
 	verifyConnection := d.Get("verify_connection").(bool)
+	passwordPolicy := d.Get("password_policy").(string)
+	usernameTemplate := d.Get("username_template").(string)
 
 	d.Partial(true)
 	log.Printf("[DEBUG] Mounting Rabbitmq backend at %q", path)
-	err := client.Sys().Mount(path, &api.MountInput{
+	err := mountSecretBackend(client, path, &api.MountInput{
 		Type:        "rabbitmq",
 		Description: description,
 		Config: api.MountConfigInput{
@@ -120,6 +127,8 @@ func rabbitmqSecretBackendCreate(d *schema.ResourceData, meta interface{}) error
 		"username":          username,
 		"password":          password,
 		"verify_connection": verifyConnection,
+		"password_policy":   passwordPolicy,
+		"username_template": usernameTemplate,
 	}
 	_, err = client.Logical().Write(path+"/config/connection", data)
 	if err != nil {
@@ -130,6 +139,8 @@ func rabbitmqSecretBackendCreate(d *schema.ResourceData, meta interface{}) error
 	d.SetPartial("username")
 	d.SetPartial("password")
 	d.SetPartial("verify_connection")
+	d.SetPartial("password_policy")
+	d.SetPartial("username_template")
 	d.Partial(false)
 	return rabbitmqSecretBackendRead(d, meta)
 }
@@ -140,7 +151,7 @@ func rabbitmqSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 	path := d.Id()
 
 	log.Printf("[DEBUG] Reading RabbitMQ secret backend mount %q from Vault", path)
-	mounts, err := client.Sys().ListMounts()
+	mounts, err := listMounts(client)
 	if err != nil {
 		return fmt.Errorf("error reading mount %q: %s", path, err)
 	}
@@ -168,27 +179,35 @@ func rabbitmqSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error
 
 	path := d.Id()
 	d.Partial(true)
-	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") {
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") || d.HasChange("description") {
 		config := api.MountConfigInput{
 			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
 			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
 		}
-		log.Printf("[DEBUG] Updating lease TTLs for %q", path)
-		err := client.Sys().TuneMount(path, config)
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			config.Description = &description
+		}
+		log.Printf("[DEBUG] Tuning mount %q", path)
+		err := tuneMount(client, path, config)
 		if err != nil {
-			return fmt.Errorf("error updating mount TTLs for %q: %s", path, err)
+			return fmt.Errorf("error tuning mount %q: %s", path, err)
 		}
-		log.Printf("[DEBUG] Updated lease TTLs for %q", path)
+		log.Printf("[DEBUG] Tuned mount %q", path)
 		d.SetPartial("default_lease_ttl_seconds")
 		d.SetPartial("max_lease_ttl_seconds")
+		d.SetPartial("description")
 	}
-	if d.HasChange("connection_uri") || d.HasChange("username") || d.HasChange("password") || d.HasChange("verify_connection") {
+	if d.HasChange("connection_uri") || d.HasChange("username") || d.HasChange("password") || d.HasChange("verify_connection") ||
+		d.HasChange("password_policy") || d.HasChange("username_template") {
 		log.Printf("[DEBUG] Updating connecion credentials at %q", path+"/config/connection")
 		data := map[string]interface{}{
 			"connection_uri":    d.Get("connection_uri").(string),
 			"username":          d.Get("username").(string),
 			"password":          d.Get("password").(string),
 			"verify_connection": d.Get("verify_connection").(bool),
+			"password_policy":   d.Get("password_policy").(string),
+			"username_template": d.Get("username_template").(string),
 		}
 		_, err := client.Logical().Write(path+"/config/connection", data)
 		if err != nil {
@@ -199,6 +218,8 @@ func rabbitmqSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error
 		d.SetPartial("username")
 		d.SetPartial("password")
 		d.SetPartial("verify_connection")
+		d.SetPartial("password_policy")
+		d.SetPartial("username_template")
 	}
 	d.Partial(false)
 	return rabbitmqSecretBackendRead(d, meta)
@@ -209,7 +230,7 @@ func rabbitmqSecretBackendDelete(d *schema.ResourceData, meta interface{}) error
 
 	path := d.Id()
 	log.Printf("[DEBUG] Unmounting RabbitMQ backend %q", path)
-	err := client.Sys().Unmount(path)
+	err := unmountSecretBackend(client, path)
 	if err != nil {
 		return fmt.Errorf("error unmounting RabbitMQ backend from %q: %s", path, err)
 	}
@@ -222,7 +243,7 @@ func rabbitmqSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool
 
 	path := d.Id()
 	log.Printf("[DEBUG] Checking if RabbitMQ backend exists at %q", path)
-	mounts, err := client.Sys().ListMounts()
+	mounts, err := listMounts(client)
 	if err != nil {
 		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
 	}
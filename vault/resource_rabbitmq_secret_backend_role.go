@@ -1,8 +1,10 @@
 package vault
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -40,10 +42,80 @@ func rabbitmqSecretBackendRoleResource() *schema.Resource {
 				Description: "Specifies a comma-separated RabbitMQ management tags.",
 			},
 			"vhosts": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Specifies a map of virtual hosts to permissions.",
-				Default:     "",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Specifies a map of virtual hosts to permissions.",
+				Deprecated:    "use vhost blocks instead",
+				ConflictsWith: []string{"vhost", "vhost_topic"},
+			},
+			"vhost": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "Permissions on a virtual host.",
+				ConflictsWith: []string{"vhosts"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The virtual host.",
+						},
+						"configure": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Regex of the resources that can be configured.",
+						},
+						"write": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Regex of the resources that can be written to.",
+						},
+						"read": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Regex of the resources that can be read from.",
+						},
+					},
+				},
+			},
+			"vhost_topic": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "Topic permissions on a virtual host.",
+				ConflictsWith: []string{"vhosts"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The virtual host.",
+						},
+						"vhost": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "Permissions on the topics of the exchange.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The topic exchange.",
+									},
+									"write": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Regex of the routing keys that can be published to.",
+									},
+									"read": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Regex of the routing keys that can be consumed from.",
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -61,6 +133,20 @@ func rabbitmqSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) er
 		"tags":   tags,
 		"vhosts": vhosts,
 	}
+	if v, ok := d.GetOk("vhost"); ok {
+		rendered, err := json.Marshal(rabbitmqSecretBackendRoleExpandVhosts(v.([]interface{})))
+		if err != nil {
+			return fmt.Errorf("error encoding vhost blocks: %s", err)
+		}
+		data["vhosts"] = string(rendered)
+	}
+	if v, ok := d.GetOk("vhost_topic"); ok {
+		rendered, err := json.Marshal(rabbitmqSecretBackendRoleExpandVhostTopics(v.([]interface{})))
+		if err != nil {
+			return fmt.Errorf("error encoding vhost_topic blocks: %s", err)
+		}
+		data["vhost_topics"] = string(rendered)
+	}
 	log.Printf("[DEBUG] Creating role %q on Rabbitmq backend %q", name, backend)
 	_, err := client.Logical().Write(backend+"/roles/"+name, data)
 	if err != nil {
@@ -71,7 +157,6 @@ func rabbitmqSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) er
 	d.SetId(backend + "/roles/" + name)
 	d.Set("name", name)
 	d.Set("tags", tags)
-	d.Set("vhosts", vhosts)
 	d.Set("backend", backend)
 	return rabbitmqSecretBackendRoleRead(d, meta)
 }
@@ -97,7 +182,27 @@ func rabbitmqSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) err
 		return nil
 	}
 	d.Set("tags", secret.Data["tags"])
-	d.Set("vhosts", secret.Data["vhosts"])
+	// newer versions of Vault return the permissions as maps, which we can
+	// only compare with the legacy JSON string by decoding it again.
+	switch v := secret.Data["vhosts"].(type) {
+	case string:
+		d.Set("vhosts", v)
+	case map[string]interface{}:
+		if legacy, ok := d.GetOk("vhosts"); ok {
+			var configured map[string]interface{}
+			if err := json.Unmarshal([]byte(legacy.(string)), &configured); err != nil || !rabbitmqSecretBackendRoleVhostsEqual(configured, v) {
+				rendered, _ := json.Marshal(v)
+				d.Set("vhosts", string(rendered))
+			}
+		} else if err := d.Set("vhost", rabbitmqSecretBackendRoleFlattenVhosts(v)); err != nil {
+			return fmt.Errorf("error setting vhost in state: %s", err)
+		}
+	}
+	if v, ok := secret.Data["vhost_topics"].(map[string]interface{}); ok {
+		if err := d.Set("vhost_topic", rabbitmqSecretBackendRoleFlattenVhostTopics(v)); err != nil {
+			return fmt.Errorf("error setting vhost_topic in state: %s", err)
+		}
+	}
 	d.Set("backend", strings.Join(pathPieces[:len(pathPieces)-2], "/"))
 	d.Set("name", pathPieces[len(pathPieces)-1])
 	return nil
@@ -128,3 +233,91 @@ func rabbitmqSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (
 	log.Printf("[DEBUG] Checked if %q exists", path)
 	return secret != nil, nil
 }
+
+// rabbitmqSecretBackendRoleExpandVhosts turns vhost blocks into the map of
+// virtual host to permissions the API expects.
+func rabbitmqSecretBackendRoleExpandVhosts(blocks []interface{}) map[string]interface{} {
+	vhosts := map[string]interface{}{}
+	for _, b := range blocks {
+		block := b.(map[string]interface{})
+		vhosts[block["host"].(string)] = map[string]interface{}{
+			"configure": block["configure"].(string),
+			"write":     block["write"].(string),
+			"read":      block["read"].(string),
+		}
+	}
+	return vhosts
+}
+
+// rabbitmqSecretBackendRoleExpandVhostTopics turns vhost_topic blocks into
+// the map of virtual host to topic permissions the API expects.
+func rabbitmqSecretBackendRoleExpandVhostTopics(blocks []interface{}) map[string]interface{} {
+	vhostTopics := map[string]interface{}{}
+	for _, b := range blocks {
+		block := b.(map[string]interface{})
+		topics := map[string]interface{}{}
+		for _, t := range block["vhost"].([]interface{}) {
+			topic := t.(map[string]interface{})
+			topics[topic["topic"].(string)] = map[string]interface{}{
+				"write": topic["write"].(string),
+				"read":  topic["read"].(string),
+			}
+		}
+		vhostTopics[block["host"].(string)] = topics
+	}
+	return vhostTopics
+}
+
+func rabbitmqSecretBackendRoleFlattenVhosts(vhosts map[string]interface{}) []map[string]interface{} {
+	blocks := []map[string]interface{}{}
+	for _, host := range rabbitmqSecretBackendRoleSortedKeys(vhosts) {
+		perms, _ := vhosts[host].(map[string]interface{})
+		blocks = append(blocks, map[string]interface{}{
+			"host":      host,
+			"configure": perms["configure"],
+			"write":     perms["write"],
+			"read":      perms["read"],
+		})
+	}
+	return blocks
+}
+
+func rabbitmqSecretBackendRoleFlattenVhostTopics(vhostTopics map[string]interface{}) []map[string]interface{} {
+	blocks := []map[string]interface{}{}
+	for _, host := range rabbitmqSecretBackendRoleSortedKeys(vhostTopics) {
+		topics, _ := vhostTopics[host].(map[string]interface{})
+		topicBlocks := []map[string]interface{}{}
+		for _, topic := range rabbitmqSecretBackendRoleSortedKeys(topics) {
+			perms, _ := topics[topic].(map[string]interface{})
+			topicBlocks = append(topicBlocks, map[string]interface{}{
+				"topic": topic,
+				"write": perms["write"],
+				"read":  perms["read"],
+			})
+		}
+		blocks = append(blocks, map[string]interface{}{
+			"host":  host,
+			"vhost": topicBlocks,
+		})
+	}
+	return blocks
+}
+
+// rabbitmqSecretBackendRoleVhostsEqual compares two decoded vhost maps,
+// ignoring how the JSON they came from was formatted.
+func rabbitmqSecretBackendRoleVhostsEqual(a, b map[string]interface{}) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(ja) == string(jb)
+}
+
+// the API returns maps, so sort the keys to keep the order of the blocks
+// stable between refreshes.
+func rabbitmqSecretBackendRoleSortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
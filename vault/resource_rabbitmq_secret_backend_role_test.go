@@ -30,7 +30,7 @@ func TestAccRabbitmqSecretBackendRole_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "name", fmt.Sprintf("%s", name)),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "backend", backend),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "tags", testAccRabbitmqSecretBackendRoleTags_basic),
-					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost", testAccRabbitmqSecretBackendRoleVhost_basic),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhosts", testAccRabbitmqSecretBackendRoleVhost_basic),
 				),
 			},
 			{
@@ -39,7 +39,7 @@ func TestAccRabbitmqSecretBackendRole_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "name", fmt.Sprintf("%s", name)),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "backend", backend),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "tags", testAccRabbitmqSecretBackendRoleTags_updated),
-					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost", testAccRabbitmqSecretBackendRoleVhost_updated),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhosts", testAccRabbitmqSecretBackendRoleVhost_updated),
 				),
 			},
 		},
@@ -61,7 +61,7 @@ func TestAccRabbitmqSecretBackendRole_import(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "name", fmt.Sprintf("%s", name)),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "backend", backend),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "tags", testAccRabbitmqSecretBackendRoleTags_basic),
-					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost", testAccRabbitmqSecretBackendRoleVhost_basic),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhosts", testAccRabbitmqSecretBackendRoleVhost_basic),
 				),
 			},
 			{
@@ -88,7 +88,7 @@ func TestAccRabbitmqSecretBackendRole_nested(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "name", fmt.Sprintf("%s", name)),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "backend", backend),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "tags", testAccRabbitmqSecretBackendRoleTags_basic),
-					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost", testAccRabbitmqSecretBackendRoleVhost_basic),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhosts", testAccRabbitmqSecretBackendRoleVhost_basic),
 				),
 			},
 			{
@@ -97,13 +97,48 @@ func TestAccRabbitmqSecretBackendRole_nested(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "name", fmt.Sprintf("%s", name)),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "backend", backend),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "tags", testAccRabbitmqSecretBackendRoleTags_updated),
-					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost", testAccRabbitmqSecretBackendRoleVhost_updated),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhosts", testAccRabbitmqSecretBackendRoleVhost_updated),
 				),
 			},
 		},
 	})
 }
 
+func TestAccRabbitmqSecretBackendRole_blocks(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-rabbitmq")
+	name := acctest.RandomWithPrefix("tf-test-rabbitmq")
+	connectionUri, username, password := getTestRMQCreds(t)
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccRabbitmqSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRabbitmqSecretBackendRoleConfig_blocks(name, backend, connectionUri, username, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost.#", "1"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost.0.host", "/"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost.0.configure", ""),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost.0.write", ".*"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost.0.read", ".*"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topic.#", "1"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topic.0.host", "/"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topic.0.vhost.#", "1"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topic.0.vhost.0.topic", "amq.topic"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topic.0.vhost.0.write", ".*"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topic.0.vhost.0.read", ".*"),
+				),
+			},
+			{
+				ResourceName:      "vault_rabbitmq_secret_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccRabbitmqSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -163,3 +198,38 @@ resource "vault_rabbitmq_secret_backend_role" "test" {
 }
 `, path, connectionUri, username, password, path, name, testAccRabbitmqSecretBackendRoleTags_updated, testAccRabbitmqSecretBackendRoleVhost_updated)
 }
+
+func testAccRabbitmqSecretBackendRoleConfig_blocks(name, path, connectionUri, username, password string) string {
+	return fmt.Sprintf(`
+resource "vault_rabbitmq_secret_backend" "test" {
+  path = "%s"
+  description = "test description"
+  connection_uri = "%s"
+  username = "%s"
+  password = "%s"
+}
+
+resource "vault_rabbitmq_secret_backend_role" "test" {
+  backend = "${vault_rabbitmq_secret_backend.test.path}"
+  name = "%s"
+  tags = "management"
+
+  vhost {
+    host = "/"
+    configure = ""
+    write = ".*"
+    read = ".*"
+  }
+
+  vhost_topic {
+    host = "/"
+
+    vhost {
+      topic = "amq.topic"
+      write = ".*"
+      read = ".*"
+    }
+  }
+}
+`, path, connectionUri, username, password, name)
+}
@@ -41,6 +41,7 @@ func TestAccRabbitmqSecretBackend_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "connection_uri", connectionUri),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "username", username),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "password", password),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "username_template", "{{ .RoleName }}-{{ random 8 }}"),
 				),
 			},
 		},
@@ -124,5 +125,6 @@ resource "vault_rabbitmq_secret_backend" "test" {
   connection_uri = "%s"
   username = "%s"
   password = "%s"
+  username_template = "{{ .RoleName }}-{{ random 8 }}"
 }`, path, connectionUri, username, password)
 }
@@ -0,0 +1,191 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var secretsSyncAssociationIDRegex = regexp.MustCompile("^type=(.+)::name=(.+)::mount=(.+)::secret_name=(.+)$")
+
+func secretsSyncAssociationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: secretsSyncAssociationCreate,
+		Read:   secretsSyncAssociationRead,
+		Delete: secretsSyncAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Type of the destination, e.g. aws-sm or gh.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the destination.",
+			},
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path of the KV version 2 backend of the secret.",
+			},
+			"secret_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the secret to sync, relative to the backend.",
+			},
+			"sync_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the last sync of the secret.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the secret was last synced.",
+			},
+		},
+	}
+}
+
+func secretsSyncAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultEnterprise(client, "1.15.0", "vault_secrets_sync_association"); err != nil {
+		return err
+	}
+
+	destType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	mount := d.Get("mount").(string)
+	secretName := d.Get("secret_name").(string)
+
+	path := secretsSyncDestinationPath(destType, name) + "/associations/set"
+
+	log.Printf("[DEBUG] Associating secret %q of %q with secrets sync destination %q", secretName, mount, name)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"mount":       mount,
+		"secret_name": secretName,
+	})
+	if err != nil {
+		return fmt.Errorf("error associating secret %q of %q with secrets sync destination %q: %s", secretName, mount, name, err)
+	}
+	log.Printf("[DEBUG] Associated secret %q of %q with secrets sync destination %q", secretName, mount, name)
+
+	d.SetId(fmt.Sprintf("type=%s::name=%s::mount=%s::secret_name=%s", destType, name, mount, secretName))
+
+	return secretsSyncAssociationRead(d, meta)
+}
+
+func secretsSyncAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+
+	destType, name, mount, secretName, err := secretsSyncAssociationParseID(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID %q for secrets sync association: %s", id, err)
+	}
+
+	path := secretsSyncDestinationPath(destType, name) + "/associations"
+
+	log.Printf("[DEBUG] Reading secrets sync association %q", id)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading secrets sync association %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Read secrets sync association %q", id)
+
+	// the associations are keyed by the accessor of the mount and the name
+	// of the secret.
+	var association map[string]interface{}
+	if resp != nil {
+		accessor, err := secretsSyncMountAccessor(client, mount)
+		if err != nil {
+			return fmt.Errorf("error reading secrets sync association %q: %s", id, err)
+		}
+		secrets, _ := resp.Data["associated_secrets"].(map[string]interface{})
+		for _, v := range secrets {
+			a, _ := v.(map[string]interface{})
+			if accessor != "" && a["accessor"] == accessor && a["secret_name"] == secretName {
+				association = a
+				break
+			}
+		}
+	}
+	if association == nil {
+		log.Printf("[WARN] Secrets sync association %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("type", destType)
+	d.Set("name", name)
+	d.Set("mount", mount)
+	d.Set("secret_name", secretName)
+	d.Set("sync_status", association["sync_status"])
+	d.Set("updated_at", association["updated_at"])
+
+	return nil
+}
+
+func secretsSyncAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+
+	destType, name, mount, secretName, err := secretsSyncAssociationParseID(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID %q for secrets sync association: %s", id, err)
+	}
+
+	path := secretsSyncDestinationPath(destType, name) + "/associations/remove"
+
+	log.Printf("[DEBUG] Deleting secrets sync association %q", id)
+	_, err = client.Logical().Write(path, map[string]interface{}{
+		"mount":       mount,
+		"secret_name": secretName,
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting secrets sync association %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Deleted secrets sync association %q", id)
+
+	return nil
+}
+
+// secretsSyncMountAccessor returns the accessor of the secret mount at
+// mount, or an empty string when it doesn't exist.
+func secretsSyncMountAccessor(client *api.Client, mount string) (string, error) {
+	path := "sys/mounts/" + strings.Trim(mount, "/")
+
+	log.Printf("[DEBUG] Reading mount %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read mount %q", path)
+	if resp == nil {
+		return "", nil
+	}
+
+	accessor, _ := resp.Data["accessor"].(string)
+	return accessor, nil
+}
+
+func secretsSyncAssociationParseID(id string) (destType, name, mount, secretName string, err error) {
+	res := secretsSyncAssociationIDRegex.FindStringSubmatch(id)
+	if len(res) != 5 {
+		return "", "", "", "", fmt.Errorf("ID did not match pattern")
+	}
+	return res[1], res[2], res[3], res[4], nil
+}
@@ -0,0 +1,101 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const secretsSyncConfigPath = "sys/sync/config"
+
+func secretsSyncConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Create: secretsSyncConfigWrite,
+		Read:   secretsSyncConfigRead,
+		Update: secretsSyncConfigWrite,
+		Delete: secretsSyncConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the syncing of secrets to every destination.",
+			},
+			"queue_capacity": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of pending sync operations.",
+			},
+		},
+	}
+}
+
+func secretsSyncConfigWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultEnterprise(client, "1.16.0", "vault_secrets_sync_config"); err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{
+		"disabled": d.Get("disabled").(bool),
+	}
+	if v, ok := d.GetOk("queue_capacity"); ok {
+		data["queue_capacity"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Writing secrets sync configuration to %q", secretsSyncConfigPath)
+	if _, err := patchRequest(client, secretsSyncConfigPath, data); err != nil {
+		return fmt.Errorf("error writing secrets sync configuration to %q: %s", secretsSyncConfigPath, err)
+	}
+	log.Printf("[DEBUG] Wrote secrets sync configuration to %q", secretsSyncConfigPath)
+
+	d.SetId(secretsSyncConfigPath)
+
+	return secretsSyncConfigRead(d, meta)
+}
+
+func secretsSyncConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading secrets sync configuration from %q", secretsSyncConfigPath)
+	resp, err := client.Logical().Read(secretsSyncConfigPath)
+	if err != nil {
+		return fmt.Errorf("error reading secrets sync configuration from %q: %s", secretsSyncConfigPath, err)
+	}
+	log.Printf("[DEBUG] Read secrets sync configuration from %q", secretsSyncConfigPath)
+	if resp == nil {
+		return fmt.Errorf("no secrets sync configuration found at %q", secretsSyncConfigPath)
+	}
+
+	for _, k := range []string{"disabled", "queue_capacity"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+// secretsSyncConfigDelete enables the syncing of secrets again, the
+// configuration itself can't be deleted.
+func secretsSyncConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Resetting secrets sync configuration at %q", secretsSyncConfigPath)
+	_, err := patchRequest(client, secretsSyncConfigPath, map[string]interface{}{
+		"disabled": false,
+	})
+	if err != nil {
+		return fmt.Errorf("error resetting secrets sync configuration at %q: %s", secretsSyncConfigPath, err)
+	}
+	log.Printf("[DEBUG] Reset secrets sync configuration at %q", secretsSyncConfigPath)
+
+	return nil
+}
@@ -0,0 +1,336 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+// secretsSyncDestination describes a type of secrets sync destination, whose
+// resources only differ by the connection details they configure.
+type secretsSyncDestination struct {
+	// Type is the type of the destination in the paths of Vault.
+	Type string
+	// Resource is the name of the Terraform resource, used in errors.
+	Resource string
+	// Fields are the connection details of the destination.
+	Fields map[string]*schema.Schema
+}
+
+func secretsSyncAWSDestinationResource() *schema.Resource {
+	return secretsSyncDestinationResource(&secretsSyncDestination{
+		Type:     "aws-sm",
+		Resource: "vault_secrets_sync_aws_destination",
+		Fields: map[string]*schema.Schema{
+			"access_key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The access key ID of the IAM user Vault syncs the secrets with, instead of AWS_ACCESS_KEY_ID.",
+			},
+			"secret_access_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The secret access key of the IAM user Vault syncs the secrets with, instead of AWS_SECRET_ACCESS_KEY.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region the secrets are synced to, instead of AWS_REGION.",
+			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ARN of a role Vault assumes to sync the secrets.",
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The external ID passed when assuming role_arn.",
+			},
+		},
+	})
+}
+
+func secretsSyncAzureDestinationResource() *schema.Resource {
+	return secretsSyncDestinationResource(&secretsSyncDestination{
+		Type:     "azure-kv",
+		Resource: "vault_secrets_sync_azure_destination",
+		Fields: map[string]*schema.Schema{
+			"key_vault_uri": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The URI of the Key Vault the secrets are synced to, instead of KEY_VAULT_URI.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The client ID of the service principal Vault syncs the secrets with, instead of AZURE_CLIENT_ID.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The client secret of the service principal, instead of AZURE_CLIENT_SECRET.",
+			},
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the tenant of the service principal, instead of AZURE_TENANT_ID.",
+			},
+			"cloud": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Azure cloud the Key Vault is in, e.g. \"cloud\" or \"china\".",
+			},
+		},
+	})
+}
+
+func secretsSyncGCPDestinationResource() *schema.Resource {
+	return secretsSyncDestinationResource(&secretsSyncDestination{
+		Type:     "gcp-sm",
+		Resource: "vault_secrets_sync_gcp_destination",
+		Fields: map[string]*schema.Schema{
+			"credentials": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "JSON credentials of the service account Vault syncs the secrets with, instead of GOOGLE_APPLICATION_CREDENTIALS.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the project the secrets are synced to, instead of the project of the credentials.",
+			},
+		},
+	})
+}
+
+func secretsSyncGHDestinationResource() *schema.Resource {
+	return secretsSyncDestinationResource(&secretsSyncDestination{
+		Type:     "gh",
+		Resource: "vault_secrets_sync_gh_destination",
+		Fields: map[string]*schema.Schema{
+			"access_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The personal access token Vault syncs the secrets with, instead of GITHUB_ACCESS_TOKEN.",
+			},
+			"repository_owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The owner of the repository the secrets are synced to, instead of GITHUB_REPOSITORY_OWNER.",
+			},
+			"repository_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the repository the secrets are synced to, instead of GITHUB_REPOSITORY_NAME.",
+			},
+		},
+	})
+}
+
+func secretsSyncVercelDestinationResource() *schema.Resource {
+	return secretsSyncDestinationResource(&secretsSyncDestination{
+		Type:     "vercel-project",
+		Resource: "vault_secrets_sync_vercel_destination",
+		Fields: map[string]*schema.Schema{
+			"access_token": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The access token Vault syncs the secrets with.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the project the secrets are synced to.",
+			},
+			"team_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the team the project belongs to.",
+			},
+			"deployment_environments": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The deployment environments the secrets are synced to, among development, preview and production.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"development", "preview", "production"}, false),
+				},
+			},
+		},
+	})
+}
+
+func secretsSyncDestinationResource(dest *secretsSyncDestination) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the destination.",
+		},
+		"granularity": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "secret-path",
+			Description:  "Whether a secret is synced as a whole, \"secret-path\", or as one secret per key, \"secret-key\".",
+			ValidateFunc: validation.StringInSlice([]string{"secret-path", "secret-key"}, false),
+		},
+		"secret_name_template": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Template of the names of the synced secrets.",
+		},
+		"custom_tags": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Tags set on the synced secrets, where the destination supports them.",
+		},
+	}
+	for k, v := range dest.Fields {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return secretsSyncDestinationCreate(dest, d, meta)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return secretsSyncDestinationRead(dest, d, meta)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return secretsSyncDestinationUpdate(dest, d, meta)
+		},
+		Delete: secretsSyncDestinationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+// secretsSyncDestinationData returns the fields of a destination to write to
+// Vault, only those that changed unless all is true.
+func secretsSyncDestinationData(dest *secretsSyncDestination, d *schema.ResourceData, all bool) map[string]interface{} {
+	data := map[string]interface{}{}
+	fields := []string{"granularity", "secret_name_template", "custom_tags"}
+	for k := range dest.Fields {
+		fields = append(fields, k)
+	}
+	for _, k := range fields {
+		if !all && !d.HasChange(k) {
+			continue
+		}
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+	return data
+}
+
+func secretsSyncDestinationCreate(dest *secretsSyncDestination, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultEnterprise(client, "1.15.0", dest.Resource); err != nil {
+		return err
+	}
+
+	path := secretsSyncDestinationPath(dest.Type, d.Get("name").(string))
+
+	log.Printf("[DEBUG] Creating secrets sync destination %q", path)
+	if _, err := client.Logical().Write(path, secretsSyncDestinationData(dest, d, true)); err != nil {
+		return fmt.Errorf("error creating secrets sync destination %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Created secrets sync destination %q", path)
+
+	d.SetId(path)
+
+	return secretsSyncDestinationRead(dest, d, meta)
+}
+
+func secretsSyncDestinationRead(dest *secretsSyncDestination, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	prefix := secretsSyncDestinationPath(dest.Type, "")
+	if !strings.HasPrefix(path, prefix) {
+		return fmt.Errorf("invalid ID %q for %s, expected it to start with %q", path, dest.Resource, prefix)
+	}
+
+	log.Printf("[DEBUG] Reading secrets sync destination %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading secrets sync destination %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read secrets sync destination %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Secrets sync destination %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", strings.TrimPrefix(path, prefix))
+
+	// the sensitive connection details are returned redacted.
+	if details, ok := resp.Data["connection_details"].(map[string]interface{}); ok {
+		for k, s := range dest.Fields {
+			if v, ok := details[k]; ok && !s.Sensitive {
+				d.Set(k, v)
+			}
+		}
+	}
+	if options, ok := resp.Data["options"].(map[string]interface{}); ok {
+		if v, ok := options["granularity_level"]; ok {
+			d.Set("granularity", v)
+		}
+		for _, k := range []string{"secret_name_template", "custom_tags"} {
+			if v, ok := options[k]; ok {
+				d.Set(k, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func secretsSyncDestinationUpdate(dest *secretsSyncDestination, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Updating secrets sync destination %q", path)
+	if _, err := patchRequest(client, path, secretsSyncDestinationData(dest, d, false)); err != nil {
+		return fmt.Errorf("error updating secrets sync destination %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated secrets sync destination %q", path)
+
+	return secretsSyncDestinationRead(dest, d, meta)
+}
+
+func secretsSyncDestinationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting secrets sync destination %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting secrets sync destination %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted secrets sync destination %q", path)
+
+	return nil
+}
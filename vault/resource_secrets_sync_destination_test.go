@@ -0,0 +1,100 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccSecretsSyncDestination_gh(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test-gh")
+	mount := acctest.RandomWithPrefix("tf-test-kv")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnterprisePreCheck(t)
+		},
+		CheckDestroy: testAccCheckSecretsSyncDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretsSyncDestinationConfig_gh(name, mount, "secret-path"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_secrets_sync_gh_destination.test", "repository_owner", "hashicorp"),
+					resource.TestCheckResourceAttr("vault_secrets_sync_gh_destination.test", "granularity", "secret-path"),
+					resource.TestCheckResourceAttrSet("vault_secrets_sync_gh_destination.test", "secret_name_template"),
+					resource.TestCheckResourceAttr("vault_secrets_sync_association.test", "secret_name", "app"),
+					resource.TestCheckResourceAttrSet("vault_secrets_sync_association.test", "sync_status"),
+				),
+			},
+			{
+				Config: testAccSecretsSyncDestinationConfig_gh(name, mount, "secret-key"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_secrets_sync_gh_destination.test", "granularity", "secret-key"),
+				),
+			},
+			{
+				ResourceName:            "vault_secrets_sync_gh_destination.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_token"},
+			},
+		},
+	})
+}
+
+func testAccCheckSecretsSyncDestinationDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_secrets_sync_gh_destination" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("secrets sync destination %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccSecretsSyncDestinationConfig_gh(name, mount, granularity string) string {
+	return fmt.Sprintf(`
+resource "vault_secrets_sync_gh_destination" "test" {
+  name             = "%s"
+  access_token     = "github_pat_test"
+  repository_owner = "hashicorp"
+  repository_name  = "terraform-provider-vault"
+  granularity      = "%s"
+}
+
+resource "vault_mount" "kv" {
+  path    = "%s"
+  type    = "kv"
+  options = {
+    version = "2"
+  }
+}
+
+resource "vault_generic_secret" "app" {
+  path      = "${vault_mount.kv.path}/app"
+  data_json = "{\"foo\": \"bar\"}"
+}
+
+resource "vault_secrets_sync_association" "test" {
+  type        = "gh"
+  name        = "${vault_secrets_sync_gh_destination.test.name}"
+  mount       = "${vault_mount.kv.path}"
+  secret_name = "app"
+  depends_on  = ["vault_generic_secret.app"]
+}
+`, name, granularity, mount)
+}
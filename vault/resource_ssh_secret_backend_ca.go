@@ -51,6 +51,32 @@ func sshSecretBackendCAResource() *schema.Resource {
 				Computed:    true,
 				Description: "Public key part the SSH CA key pair; required if generate_signing_key is false.",
 			},
+			"key_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The type of the signing key pair Vault generates, e.g. \"ssh-rsa\", \"ec\" or \"ssh-ed25519\". Requires Vault 1.12 or later.",
+			},
+			"key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The number of bits of the signing key pair Vault generates. Requires Vault 1.12 or later.",
+			},
+			"managed_key_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"managed_key_id", "private_key"},
+				Description:   "The name of the managed key to use as the signing key. Requires Vault Enterprise 1.16 or later.",
+			},
+			"managed_key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"managed_key_name", "private_key"},
+				Description:   "The ID of the managed key to use as the signing key. Requires Vault Enterprise 1.16 or later.",
+			},
 		},
 	}
 }
@@ -69,6 +95,14 @@ func sshSecretBackendCACreate(d *schema.ResourceData, meta interface{}) error {
 	if publicKey, ok := d.Get("public_key").(string); ok {
 		data["public_key"] = publicKey
 	}
+	for _, k := range []string{"key_type", "managed_key_name", "managed_key_id"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("key_bits"); ok {
+		data["key_bits"] = v.(int)
+	}
 
 	log.Printf("[DEBUG] Writing CA information on SSH backend %q", backend)
 	_, err := client.Logical().Write(backend+"/config/ca", data)
@@ -100,7 +134,8 @@ func sshSecretBackendCARead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("public_key", secret.Data["public_key"])
 	d.Set("backend", backend)
 
-	// the API doesn't return private_key and generate_signing_key
+	// the API doesn't return private_key, generate_signing_key,
+	// the key type or the managed key used.
 	// So... if they drift, they drift.
 
 	return nil
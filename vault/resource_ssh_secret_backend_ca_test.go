@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -42,6 +43,25 @@ func TestAccSSHSecretBackendCA_provided(t *testing.T) {
 	})
 }
 
+func TestAccSSHSecretBackendCA_keyType(t *testing.T) {
+	backend := "ssh-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckSSHSecretBackendCADestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendCAConfigKeyType(backend),
+				Check: resource.ComposeTestCheckFunc(
+					testAccSSHSecretBackendCACheck(backend),
+					resource.TestMatchResourceAttr("vault_ssh_secret_backend_ca.test", "public_key", regexp.MustCompile("^ssh-ed25519 ")),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSHSecretBackend_import(t *testing.T) {
 	backend := "ssh-" + acctest.RandString(10)
 	resource.Test(t, resource.TestCase{
@@ -94,6 +114,20 @@ resource "vault_ssh_secret_backend_ca" "test" {
 }`, backend)
 }
 
+func testAccSSHSecretBackendCAConfigKeyType(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "ssh"
+  path = "%s"
+}
+
+resource "vault_ssh_secret_backend_ca" "test" {
+  backend              = "${vault_mount.test.path}"
+  generate_signing_key = true
+  key_type             = "ssh-ed25519"
+}`, backend)
+}
+
 func testAccSSHSecretBackendCAConfigProvided(backend string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "test" {
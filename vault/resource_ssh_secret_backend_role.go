@@ -0,0 +1,408 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	sshSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
+	sshSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+)$")
+)
+
+// sshSecretBackendRoleStringFields are the role fields that Vault accepts
+// and returns as plain strings, comma-separated lists included.
+var sshSecretBackendRoleStringFields = []string{
+	"allowed_users",
+	"allowed_domains",
+	"allowed_extensions",
+	"allowed_critical_options",
+	"default_user",
+	"cidr_list",
+	"exclude_cidr_list",
+	"key_id_format",
+}
+
+var sshSecretBackendRoleBoolFields = []string{
+	"allow_user_certificates",
+	"allow_host_certificates",
+	"allow_bare_domains",
+	"allow_subdomains",
+	"allow_user_key_ids",
+	"allowed_users_template",
+	"allowed_domains_template",
+	"default_user_template",
+}
+
+func sshSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: sshSecretBackendRoleWrite,
+		Read:   sshSecretBackendRoleRead,
+		Update: sshSecretBackendRoleWrite,
+		Delete: sshSecretBackendRoleDelete,
+		Exists: sshSecretBackendRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the SSH Secret Backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the role.",
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The type of credentials generated by this role. Must be either \"ca\" or \"otp\".",
+				ValidateFunc: validation.StringInSlice([]string{"ca", "otp"}, false),
+			},
+			"allowed_users": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma-separated list of usernames that are allowed, \"*\" allows any.",
+			},
+			"allowed_users_template": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specifies if allowed_users can be declared using identity template policies.",
+			},
+			"default_user": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The default username for which credentials will be generated.",
+			},
+			"default_user_template": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specifies if default_user can be declared using identity template policies.",
+			},
+			"allowed_domains": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma-separated list of domains for which host certificates can be issued.",
+			},
+			"allowed_domains_template": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specifies if allowed_domains can be declared using identity template policies.",
+			},
+			"allow_bare_domains": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to allow host certificates for the bare domains in allowed_domains.",
+			},
+			"allow_subdomains": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to allow host certificates for subdomains of allowed_domains.",
+			},
+			"allow_user_certificates": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to allow user certificates to be signed.",
+			},
+			"allow_host_certificates": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to allow host certificates to be signed.",
+			},
+			"allow_user_key_ids": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag to allow users to override the key ID of signed certificates.",
+			},
+			"key_id_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Custom format for the key ID of signed certificates.",
+			},
+			"allowed_extensions": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma-separated list of extensions that users can request, \"*\" allows any.",
+			},
+			"default_extensions": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Map of extensions added to signed certificates when none are requested.",
+			},
+			"allowed_critical_options": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma-separated list of critical options that users can request, \"*\" allows any.",
+			},
+			"default_critical_options": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Map of critical options added to signed certificates when none are requested.",
+			},
+			"allowed_user_key_config": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Restricts the type and length of the keys that can be signed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The SSH public key type, e.g. \"rsa\", \"ec\" or \"ed25519\".",
+							ValidateFunc: validation.StringInSlice([]string{"rsa", "ecdsa", "ec", "dsa", "ed25519", "ssh-rsa", "ssh-dss", "ssh-ed25519", "ecdsa-sha2-nistp256", "ecdsa-sha2-nistp384", "ecdsa-sha2-nistp521"}, false),
+						},
+						"lengths": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "The allowed key lengths, 0 for key types without a length such as ed25519.",
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+			"algorithm_signer": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The algorithm used to sign certificates.",
+				ValidateFunc: validation.StringInSlice([]string{"default", "ssh-rsa", "rsa-sha2-256", "rsa-sha2-512"}, false),
+			},
+			"cidr_list": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma-separated list of CIDR blocks for which the OTP role is applicable.",
+			},
+			"exclude_cidr_list": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma-separated list of CIDR blocks excluded from cidr_list.",
+			},
+			"ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The TTL.",
+				ValidateFunc: validateDuration,
+			},
+			"max_ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The maximum TTL.",
+				ValidateFunc: validateDuration,
+			},
+		},
+	}
+}
+
+func sshSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := sshSecretBackendRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"key_type":                 d.Get("key_type").(string),
+		"default_extensions":       d.Get("default_extensions").(map[string]interface{}),
+		"default_critical_options": d.Get("default_critical_options").(map[string]interface{}),
+	}
+	for _, k := range sshSecretBackendRoleStringFields {
+		data[k] = d.Get(k).(string)
+	}
+	for _, k := range sshSecretBackendRoleBoolFields {
+		data[k] = d.Get(k).(bool)
+	}
+	for _, k := range []string{"algorithm_signer", "ttl", "max_ttl"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+
+	keyLengths := map[string]interface{}{}
+	for _, raw := range d.Get("allowed_user_key_config").(*schema.Set).List() {
+		config := raw.(map[string]interface{})
+		keyLengths[config["type"].(string)] = config["lengths"].([]interface{})
+	}
+	data["allowed_user_key_lengths"] = keyLengths
+
+	log.Printf("[DEBUG] Writing SSH secret backend role %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing SSH secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote SSH secret backend role %q", path)
+
+	d.SetId(path)
+	return sshSecretBackendRoleRead(d, meta)
+}
+
+func sshSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := sshSecretBackendRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+	name, err := sshSecretBackendRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading SSH secret backend role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading SSH secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read SSH secret backend role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] SSH secret backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range append(append(sshSecretBackendRoleStringFields, sshSecretBackendRoleBoolFields...), "key_type", "algorithm_signer", "ttl", "max_ttl") {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+	for _, k := range []string{"default_extensions", "default_critical_options"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting %s in state: %s", k, err)
+		}
+	}
+
+	keyConfigs, err := sshSecretBackendRoleFlattenKeyLengths(resp.Data["allowed_user_key_lengths"])
+	if err != nil {
+		return fmt.Errorf("error reading allowed_user_key_lengths of SSH secret backend role %q: %s", path, err)
+	}
+	if err := d.Set("allowed_user_key_config", keyConfigs); err != nil {
+		return fmt.Errorf("error setting allowed_user_key_config in state: %s", err)
+	}
+
+	return nil
+}
+
+func sshSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting SSH secret backend role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting SSH secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted SSH secret backend role %q", path)
+	return nil
+}
+
+func sshSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if SSH secret backend role %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if SSH secret backend role %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if SSH secret backend role %q exists", path)
+	return resp != nil, nil
+}
+
+// sshSecretBackendRoleFlattenKeyLengths converts allowed_user_key_lengths,
+// which older versions of Vault return with a single length per key type
+// and newer ones with a list, into allowed_user_key_config blocks.
+func sshSecretBackendRoleFlattenKeyLengths(raw interface{}) ([]interface{}, error) {
+	keyLengths, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	configs := make([]interface{}, 0, len(keyLengths))
+	for keyType, v := range keyLengths {
+		var values []interface{}
+		switch v := v.(type) {
+		case []interface{}:
+			values = v
+		default:
+			values = []interface{}{v}
+		}
+		lengths := make([]interface{}, 0, len(values))
+		for _, value := range values {
+			switch value := value.(type) {
+			case json.Number:
+				i, err := value.Int64()
+				if err != nil {
+					return nil, fmt.Errorf("invalid length %q for key type %q: %s", value, keyType, err)
+				}
+				lengths = append(lengths, int(i))
+			case float64:
+				lengths = append(lengths, int(value))
+			default:
+				return nil, fmt.Errorf("invalid length %v for key type %q", value, keyType)
+			}
+		}
+		configs = append(configs, map[string]interface{}{
+			"type":    keyType,
+			"lengths": lengths,
+		})
+	}
+	return configs, nil
+}
+
+func sshSecretBackendRolePath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/roles/" + strings.Trim(name, "/")
+}
+
+func sshSecretBackendRoleNameFromPath(path string) (string, error) {
+	if !sshSecretBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := sshSecretBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
+
+func sshSecretBackendRoleBackendFromPath(path string) (string, error) {
+	if !sshSecretBackendRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := sshSecretBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,132 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccSSHSecretBackendRole_basic(t *testing.T) {
+	backend := "ssh-" + acctest.RandString(10)
+	name := "role-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckSSHSecretBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendRoleConfig_basic(backend, name, "ubuntu"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test", "key_type", "ca"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test", "allow_user_certificates", "true"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test", "allowed_users", "ubuntu"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test", "default_extensions.%", "1"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test", "default_extensions.permit-pty", ""),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test", "allowed_user_key_config.#", "1"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test", "ttl", "1800"),
+				),
+			},
+			{
+				Config: testAccSSHSecretBackendRoleConfig_basic(backend, name, "ubuntu,centos"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test", "allowed_users", "ubuntu,centos"),
+				),
+			},
+			{
+				ResourceName:      "vault_ssh_secret_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSHSecretBackendRole_otp(t *testing.T) {
+	backend := "ssh-" + acctest.RandString(10)
+	name := "role-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckSSHSecretBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendRoleConfig_otp(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test", "key_type", "otp"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test", "default_user", "ubuntu"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test", "cidr_list", "10.0.0.0/8"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSSHSecretBackendRoleDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ssh_secret_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccSSHSecretBackendRoleConfig_basic(backend string, name string, allowedUsers string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "ssh"
+  path = "%s"
+}
+
+resource "vault_ssh_secret_backend_role" "test" {
+  backend                 = "${vault_mount.test.path}"
+  name                    = "%s"
+  key_type                = "ca"
+  allow_user_certificates = true
+  allowed_users           = "%s"
+  default_user            = "ubuntu"
+  allowed_extensions      = "permit-pty,permit-port-forwarding"
+  ttl                     = "1800"
+
+  default_extensions {
+    permit-pty = ""
+  }
+
+  allowed_user_key_config {
+    type    = "rsa"
+    lengths = [2048, 4096]
+  }
+}`, backend, name, allowedUsers)
+}
+
+func testAccSSHSecretBackendRoleConfig_otp(backend string, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "ssh"
+  path = "%s"
+}
+
+resource "vault_ssh_secret_backend_role" "test" {
+  backend      = "${vault_mount.test.path}"
+  name         = "%s"
+  key_type     = "otp"
+  default_user = "ubuntu"
+  cidr_list    = "10.0.0.0/8"
+}`, backend, name)
+}
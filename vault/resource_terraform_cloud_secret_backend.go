@@ -0,0 +1,251 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// terraformCloudSecretBackendConfigFields are the fields of the Terraform Cloud configuration.
+var terraformCloudSecretBackendConfigFields = []string{
+	"address",
+	"token",
+	"base_path",
+}
+
+func terraformCloudSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: terraformCloudSecretBackendCreate,
+		Read:   terraformCloudSecretBackendRead,
+		Update: terraformCloudSecretBackendUpdate,
+		Delete: terraformCloudSecretBackendDelete,
+		Exists: terraformCloudSecretBackendExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "terraform",
+				Description:  "Path to mount the backend at.",
+				ValidateFunc: validateMountPath,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old+"/" == new || new+"/" == old
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lease duration for secrets in seconds",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum possible lease duration for secrets in seconds",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "https://app.terraform.io",
+				Description: "Address of the Terraform Cloud or Terraform Enterprise instance.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "API token Vault uses to manage Terraform Cloud tokens.",
+				Sensitive:   true,
+			},
+			"base_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/api/v2/",
+				Description: "Base path of the Terraform Cloud API.",
+			},
+		},
+	}
+}
+
+func terraformCloudSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	description := d.Get("description").(string)
+	defaultTTL := d.Get("default_lease_ttl_seconds").(int)
+	maxTTL := d.Get("max_lease_ttl_seconds").(int)
+
+	d.Partial(true)
+	log.Printf("[DEBUG] Mounting Terraform Cloud backend at %q", path)
+	err := mountSecretBackend(client, path, &api.MountInput{
+		Type:        "terraform",
+		Description: description,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", defaultTTL),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", maxTTL),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted Terraform Cloud backend at %q", path)
+	d.SetId(path)
+
+	d.SetPartial("path")
+	d.SetPartial("description")
+	d.SetPartial("default_lease_ttl_seconds")
+	d.SetPartial("max_lease_ttl_seconds")
+
+	if err := terraformCloudSecretBackendWriteConfig(client, path, d); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return terraformCloudSecretBackendRead(d, meta)
+}
+
+func terraformCloudSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading Terraform Cloud backend mount %q from Vault", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Terraform Cloud backend mount %q from Vault", path)
+
+	// the API always returns the path with a trailing slash, so let's make
+	// sure we always specify it as a trailing slash.
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
+
+	configPath := terraformCloudSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading Terraform Cloud configuration from %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading Terraform Cloud configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Terraform Cloud configuration from %q", configPath)
+	if resp != nil {
+		// the token is never returned.
+		for _, k := range []string{
+			"address", "base_path",
+		} {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func terraformCloudSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	d.Partial(true)
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") || d.HasChange("description") {
+		config := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			config.Description = &description
+		}
+		log.Printf("[DEBUG] Tuning mount %q", path)
+		err := tuneMount(client, path, config)
+		if err != nil {
+			return fmt.Errorf("error tuning mount %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Tuned mount %q", path)
+		d.SetPartial("default_lease_ttl_seconds")
+		d.SetPartial("max_lease_ttl_seconds")
+		d.SetPartial("description")
+	}
+	err := writeBackendConfigOnChange(d, terraformCloudSecretBackendConfigFields, func() error {
+		return terraformCloudSecretBackendWriteConfig(client, path, d)
+	})
+	if err != nil {
+		return err
+	}
+	d.Partial(false)
+	return terraformCloudSecretBackendRead(d, meta)
+}
+
+func terraformCloudSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting Terraform Cloud backend %q", path)
+	err := unmountSecretBackend(client, path)
+	if err != nil {
+		return fmt.Errorf("error unmounting Terraform Cloud backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted Terraform Cloud backend %q", path)
+	return nil
+}
+
+func terraformCloudSecretBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if Terraform Cloud backend exists at %q", path)
+	mounts, err := listMounts(client)
+	if err != nil {
+		return true, fmt.Errorf("error retrieving list of mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Checked if Terraform Cloud backend exists at %q", path)
+	_, ok := mounts[strings.Trim(path, "/")+"/"]
+	return ok, nil
+}
+
+func terraformCloudSecretBackendWriteConfig(client *api.Client, path string, d *schema.ResourceData) error {
+	configPath := terraformCloudSecretBackendConfigPath(path)
+
+	log.Printf("[DEBUG] Writing Terraform Cloud configuration to %q", configPath)
+	data := map[string]interface{}{}
+	for _, k := range []string{
+		"address", "token", "base_path",
+	} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing Terraform Cloud configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Terraform Cloud configuration to %q", configPath)
+	for _, k := range terraformCloudSecretBackendConfigFields {
+		d.SetPartial(k)
+	}
+
+	return nil
+}
+
+func terraformCloudSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccTerraformCloudSecretBackend_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-terraform-cloud")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccTerraformCloudSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTerraformCloudSecretBackendConfig_initial(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_backend.test", "address", "https://app.terraform.io"),
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_backend.test", "base_path", "/api/v2/"),
+				),
+			},
+			{
+				Config: testAccTerraformCloudSecretBackendConfig_updated(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_backend.test", "address", "https://tfe.example.com"),
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_backend.test", "base_path", "/tfe/api/v2/"),
+				),
+			},
+			{
+				ResourceName:            "vault_terraform_cloud_secret_backend.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func testAccTerraformCloudSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_terraform_cloud_secret_backend" {
+			continue
+		}
+		for path, mount := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if mount.Type == "terraform" && path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccTerraformCloudSecretBackendConfig_initial(path string) string {
+	return fmt.Sprintf(`
+resource "vault_terraform_cloud_secret_backend" "test" {
+  path  = "%s"
+  token = "randomized-token-12392.atlasv1.1234567890"
+}`, path)
+}
+
+func testAccTerraformCloudSecretBackendConfig_updated(path string) string {
+	return fmt.Sprintf(`
+resource "vault_terraform_cloud_secret_backend" "test" {
+  path      = "%s"
+  address   = "https://tfe.example.com"
+  base_path = "/tfe/api/v2/"
+  token     = "randomized-token-12392.atlasv1.1234567890"
+}`, path)
+}
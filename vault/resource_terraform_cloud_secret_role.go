@@ -0,0 +1,194 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	terraformCloudSecretRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/role/.+$")
+	terraformCloudSecretRoleNameFromPathRegex    = regexp.MustCompile("^.+/role/(.+)$")
+)
+
+func terraformCloudSecretRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: terraformCloudSecretRoleWrite,
+		Read:   terraformCloudSecretRoleRead,
+		Update: terraformCloudSecretRoleWrite,
+		Delete: terraformCloudSecretRoleDelete,
+		Exists: terraformCloudSecretRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Terraform Cloud Secret Backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the role.",
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the Terraform Cloud organization to generate tokens for.",
+			},
+			"team_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "ID of the Terraform Cloud team to generate tokens for.",
+				ConflictsWith: []string{"user_id"},
+			},
+			"user_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "ID of the Terraform Cloud user to generate tokens for.",
+				ConflictsWith: []string{"organization", "team_id"},
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Default lease TTL in seconds of generated tokens.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum lease TTL in seconds of generated tokens.",
+			},
+		},
+	}
+}
+
+func terraformCloudSecretRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := terraformCloudSecretRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"ttl":     d.Get("ttl").(int),
+		"max_ttl": d.Get("max_ttl").(int),
+	}
+	for _, k := range []string{"organization", "team_id", "user_id"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if data["organization"] == nil && data["user_id"] == nil {
+		return fmt.Errorf("one of organization or user_id must be set")
+	}
+
+	log.Printf("[DEBUG] Writing Terraform Cloud secret role %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing Terraform Cloud secret role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Terraform Cloud secret role %q", path)
+
+	d.SetId(path)
+	return terraformCloudSecretRoleRead(d, meta)
+}
+
+func terraformCloudSecretRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := terraformCloudSecretRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+	name, err := terraformCloudSecretRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading Terraform Cloud secret role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Terraform Cloud secret role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Terraform Cloud secret role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Terraform Cloud secret role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range []string{"organization", "team_id", "user_id", "ttl", "max_ttl"} {
+		d.Set(k, resp.Data[k])
+	}
+
+	return nil
+}
+
+func terraformCloudSecretRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting Terraform Cloud secret role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting Terraform Cloud secret role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted Terraform Cloud secret role %q", path)
+	return nil
+}
+
+func terraformCloudSecretRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if Terraform Cloud secret role %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if Terraform Cloud secret role %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if Terraform Cloud secret role %q exists", path)
+	return resp != nil, nil
+}
+
+func terraformCloudSecretRolePath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/role/" + strings.Trim(name, "/")
+}
+
+func terraformCloudSecretRoleNameFromPath(path string) (string, error) {
+	if !terraformCloudSecretRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := terraformCloudSecretRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
+
+func terraformCloudSecretRoleBackendFromPath(path string) (string, error) {
+	if !terraformCloudSecretRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := terraformCloudSecretRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccTerraformCloudSecretRole_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-terraform-cloud")
+	name := acctest.RandomWithPrefix("tf-test")
+	token, organization := getTestTFCCreds(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckTerraformCloudSecretRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTerraformCloudSecretRoleConfig(backend, token, name, organization, 120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_role.test", "organization", organization),
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_role.test", "ttl", "120"),
+				),
+			},
+			{
+				Config: testAccTerraformCloudSecretRoleConfig(backend, token, name, organization, 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_terraform_cloud_secret_role.test", "ttl", "300"),
+				),
+			},
+			{
+				ResourceName:      "vault_terraform_cloud_secret_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTerraformCloudSecretRoleDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_terraform_cloud_secret_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("Terraform Cloud secret role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccTerraformCloudSecretRoleConfig(backend, token, name, organization string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_terraform_cloud_secret_backend" "test" {
+  path  = "%s"
+  token = "%s"
+}
+
+resource "vault_terraform_cloud_secret_role" "test" {
+  backend      = "${vault_terraform_cloud_secret_backend.test.path}"
+  name         = "%s"
+  organization = "%s"
+  ttl          = %d
+}`, backend, token, name, organization, ttl)
+}
@@ -32,7 +32,7 @@ func tokenAuthBackendRoleResource() *schema.Resource {
 				Description: "Name of the role.",
 			},
 			"allowed_policies": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -40,7 +40,7 @@ func tokenAuthBackendRoleResource() *schema.Resource {
 				Description: "List of allowed policies for given role.",
 			},
 			"disallowed_policies": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -54,10 +54,11 @@ func tokenAuthBackendRoleResource() *schema.Resource {
 				Description: "If true, tokens created against this policy will be orphan tokens.",
 			},
 			"period": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The duration in which a token should be renewed. At each renewal, the token's TTL will be set to the value of this parameter.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The duration in which a token should be renewed. At each renewal, the token's TTL will be set to the value of this parameter.",
+				ValidateFunc: validateDuration,
 			},
 			"renewable": {
 				Type:        schema.TypeBool,
@@ -66,10 +67,11 @@ func tokenAuthBackendRoleResource() *schema.Resource {
 				Description: "Wether to disable the ability of the token to be renewed past its initial TTL.",
 			},
 			"explicit_max_ttl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "If set, the token will have an explicit max TTL set upon it.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "If set, the token will have an explicit max TTL set upon it.",
+				ValidateFunc: validateDuration,
 			},
 			"path_suffix": {
 				Type:        schema.TypeString,
@@ -78,16 +80,18 @@ func tokenAuthBackendRoleResource() *schema.Resource {
 				Description: "Tokens created against this role will have the given suffix as part of their path in addition to the role name.",
 			},
 			"ttl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The TTL period of tokens issued using this role, provided as the number of minutes.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The TTL period of tokens issued using this role, provided as the number of minutes.",
+				ValidateFunc: validateDuration,
 			},
 			"max_ttl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The maximum allowed lifetime of tokens issued using this role.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The maximum allowed lifetime of tokens issued using this role.",
+				ValidateFunc: validateDuration,
 			},
 		},
 	}
@@ -102,13 +106,13 @@ func tokenAuthBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Writing Token auth backend role %q", path)
 
-	iAllowedPolicies := d.Get("allowed_policies").([]interface{})
+	iAllowedPolicies := d.Get("allowed_policies").(*schema.Set).List()
 	allowedPolicies := make([]string, 0, len(iAllowedPolicies))
 	for _, iPolicy := range iAllowedPolicies {
 		allowedPolicies = append(allowedPolicies, iPolicy.(string))
 	}
 
-	iDisallowedPolicies := d.Get("disallowed_policies").([]interface{})
+	iDisallowedPolicies := d.Get("disallowed_policies").(*schema.Set).List()
 	disallowedPolicies := make([]string, 0, len(iDisallowedPolicies))
 	for _, iPolicy := range iDisallowedPolicies {
 		disallowedPolicies = append(disallowedPolicies, iPolicy.(string))
@@ -196,13 +200,13 @@ func tokenAuthBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Updating Token auth backend role %q", path)
 
-	iAllowedPolicies := d.Get("allowed_policies").([]interface{})
+	iAllowedPolicies := d.Get("allowed_policies").(*schema.Set).List()
 	allowedPolicies := make([]string, 0, len(iAllowedPolicies))
 	for _, iPolicy := range iAllowedPolicies {
 		allowedPolicies = append(allowedPolicies, iPolicy.(string))
 	}
 
-	iDisallowedPolicies := d.Get("disallowed_policies").([]interface{})
+	iDisallowedPolicies := d.Get("disallowed_policies").(*schema.Set).List()
 	disallowedPolicies := make([]string, 0, len(iDisallowedPolicies))
 	for _, iPolicy := range iDisallowedPolicies {
 		disallowedPolicies = append(disallowedPolicies, iPolicy.(string))
@@ -0,0 +1,302 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	totpSecretBackendKeyBackendFromPathRegex = regexp.MustCompile("^(.+)/keys/.+$")
+	totpSecretBackendKeyNameFromPathRegex    = regexp.MustCompile("^.+/keys/(.+)$")
+)
+
+func totpSecretBackendKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: totpSecretBackendKeyCreate,
+		Read:   totpSecretBackendKeyRead,
+		Delete: totpSecretBackendKeyDelete,
+		Exists: totpSecretBackendKeyExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// keys can't be updated in place, every attribute forces a new key.
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the TOTP Secret Backend the key belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the key.",
+			},
+			"generate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Generate the key in Vault instead of importing it from url or key.",
+			},
+			"exported": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Return the barcode and url of a generated key.",
+			},
+			"key_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     20,
+				Description: "Size in bytes of a generated key.",
+			},
+			"url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				Description:   "otpauth:// URL of the key to import, or of the generated key if exported.",
+				ConflictsWith: []string{"key"},
+			},
+			"key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				Description:   "Base32 encoded key to import.",
+				ConflictsWith: []string{"url"},
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the issuer of the key.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the account the key belongs to.",
+			},
+			"period": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Number of seconds each code is valid for.",
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Hashing algorithm of the key, one of \"SHA1\", \"SHA256\" or \"SHA512\".",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256", "SHA512"}, false),
+			},
+			"digits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Number of digits of each code, either 6 or 8.",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					if value := v.(int); value != 6 && value != 8 {
+						errs = append(errs, fmt.Errorf("%s must be either 6 or 8, got %d", k, value))
+					}
+					return
+				},
+			},
+			"skew": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				Description:  "Number of periods before or after the current one a code is still accepted in, either 0 or 1.",
+				ValidateFunc: validation.IntBetween(0, 1),
+			},
+			"qr_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     200,
+				Description: "Size in pixels of the barcode of a generated key, 0 disables the barcode.",
+			},
+			"barcode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded PNG barcode of the generated key.",
+			},
+		},
+	}
+}
+
+func totpSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := totpSecretBackendKeyPath(backend, name)
+
+	generate := d.Get("generate").(bool)
+	data := map[string]interface{}{
+		"generate": generate,
+		"skew":     d.Get("skew").(int),
+	}
+	for _, k := range []string{"issuer", "account_name", "algorithm"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	for _, k := range []string{"period", "digits"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(int)
+		}
+	}
+	if generate {
+		data["exported"] = d.Get("exported").(bool)
+		data["key_size"] = d.Get("key_size").(int)
+		data["qr_size"] = d.Get("qr_size").(int)
+	} else {
+		url, hasURL := d.GetOk("url")
+		key, hasKey := d.GetOk("key")
+		switch {
+		case hasURL:
+			data["url"] = url.(string)
+		case hasKey:
+			data["key"] = key.(string)
+		default:
+			return fmt.Errorf("one of url or key must be set when generate is false")
+		}
+	}
+
+	log.Printf("[DEBUG] Creating TOTP secret backend key %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error creating TOTP secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Created TOTP secret backend key %q", path)
+
+	d.SetId(path)
+
+	// the barcode and url of a generated key are only returned once.
+	if resp != nil {
+		d.Set("barcode", resp.Data["barcode"])
+		if v, ok := resp.Data["url"]; ok {
+			d.Set("url", v)
+		}
+	}
+
+	return totpSecretBackendKeyRead(d, meta)
+}
+
+func totpSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := totpSecretBackendKeyBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for TOTP secret backend key: %s", path, err)
+	}
+	name, err := totpSecretBackendKeyNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for TOTP secret backend key: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading TOTP secret backend key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading TOTP secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read TOTP secret backend key %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] TOTP secret backend key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range []string{"issuer", "account_name", "period", "algorithm", "digits"} {
+		d.Set(k, resp.Data[k])
+	}
+
+	return nil
+}
+
+func totpSecretBackendKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting TOTP secret backend key %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting TOTP secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted TOTP secret backend key %q", path)
+
+	return nil
+}
+
+func totpSecretBackendKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Checking if TOTP secret backend key %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if TOTP secret backend key %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if TOTP secret backend key %q exists", path)
+
+	return resp != nil, nil
+}
+
+func totpSecretBackendKeyPath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/keys/" + strings.Trim(name, "/")
+}
+
+func totpSecretBackendKeyBackendFromPath(path string) (string, error) {
+	if !totpSecretBackendKeyBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := totpSecretBackendKeyBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
+
+func totpSecretBackendKeyNameFromPath(path string) (string, error) {
+	if !totpSecretBackendKeyNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := totpSecretBackendKeyNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
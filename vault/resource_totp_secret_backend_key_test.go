@@ -0,0 +1,115 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccTOTPSecretBackendKey_generated(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+	name := acctest.RandomWithPrefix("tf-test")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckTOTPSecretBackendKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTOTPSecretBackendKeyConfig_generated(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "name", name),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "issuer", "Vault"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "account_name", "test@example.com"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "period", "60"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "digits", "8"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "algorithm", "SHA256"),
+					resource.TestCheckResourceAttrSet("vault_totp_secret_backend_key.test", "barcode"),
+					resource.TestCheckResourceAttrSet("vault_totp_secret_backend_key.test", "url"),
+				),
+			},
+			{
+				ResourceName:            "vault_totp_secret_backend_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"generate", "exported", "key_size", "qr_size", "skew", "barcode", "url"},
+			},
+		},
+	})
+}
+
+func TestAccTOTPSecretBackendKey_imported(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+	name := acctest.RandomWithPrefix("tf-test")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckTOTPSecretBackendKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTOTPSecretBackendKeyConfig_imported(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "issuer", "Example"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "account_name", "alice"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "period", "30"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "digits", "6"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "barcode", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTOTPSecretBackendKeyDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_totp_secret_backend_key" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("TOTP secret backend key %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccTOTPSecretBackendKeyConfig_generated(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  backend      = "${vault_mount.test.path}"
+  name         = "%s"
+  generate     = true
+  issuer       = "Vault"
+  account_name = "test@example.com"
+  period       = 60
+  digits       = 8
+  algorithm    = "SHA256"
+}`, backend, name)
+}
+
+func testAccTOTPSecretBackendKeyConfig_imported(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  backend = "${vault_mount.test.path}"
+  name    = "%s"
+  url     = "otpauth://totp/Example:alice?secret=JBSWY3DPEHPK3PXP&issuer=Example"
+}`, backend, name)
+}
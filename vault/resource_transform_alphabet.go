@@ -0,0 +1,164 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	transformAlphabetBackendFromPathRegex = regexp.MustCompile("^(.+)/alphabet/.+$")
+	transformAlphabetNameFromPathRegex    = regexp.MustCompile("^.+/alphabet/(.+)$")
+)
+
+func transformAlphabetResource() *schema.Resource {
+	return &schema.Resource{
+		Create: transformAlphabetWrite,
+		Read:   transformAlphabetRead,
+		Update: transformAlphabetWrite,
+		Delete: transformAlphabetDelete,
+		Exists: transformAlphabetExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path the transform secret backend is mounted at.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the alphabet.",
+			},
+			"alphabet": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A string of characters that contains the alphabet set.",
+			},
+		},
+	}
+}
+
+func transformAlphabetWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultEnterprise(client, "1.4.0", "vault_transform_alphabet"); err != nil {
+		return err
+	}
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := transformAlphabetPath(backend, name)
+
+	data := map[string]interface{}{
+		"alphabet": d.Get("alphabet").(string),
+	}
+
+	log.Printf("[DEBUG] Writing transform alphabet %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing transform alphabet %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote transform alphabet %q", path)
+
+	d.SetId(path)
+	return transformAlphabetRead(d, meta)
+}
+
+func transformAlphabetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := transformAlphabetBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid alphabet ID %q: %s", path, err)
+	}
+	name, err := transformAlphabetNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid alphabet ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading transform alphabet %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transform alphabet %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read transform alphabet %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Transform alphabet %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	d.Set("alphabet", resp.Data["alphabet"])
+
+	return nil
+}
+
+func transformAlphabetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting transform alphabet %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting transform alphabet %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted transform alphabet %q", path)
+	return nil
+}
+
+func transformAlphabetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if transform alphabet %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if transform alphabet %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if transform alphabet %q exists", path)
+	return resp != nil, nil
+}
+
+func transformAlphabetPath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/alphabet/" + strings.Trim(name, "/")
+}
+
+func transformAlphabetNameFromPath(path string) (string, error) {
+	if !transformAlphabetNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no alphabet found")
+	}
+	res := transformAlphabetNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for alphabet", len(res))
+	}
+	return res[1], nil
+}
+
+func transformAlphabetBackendFromPath(path string) (string, error) {
+	if !transformAlphabetBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := transformAlphabetBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccTransformAlphabet_basic(t *testing.T) {
+	backend := "transform-" + acctest.RandString(10)
+	name := "alphabet-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t); testAccEnterprisePreCheck(t) },
+		CheckDestroy: testAccCheckTransformAlphabetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformAlphabetConfig_basic(backend, name, "0123456789"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transform_alphabet.test", "alphabet", "0123456789"),
+				),
+			},
+			{
+				Config: testAccTransformAlphabetConfig_basic(backend, name, "abcdefghijklmnopqrstuvwxyz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transform_alphabet.test", "alphabet", "abcdefghijklmnopqrstuvwxyz"),
+				),
+			},
+			{
+				ResourceName:      "vault_transform_alphabet.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTransformAlphabetDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_transform_alphabet" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("alphabet %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccTransformAlphabetConfig_basic(backend, name string, alphabet string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transform"
+  path = "%s"
+}
+
+resource "vault_transform_alphabet" "test" {
+  backend  = "${vault_mount.test.path}"
+  name     = "%s"
+  alphabet = "%s"
+}`, backend, name, alphabet)
+}
@@ -0,0 +1,169 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	transformRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/role/.+$")
+	transformRoleNameFromPathRegex    = regexp.MustCompile("^.+/role/(.+)$")
+)
+
+func transformRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: transformRoleWrite,
+		Read:   transformRoleRead,
+		Update: transformRoleWrite,
+		Delete: transformRoleDelete,
+		Exists: transformRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path the transform secret backend is mounted at.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the role.",
+			},
+			"transformations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The transformations that can be used with this role.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func transformRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultEnterprise(client, "1.4.0", "vault_transform_role"); err != nil {
+		return err
+	}
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := transformRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"transformations": toStringArray(d.Get("transformations").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Writing transform role %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing transform role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote transform role %q", path)
+
+	d.SetId(path)
+	return transformRoleRead(d, meta)
+}
+
+func transformRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := transformRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+	name, err := transformRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading transform role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transform role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read transform role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Transform role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	if err := d.Set("transformations", resp.Data["transformations"]); err != nil {
+		return fmt.Errorf("error setting transformations in state: %s", err)
+	}
+
+	return nil
+}
+
+func transformRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting transform role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting transform role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted transform role %q", path)
+	return nil
+}
+
+func transformRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if transform role %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if transform role %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if transform role %q exists", path)
+	return resp != nil, nil
+}
+
+func transformRolePath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/role/" + strings.Trim(name, "/")
+}
+
+func transformRoleNameFromPath(path string) (string, error) {
+	if !transformRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := transformRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
+
+func transformRoleBackendFromPath(path string) (string, error) {
+	if !transformRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := transformRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,90 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccTransformRole_basic(t *testing.T) {
+	backend := "transform-" + acctest.RandString(10)
+	name := "role-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t); testAccEnterprisePreCheck(t) },
+		CheckDestroy: testAccCheckTransformRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformRoleConfig_basic(backend, name, "${vault_transform_transformation.ccn.name}"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transform_role.test", "transformations.#", "1"),
+					resource.TestCheckResourceAttr("vault_transform_role.test", "transformations.0", "ccn"),
+				),
+			},
+			{
+				Config: testAccTransformRoleConfig_basic(backend, name, "${vault_transform_transformation.ssn.name}"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transform_role.test", "transformations.0", "ssn"),
+				),
+			},
+			{
+				ResourceName:      "vault_transform_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTransformRoleDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_transform_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccTransformRoleConfig_basic(backend, name string, transformation string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transform"
+  path = "%s"
+}
+
+resource "vault_transform_transformation" "ccn" {
+  backend      = "${vault_mount.test.path}"
+  name         = "ccn"
+  type         = "fpe"
+  template     = "builtin/creditcardnumber"
+  tweak_source = "internal"
+}
+
+resource "vault_transform_transformation" "ssn" {
+  backend      = "${vault_mount.test.path}"
+  name         = "ssn"
+  type         = "fpe"
+  template     = "builtin/socialsecuritynumber"
+  tweak_source = "internal"
+}
+
+resource "vault_transform_role" "test" {
+  backend         = "${vault_mount.test.path}"
+  name            = "%s"
+  transformations = ["%s"]
+}`, backend, name, transformation)
+}
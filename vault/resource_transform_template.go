@@ -0,0 +1,202 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	transformTemplateBackendFromPathRegex = regexp.MustCompile("^(.+)/template/.+$")
+	transformTemplateNameFromPathRegex    = regexp.MustCompile("^.+/template/(.+)$")
+)
+
+func transformTemplateResource() *schema.Resource {
+	return &schema.Resource{
+		Create: transformTemplateWrite,
+		Read:   transformTemplateRead,
+		Update: transformTemplateWrite,
+		Delete: transformTemplateDelete,
+		Exists: transformTemplateExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path the transform secret backend is mounted at.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the template.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "regex",
+				Description:  "The pattern type to use for match detection. Currently only \"regex\" is supported.",
+				ValidateFunc: validation.StringInSlice([]string{"regex"}, false),
+			},
+			"pattern": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The pattern used to match a particular value, with capture groups for the characters to transform.",
+			},
+			"alphabet": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the alphabet to use with this template.",
+			},
+			"encode_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The regular expression template used to format encoded values. Requires Vault 1.9 or later.",
+			},
+			"decode_formats": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Named regular expression templates used to format decoded values. Requires Vault 1.9 or later.",
+			},
+		},
+	}
+}
+
+func transformTemplateWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultEnterprise(client, "1.4.0", "vault_transform_template"); err != nil {
+		return err
+	}
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := transformTemplatePath(backend, name)
+
+	data := map[string]interface{}{
+		"type":     d.Get("type").(string),
+		"pattern":  d.Get("pattern").(string),
+		"alphabet": d.Get("alphabet").(string),
+	}
+	if v, ok := d.GetOk("encode_format"); ok {
+		data["encode_format"] = v.(string)
+	}
+	if v, ok := d.GetOk("decode_formats"); ok {
+		data["decode_formats"] = v
+	}
+
+	log.Printf("[DEBUG] Writing transform template %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing transform template %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote transform template %q", path)
+
+	d.SetId(path)
+	return transformTemplateRead(d, meta)
+}
+
+func transformTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := transformTemplateBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid template ID %q: %s", path, err)
+	}
+	name, err := transformTemplateNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid template ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading transform template %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transform template %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read transform template %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Transform template %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range []string{"type", "pattern", "alphabet", "encode_format"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+	if err := d.Set("decode_formats", resp.Data["decode_formats"]); err != nil {
+		return fmt.Errorf("error setting decode_formats in state: %s", err)
+	}
+
+	return nil
+}
+
+func transformTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting transform template %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting transform template %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted transform template %q", path)
+	return nil
+}
+
+func transformTemplateExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if transform template %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if transform template %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if transform template %q exists", path)
+	return resp != nil, nil
+}
+
+func transformTemplatePath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/template/" + strings.Trim(name, "/")
+}
+
+func transformTemplateNameFromPath(path string) (string, error) {
+	if !transformTemplateNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no template found")
+	}
+	res := transformTemplateNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for template", len(res))
+	}
+	return res[1], nil
+}
+
+func transformTemplateBackendFromPath(path string) (string, error) {
+	if !transformTemplateBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := transformTemplateBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
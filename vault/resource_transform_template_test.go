@@ -0,0 +1,87 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccTransformTemplate_basic(t *testing.T) {
+	backend := "transform-" + acctest.RandString(10)
+	name := "template-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t); testAccEnterprisePreCheck(t) },
+		CheckDestroy: testAccCheckTransformTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformTemplateConfig_basic(backend, name, `(\\d{4})-(\\d{4})-(\\d{4})-(\\d{4})`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transform_template.test", "type", "regex"),
+					resource.TestCheckResourceAttr("vault_transform_template.test", "alphabet", "numerics"),
+					resource.TestCheckResourceAttr("vault_transform_template.test", "decode_formats.%", "1"),
+				),
+			},
+			{
+				Config: testAccTransformTemplateConfig_basic(backend, name, `(\\d{4})(\\d{4})(\\d{4})(\\d{4})`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transform_template.test", "pattern", "(\\d{4})(\\d{4})(\\d{4})(\\d{4})"),
+				),
+			},
+			{
+				ResourceName:      "vault_transform_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTransformTemplateDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_transform_template" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("template %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccTransformTemplateConfig_basic(backend, name string, pattern string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transform"
+  path = "%s"
+}
+
+resource "vault_transform_alphabet" "test" {
+  backend  = "${vault_mount.test.path}"
+  name     = "numerics"
+  alphabet = "0123456789"
+}
+
+resource "vault_transform_template" "test" {
+  backend  = "${vault_mount.test.path}"
+  name     = "%s"
+  pattern  = "%s"
+  alphabet = "${vault_transform_alphabet.test.name}"
+
+  encode_format = "$1-$2-$3-$4"
+  decode_formats = {
+    "last-four" = "$4"
+  }
+}`, backend, name, pattern)
+}
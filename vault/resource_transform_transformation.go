@@ -0,0 +1,259 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	transformTransformationBackendFromPathRegex = regexp.MustCompile("^(.+)/transformations/.+$")
+	transformTransformationNameFromPathRegex    = regexp.MustCompile("^.+/transformations/(.+)$")
+)
+
+func transformTransformationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: transformTransformationWrite,
+		Read:   transformTransformationRead,
+		Update: transformTransformationWrite,
+		Delete: transformTransformationDelete,
+		Exists: transformTransformationExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path the transform secret backend is mounted at.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the transformation.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The type of transformation. Must be one of \"fpe\", \"masking\" or \"tokenization\".",
+				ValidateFunc: validation.StringInSlice([]string{"fpe", "masking", "tokenization"}, false),
+			},
+			"template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the template to use, for fpe and masking transformations.",
+			},
+			"tweak_source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The source of the tweak value for fpe transformations. Must be one of \"supplied\", \"generated\" or \"internal\".",
+				ValidateFunc: validation.StringInSlice([]string{"supplied", "generated", "internal"}, false),
+			},
+			"masking_character": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The character used to replace data for masking transformations.",
+			},
+			"allowed_roles": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The roles allowed to use this transformation.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"max_ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The maximum TTL of a token for tokenization transformations.",
+				ValidateFunc: validateDuration,
+			},
+			"mapping_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The mapping mode of tokenization transformations. Must be either \"default\" or \"exportable\".",
+				ValidateFunc: validation.StringInSlice([]string{"default", "exportable"}, false),
+			},
+			"convergent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Whether tokenization transformations return the same token for the same input. Requires Vault 1.11 or later.",
+			},
+			"stores": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "The storage backends tokenization transformations use.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deletion_allowed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the transformation can be deleted. Requires Vault 1.12 or later.",
+			},
+			"templates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The templates the transformation uses.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func transformTransformationWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	if err := checkVaultEnterprise(client, "1.4.0", "vault_transform_transformation"); err != nil {
+		return err
+	}
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := transformTransformationPath(backend, name)
+
+	data := map[string]interface{}{
+		"type":          d.Get("type").(string),
+		"allowed_roles": toStringArray(d.Get("allowed_roles").([]interface{})),
+	}
+	for _, k := range []string{"template", "tweak_source", "masking_character", "max_ttl", "mapping_mode"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("stores"); ok {
+		data["stores"] = toStringArray(v.([]interface{}))
+	}
+	// only sent when set, older versions of Vault reject these.
+	for _, k := range []string{"convergent", "deletion_allowed"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(bool)
+		}
+	}
+
+	log.Printf("[DEBUG] Writing transform transformation %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing transform transformation %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote transform transformation %q", path)
+
+	d.SetId(path)
+	return transformTransformationRead(d, meta)
+}
+
+func transformTransformationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := transformTransformationBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid transformation ID %q: %s", path, err)
+	}
+	name, err := transformTransformationNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid transformation ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading transform transformation %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transform transformation %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read transform transformation %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Transform transformation %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range []string{"type", "tweak_source", "masking_character", "max_ttl", "mapping_mode", "convergent", "deletion_allowed"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+	for _, k := range []string{"allowed_roles", "stores", "templates"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting %s in state: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func transformTransformationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting transform transformation %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting transform transformation %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted transform transformation %q", path)
+	return nil
+}
+
+func transformTransformationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if transform transformation %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if transform transformation %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if transform transformation %q exists", path)
+	return resp != nil, nil
+}
+
+func transformTransformationPath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/transformations/" + strings.Trim(name, "/")
+}
+
+func transformTransformationNameFromPath(path string) (string, error) {
+	if !transformTransformationNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no transformation found")
+	}
+	res := transformTransformationNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for transformation", len(res))
+	}
+	return res[1], nil
+}
+
+func transformTransformationBackendFromPath(path string) (string, error) {
+	if !transformTransformationBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := transformTransformationBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,81 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccTransformTransformation_basic(t *testing.T) {
+	backend := "transform-" + acctest.RandString(10)
+	name := "transformation-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t); testAccEnterprisePreCheck(t) },
+		CheckDestroy: testAccCheckTransformTransformationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformTransformationConfig_basic(backend, name, "payments"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transform_transformation.test", "type", "fpe"),
+					resource.TestCheckResourceAttr("vault_transform_transformation.test", "tweak_source", "internal"),
+					resource.TestCheckResourceAttr("vault_transform_transformation.test", "templates.#", "1"),
+					resource.TestCheckResourceAttr("vault_transform_transformation.test", "templates.0", "builtin/creditcardnumber"),
+					resource.TestCheckResourceAttr("vault_transform_transformation.test", "allowed_roles.0", "payments"),
+				),
+			},
+			{
+				Config: testAccTransformTransformationConfig_basic(backend, name, "billing"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transform_transformation.test", "allowed_roles.0", "billing"),
+				),
+			},
+			{
+				ResourceName:            "vault_transform_transformation.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template"},
+			},
+		},
+	})
+}
+
+func testAccCheckTransformTransformationDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_transform_transformation" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("transformation %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccTransformTransformationConfig_basic(backend, name string, role string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transform"
+  path = "%s"
+}
+
+resource "vault_transform_transformation" "test" {
+  backend       = "${vault_mount.test.path}"
+  name          = "%s"
+  type          = "fpe"
+  template      = "builtin/creditcardnumber"
+  tweak_source  = "internal"
+  allowed_roles = ["%s"]
+}`, backend, name, role)
+}
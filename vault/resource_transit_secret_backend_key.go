@@ -0,0 +1,423 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	transitSecretBackendKeyBackendFromPathRegex = regexp.MustCompile("^(.+)/keys/.+$")
+	transitSecretBackendKeyNameFromPathRegex    = regexp.MustCompile("^.+/keys/(.+)$")
+)
+
+func transitSecretBackendKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: transitSecretBackendKeyCreate,
+		Read:   transitSecretBackendKeyRead,
+		Update: transitSecretBackendKeyUpdate,
+		Delete: transitSecretBackendKeyDelete,
+		Exists: transitSecretBackendKeyExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Transit Secret Backend the key belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the encryption key to create.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "aes256-gcm96",
+				Description: "Specifies the type of key to create.",
+				ValidateFunc: validation.StringInSlice([]string{
+					"aes128-gcm96", "aes256-gcm96", "chacha20-poly1305", "ed25519",
+					"ecdsa-p256", "ecdsa-p384", "ecdsa-p521",
+					"rsa-2048", "rsa-3072", "rsa-4096", "hmac",
+				}, false),
+			},
+			"derived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Specifies if key derivation is to be used. If enabled, all encrypt/decrypt requests to this key must provide a context.",
+			},
+			"convergent_encryption": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether or not to support convergent encryption, where the same plaintext creates the same ciphertext. Requires derived to be true.",
+			},
+			"key_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The key size in bytes for HMAC keys.",
+			},
+			"exportable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables keys to be exported. Once enabled, this can't be disabled.",
+			},
+			"allow_plaintext_backup": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables taking a backup of the named key in plaintext format. Once enabled, this can't be disabled.",
+			},
+			"deletion_allowed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specifies if the key is allowed to be deleted.",
+			},
+			"min_decryption_version": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				Description:      "Minimum key version to use for decryption.",
+				DiffSuppressFunc: transitSecretBackendKeySuppressBumpedVersion("keep_decryption_versions"),
+			},
+			"min_encryption_version": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				Description:      "Minimum key version to use for encryption, 0 means the latest version.",
+				DiffSuppressFunc: transitSecretBackendKeySuppressBumpedVersion("encrypt_with_latest_version"),
+			},
+			"keep_decryption_versions": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "If set, min_decryption_version is raised whenever the key is rotated, so that only this many of the latest versions can decrypt.",
+			},
+			"encrypt_with_latest_version": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, min_encryption_version is raised to the latest version whenever the key is rotated.",
+			},
+			"auto_rotate_period": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Amount of seconds the key should live before being automatically rotated, 0 disables automatic rotation. Requires Vault 1.10 or later.",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value that rotates the key whenever it changes.",
+			},
+			"rotate": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary map of values that rotates the key whenever any of them changes.",
+			},
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Latest key version available.",
+			},
+			"min_available_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum key version available for use.",
+			},
+			"supports_encryption": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the key supports encryption.",
+			},
+			"supports_decryption": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the key supports decryption.",
+			},
+			"supports_derivation": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the key supports derivation.",
+			},
+			"supports_signing": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the key supports signing.",
+			},
+		},
+	}
+}
+
+func transitSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := transitSecretBackendKeyPath(backend, name)
+
+	data := map[string]interface{}{
+		"type":                   d.Get("type").(string),
+		"derived":                d.Get("derived").(bool),
+		"convergent_encryption":  d.Get("convergent_encryption").(bool),
+		"exportable":             d.Get("exportable").(bool),
+		"allow_plaintext_backup": d.Get("allow_plaintext_backup").(bool),
+	}
+	if v, ok := d.GetOk("key_size"); ok {
+		data["key_size"] = v.(int)
+	}
+	if v, ok := d.GetOk("auto_rotate_period"); ok {
+		data["auto_rotate_period"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Creating transit secret backend key %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error creating transit secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Created transit secret backend key %q", path)
+
+	d.SetId(path)
+
+	// the remaining settings can only be set once the key exists.
+	if err := transitSecretBackendKeyWriteConfig(client, d); err != nil {
+		return err
+	}
+
+	return transitSecretBackendKeyRead(d, meta)
+}
+
+func transitSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := transitSecretBackendKeyBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid key ID %q: %s", path, err)
+	}
+	name, err := transitSecretBackendKeyNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid key ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading transit secret backend key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transit secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read transit secret backend key %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Transit secret backend key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range []string{
+		"type", "derived", "convergent_encryption", "key_size", "exportable",
+		"allow_plaintext_backup", "deletion_allowed", "min_decryption_version",
+		"min_encryption_version", "auto_rotate_period", "latest_version",
+		"min_available_version", "supports_encryption", "supports_decryption",
+		"supports_derivation", "supports_signing",
+	} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func transitSecretBackendKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	d.Partial(true)
+
+	if d.HasChange("rotation_trigger") || d.HasChange("rotate") {
+		log.Printf("[DEBUG] Rotating transit secret backend key %q", path)
+		_, err := client.Logical().Write(path+"/rotate", nil)
+		if err != nil {
+			return fmt.Errorf("error rotating transit secret backend key %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Rotated transit secret backend key %q", path)
+		d.SetPartial("rotation_trigger")
+		d.SetPartial("rotate")
+	}
+
+	if err := transitSecretBackendKeyWriteConfig(client, d); err != nil {
+		return err
+	}
+
+	d.Partial(false)
+	return transitSecretBackendKeyRead(d, meta)
+}
+
+func transitSecretBackendKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting transit secret backend key %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting transit secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted transit secret backend key %q", path)
+	return nil
+}
+
+func transitSecretBackendKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Checking if transit secret backend key %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if transit secret backend key %q exists: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if transit secret backend key %q exists", path)
+	return resp != nil, nil
+}
+
+func transitSecretBackendKeyWriteConfig(client *api.Client, d *schema.ResourceData) error {
+	path := d.Id()
+
+	minDecryptionVersion := d.Get("min_decryption_version").(int)
+	minEncryptionVersion := d.Get("min_encryption_version").(int)
+	keep := d.Get("keep_decryption_versions").(int)
+	encryptWithLatest := d.Get("encrypt_with_latest_version").(bool)
+	if keep > 0 || encryptWithLatest {
+		latest, err := transitSecretBackendKeyLatestVersion(client, path)
+		if err != nil {
+			return err
+		}
+		minDecryptionVersion, minEncryptionVersion = transitSecretBackendKeyMinVersions(
+			latest, keep, encryptWithLatest, minDecryptionVersion, minEncryptionVersion)
+	}
+
+	data := map[string]interface{}{
+		"exportable":             d.Get("exportable").(bool),
+		"allow_plaintext_backup": d.Get("allow_plaintext_backup").(bool),
+		"deletion_allowed":       d.Get("deletion_allowed").(bool),
+		"min_decryption_version": minDecryptionVersion,
+		"min_encryption_version": minEncryptionVersion,
+		"auto_rotate_period":     d.Get("auto_rotate_period").(int),
+	}
+
+	log.Printf("[DEBUG] Configuring transit secret backend key %q", path)
+	_, err := client.Logical().Write(path+"/config", data)
+	if err != nil {
+		return fmt.Errorf("error configuring transit secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Configured transit secret backend key %q", path)
+	return nil
+}
+
+// transitSecretBackendKeyLatestVersion reads the latest version of a key,
+// e.g. right after it was rotated.
+func transitSecretBackendKeyLatestVersion(client *api.Client, path string) (int, error) {
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return 0, fmt.Errorf("error reading transit secret backend key %q: %s", path, err)
+	}
+	if resp == nil {
+		return 0, fmt.Errorf("transit secret backend key %q not found", path)
+	}
+	v, ok := resp.Data["latest_version"].(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("no latest_version returned for transit secret backend key %q", path)
+	}
+	latest, err := v.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("expected latest_version %q to be a number", v)
+	}
+	return int(latest), nil
+}
+
+// transitSecretBackendKeyMinVersions returns the minimum decryption and
+// encryption versions of a key whose latest version is latest. The versions
+// are only ever raised above the configured ones, so that only keep versions
+// can decrypt and, if encryptWithLatest, only the latest one can encrypt.
+func transitSecretBackendKeyMinVersions(latest, keep int, encryptWithLatest bool, minDecryptionVersion, minEncryptionVersion int) (int, int) {
+	if keep > 0 && latest-keep+1 > minDecryptionVersion {
+		minDecryptionVersion = latest - keep + 1
+	}
+	if encryptWithLatest && latest > minEncryptionVersion {
+		minEncryptionVersion = latest
+	}
+	return minDecryptionVersion, minEncryptionVersion
+}
+
+// transitSecretBackendKeySuppressBumpedVersion suppresses the diff of a
+// minimum version that was raised above the configured one because the
+// field managed is set.
+func transitSecretBackendKeySuppressBumpedVersion(managed string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if _, ok := d.GetOk(managed); !ok {
+			return false
+		}
+		o, err := strconv.Atoi(old)
+		if err != nil {
+			return false
+		}
+		n, err := strconv.Atoi(new)
+		if err != nil {
+			return false
+		}
+		return o > n
+	}
+}
+
+func transitSecretBackendKeyPath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/keys/" + strings.Trim(name, "/")
+}
+
+func transitSecretBackendKeyNameFromPath(path string) (string, error) {
+	if !transitSecretBackendKeyNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no key found")
+	}
+	res := transitSecretBackendKeyNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for key", len(res))
+	}
+	return res[1], nil
+}
+
+func transitSecretBackendKeyBackendFromPath(path string) (string, error) {
+	if !transitSecretBackendKeyBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := transitSecretBackendKeyBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,340 @@
+package vault
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var transitSecretBackendKeyImportHashFunctions = map[string]crypto.Hash{
+	"SHA1":   crypto.SHA1,
+	"SHA224": crypto.SHA224,
+	"SHA256": crypto.SHA256,
+	"SHA384": crypto.SHA384,
+	"SHA512": crypto.SHA512,
+}
+
+func transitSecretBackendKeyImportResource() *schema.Resource {
+	return &schema.Resource{
+		Create: transitSecretBackendKeyImportCreate,
+		Read:   transitSecretBackendKeyImportRead,
+		Update: transitSecretBackendKeyImportUpdate,
+		Delete: transitSecretBackendKeyDelete,
+		Exists: transitSecretBackendKeyExists,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Transit Secret Backend the key is imported into.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the key to import.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Specifies the type of the imported key.",
+				ValidateFunc: validation.StringInSlice([]string{
+					"aes128-gcm96", "aes256-gcm96", "chacha20-poly1305", "ed25519",
+					"ecdsa-p256", "ecdsa-p384", "ecdsa-p521",
+					"rsa-2048", "rsa-3072", "rsa-4096", "hmac",
+				}, false),
+			},
+			"key_material": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The base64-encoded key to import, raw bytes for symmetric keys or PKCS#8 DER for asymmetric ones.",
+			},
+			"hash_function": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "SHA256",
+				Description:  "The hash function used by the RSA-OAEP step of wrapping the key.",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA224", "SHA256", "SHA384", "SHA512"}, false),
+			},
+			"derived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Specifies if key derivation is to be used.",
+			},
+			"context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Base64-encoded context used to validate key derivation when derived is true.",
+			},
+			"allow_rotation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Allows Vault to rotate the imported key, the new versions are generated by Vault.",
+			},
+			"exportable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables keys to be exported. Once enabled, this can't be disabled.",
+			},
+			"allow_plaintext_backup": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables taking a backup of the named key in plaintext format. Once enabled, this can't be disabled.",
+			},
+			"deletion_allowed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specifies if the key is allowed to be deleted.",
+			},
+			"auto_rotate_period": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Amount of seconds the key should live before being automatically rotated, 0 disables automatic rotation. Requires allow_rotation.",
+			},
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Latest key version available.",
+			},
+		},
+	}
+}
+
+func transitSecretBackendKeyImportCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := transitSecretBackendKeyPath(backend, name)
+
+	key, err := base64.StdEncoding.DecodeString(d.Get("key_material").(string))
+	if err != nil {
+		return fmt.Errorf("error decoding key_material: %s", err)
+	}
+
+	wrappingKeyPath := strings.Trim(backend, "/") + "/wrapping_key"
+	log.Printf("[DEBUG] Reading wrapping key %q", wrappingKeyPath)
+	resp, err := client.Logical().Read(wrappingKeyPath)
+	if err != nil {
+		return fmt.Errorf("error reading wrapping key %q: %s", wrappingKeyPath, err)
+	}
+	log.Printf("[DEBUG] Read wrapping key %q", wrappingKeyPath)
+	if resp == nil {
+		return fmt.Errorf("no wrapping key found at %q", wrappingKeyPath)
+	}
+	publicKey, ok := resp.Data["public_key"].(string)
+	if !ok {
+		return fmt.Errorf("unexpected public_key in wrapping key %q", wrappingKeyPath)
+	}
+
+	hashFunction := d.Get("hash_function").(string)
+	ciphertext, err := transitWrapKeyMaterial(publicKey, transitSecretBackendKeyImportHashFunctions[hashFunction], key)
+	if err != nil {
+		return fmt.Errorf("error wrapping key_material: %s", err)
+	}
+
+	data := map[string]interface{}{
+		"ciphertext":             ciphertext,
+		"hash_function":          hashFunction,
+		"type":                   d.Get("type").(string),
+		"derived":                d.Get("derived").(bool),
+		"allow_rotation":         d.Get("allow_rotation").(bool),
+		"exportable":             d.Get("exportable").(bool),
+		"allow_plaintext_backup": d.Get("allow_plaintext_backup").(bool),
+	}
+	if v, ok := d.GetOk("context"); ok {
+		data["context"] = v.(string)
+	}
+	if v, ok := d.GetOk("auto_rotate_period"); ok {
+		data["auto_rotate_period"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Importing transit secret backend key %q", path)
+	_, err = client.Logical().Write(path+"/import", data)
+	if err != nil {
+		return fmt.Errorf("error importing transit secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Imported transit secret backend key %q", path)
+
+	d.SetId(path)
+
+	// deletion_allowed can't be passed to the import endpoint.
+	if d.Get("deletion_allowed").(bool) {
+		if err := transitSecretBackendKeyImportWriteConfig(client, d); err != nil {
+			return err
+		}
+	}
+
+	return transitSecretBackendKeyImportRead(d, meta)
+}
+
+func transitSecretBackendKeyImportRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading transit secret backend key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transit secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read transit secret backend key %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Transit secret backend key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	// the key material itself can never be read back.
+	for _, k := range []string{
+		"type", "derived", "exportable", "allow_plaintext_backup",
+		"deletion_allowed", "auto_rotate_period", "latest_version",
+	} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func transitSecretBackendKeyImportUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	if err := transitSecretBackendKeyImportWriteConfig(client, d); err != nil {
+		return err
+	}
+
+	return transitSecretBackendKeyImportRead(d, meta)
+}
+
+func transitSecretBackendKeyImportWriteConfig(client *api.Client, d *schema.ResourceData) error {
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"exportable":             d.Get("exportable").(bool),
+		"allow_plaintext_backup": d.Get("allow_plaintext_backup").(bool),
+		"deletion_allowed":       d.Get("deletion_allowed").(bool),
+		"auto_rotate_period":     d.Get("auto_rotate_period").(int),
+	}
+
+	log.Printf("[DEBUG] Configuring transit secret backend key %q", path)
+	_, err := client.Logical().Write(path+"/config", data)
+	if err != nil {
+		return fmt.Errorf("error configuring transit secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Configured transit secret backend key %q", path)
+	return nil
+}
+
+// transitWrapKeyMaterial wraps key the way the transit import endpoint
+// expects: the key is wrapped with a one-off AES-256 key using AES-KWP, and
+// that AES key is in turn encrypted with the PEM-encoded RSA wrapping key
+// using RSA-OAEP. The result is the base64 encoding of both concatenated.
+func transitWrapKeyMaterial(wrappingKey string, hash crypto.Hash, key []byte) (string, error) {
+	block, _ := pem.Decode([]byte(wrappingKey))
+	if block == nil {
+		return "", fmt.Errorf("wrapping key is not PEM encoded")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("error parsing wrapping key: %s", err)
+	}
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("wrapping key is not an RSA public key")
+	}
+
+	ephemeralKey := make([]byte, 32)
+	if _, err := rand.Read(ephemeralKey); err != nil {
+		return "", fmt.Errorf("error generating ephemeral key: %s", err)
+	}
+
+	wrappedKey, err := aesKeyWrapWithPadding(ephemeralKey, key)
+	if err != nil {
+		return "", err
+	}
+
+	wrappedEphemeralKey, err := rsa.EncryptOAEP(hash.New(), rand.Reader, rsaPub, ephemeralKey, nil)
+	if err != nil {
+		return "", fmt.Errorf("error encrypting ephemeral key: %s", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(append(wrappedEphemeralKey, wrappedKey...)), nil
+}
+
+// aesKeyWrapWithPadding implements the AES key wrap with padding algorithm
+// described in RFC 5649.
+func aesKeyWrapWithPadding(kek []byte, plaintext []byte) ([]byte, error) {
+	if len(plaintext) == 0 {
+		return nil, fmt.Errorf("cannot wrap an empty key")
+	}
+
+	cipher, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, fmt.Errorf("error creating key wrapping cipher: %s", err)
+	}
+
+	var aiv [8]byte
+	copy(aiv[:4], []byte{0xa6, 0x59, 0x59, 0xa6})
+	binary.BigEndian.PutUint32(aiv[4:], uint32(len(plaintext)))
+
+	padded := make([]byte, (len(plaintext)+7)/8*8)
+	copy(padded, plaintext)
+
+	if len(padded) == 8 {
+		out := make([]byte, 16)
+		copy(out, aiv[:])
+		copy(out[8:], padded)
+		cipher.Encrypt(out, out)
+		return out, nil
+	}
+
+	n := len(padded) / 8
+	a := aiv
+	r := padded
+	var b [16]byte
+	for j := 0; j < 6; j++ {
+		for i := 0; i < n; i++ {
+			copy(b[:8], a[:])
+			copy(b[8:], r[i*8:(i+1)*8])
+			cipher.Encrypt(b[:], b[:])
+			t := uint64(n*j + i + 1)
+			binary.BigEndian.PutUint64(a[:], binary.BigEndian.Uint64(b[:8])^t)
+			copy(r[i*8:(i+1)*8], b[8:])
+		}
+	}
+
+	return append(a[:], r...), nil
+}
@@ -0,0 +1,88 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAESKeyWrapWithPadding(t *testing.T) {
+	// test vectors from RFC 5649 section 6
+	kek, _ := hex.DecodeString("5840df6e29b02af1ab493b705bf16ea1ae8338f4dcc176a8")
+	for _, tc := range []struct {
+		key      string
+		expected string
+	}{
+		{"c37b7e6492584340bed12207808941155068f738", "138bdeaa9b8fa7fc61f97742e72248ee5ae6ae5360d1ae6a5f54f373fa543b6a"},
+		{"466f7250617369", "afbeb0f07dfbf5419200f2ccb50bb24f"},
+	} {
+		key, _ := hex.DecodeString(tc.key)
+		expected, _ := hex.DecodeString(tc.expected)
+		actual, err := aesKeyWrapWithPadding(kek, key)
+		if err != nil {
+			t.Fatalf("error wrapping %s: %s", tc.key, err)
+		}
+		if !bytes.Equal(actual, expected) {
+			t.Fatalf("wrapping %s: expected %x, got %x", tc.key, expected, actual)
+		}
+	}
+}
+
+func TestAccTransitSecretBackendKeyImport_basic(t *testing.T) {
+	backend := "transit-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitSecretBackendKeyImportConfig_basic(backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key_import.test", "type", "aes256-gcm96"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key_import.test", "latest_version", "1"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key_import.test", "exportable", "false"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "plaintext", "foo"),
+				),
+			},
+			{
+				Config: testAccTransitSecretBackendKeyImportConfig_basic(backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key_import.test", "exportable", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitSecretBackendKeyImportConfig_basic(backend string, exportable bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transit"
+  path = "%s"
+}
+
+resource "vault_transit_secret_backend_key_import" "test" {
+  backend          = "${vault_mount.test.path}"
+  name             = "test"
+  type             = "aes256-gcm96"
+  key_material     = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+  exportable       = %t
+  deletion_allowed = true
+}
+
+data "vault_transit_encrypt" "test" {
+  backend   = "${vault_mount.test.path}"
+  key       = "${vault_transit_secret_backend_key_import.test.name}"
+  plaintext = "foo"
+}
+
+data "vault_transit_decrypt" "test" {
+  backend    = "${vault_mount.test.path}"
+  key        = "${vault_transit_secret_backend_key_import.test.name}"
+  ciphertext = "${data.vault_transit_encrypt.test.ciphertext}"
+}`, backend, exportable)
+}
@@ -0,0 +1,195 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccTransitSecretBackendKey_basic(t *testing.T) {
+	backend := "transit-" + acctest.RandString(10)
+	name := "key-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckTransitSecretBackendKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitSecretBackendKeyConfig_basic(backend, name, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "name", name),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "type", "aes256-gcm96"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "deletion_allowed", "true"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "latest_version", "1"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "supports_encryption", "true"),
+				),
+			},
+			{
+				Config: testAccTransitSecretBackendKeyConfig_basic(backend, name, "rotated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "latest_version", "2"),
+				),
+			},
+			{
+				ResourceName:            "vault_transit_secret_backend_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotation_trigger", "rotate"},
+			},
+		},
+	})
+}
+
+func TestAccTransitSecretBackendKey_derived(t *testing.T) {
+	backend := "transit-" + acctest.RandString(10)
+	name := "key-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckTransitSecretBackendKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitSecretBackendKeyConfig_derived(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "derived", "true"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "convergent_encryption", "true"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "supports_derivation", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTransitSecretBackendKey_retireVersions(t *testing.T) {
+	backend := "transit-" + acctest.RandString(10)
+	name := "key-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckTransitSecretBackendKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitSecretBackendKeyConfig_retireVersions(backend, name, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "latest_version", "1"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "min_decryption_version", "1"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "min_encryption_version", "1"),
+				),
+			},
+			{
+				Config: testAccTransitSecretBackendKeyConfig_retireVersions(backend, name, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "latest_version", "2"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "min_decryption_version", "1"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "min_encryption_version", "2"),
+				),
+			},
+			{
+				Config: testAccTransitSecretBackendKeyConfig_retireVersions(backend, name, "3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "latest_version", "3"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "min_decryption_version", "2"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "min_encryption_version", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestTransitSecretBackendKeyMinVersions(t *testing.T) {
+	for _, tc := range []struct {
+		latest, keep                 int
+		encryptWithLatest            bool
+		minDecryption, minEncryption int
+		expectedDecryption           int
+		expectedEncryption           int
+	}{
+		{latest: 5, minDecryption: 1, expectedDecryption: 1},
+		{latest: 5, keep: 2, minDecryption: 1, expectedDecryption: 4},
+		{latest: 5, keep: 2, minDecryption: 5, expectedDecryption: 5},
+		{latest: 1, keep: 2, minDecryption: 1, expectedDecryption: 1},
+		{latest: 5, encryptWithLatest: true, minDecryption: 1, expectedDecryption: 1, expectedEncryption: 5},
+	} {
+		minDecryption, minEncryption := transitSecretBackendKeyMinVersions(tc.latest, tc.keep, tc.encryptWithLatest, tc.minDecryption, tc.minEncryption)
+		if minDecryption != tc.expectedDecryption || minEncryption != tc.expectedEncryption {
+			t.Errorf("expected versions %d and %d for %+v, got %d and %d", tc.expectedDecryption, tc.expectedEncryption, tc, minDecryption, minEncryption)
+		}
+	}
+}
+
+func testAccCheckTransitSecretBackendKeyDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_transit_secret_backend_key" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("key %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccTransitSecretBackendKeyConfig_basic(backend string, name string, trigger string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transit"
+  path = "%s"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = "${vault_mount.test.path}"
+  name             = "%s"
+  deletion_allowed = true
+  rotation_trigger = "%s"
+}`, backend, name, trigger)
+}
+
+func testAccTransitSecretBackendKeyConfig_derived(backend string, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transit"
+  path = "%s"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend               = "${vault_mount.test.path}"
+  name                  = "%s"
+  derived               = true
+  convergent_encryption = true
+  deletion_allowed      = true
+}`, backend, name)
+}
+
+func testAccTransitSecretBackendKeyConfig_retireVersions(backend string, name string, generation string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transit"
+  path = "%s"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend                     = "${vault_mount.test.path}"
+  name                        = "%s"
+  deletion_allowed            = true
+  keep_decryption_versions    = 2
+  encrypt_with_latest_version = true
+
+  rotate = {
+    generation = "%s"
+  }
+}`, backend, name, generation)
+}
@@ -0,0 +1,117 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitSecretCacheConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Create: transitSecretCacheConfigWrite,
+		Read:   transitSecretCacheConfigRead,
+		Update: transitSecretCacheConfigWrite,
+		Delete: transitSecretCacheConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Transit Secret Backend the cache config belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Number of cache entries. A size of 0 means unlimited, otherwise it must be at least 10.",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					value := v.(int)
+					if value != 0 && value < 10 {
+						errs = append(errs, fmt.Errorf("%s must be 0 or at least 10", k))
+					}
+					return
+				},
+			},
+		},
+	}
+}
+
+func transitSecretCacheConfigWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	path := transitSecretCacheConfigPath(backend)
+
+	data := map[string]interface{}{
+		"size": d.Get("size").(int),
+	}
+
+	log.Printf("[DEBUG] Writing cache config on transit secret backend %q", backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing cache config on transit secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote cache config on transit secret backend %q", backend)
+
+	d.SetId(backend)
+	return transitSecretCacheConfigRead(d, meta)
+}
+
+func transitSecretCacheConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := transitSecretCacheConfigPath(backend)
+
+	log.Printf("[DEBUG] Reading cache config from transit secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading cache config on transit secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read cache config from transit secret backend %q", backend)
+	if resp == nil {
+		log.Printf("[WARN] Cache config not found on transit secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("size", resp.Data["size"])
+
+	return nil
+}
+
+func transitSecretCacheConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	path := transitSecretCacheConfigPath(backend)
+
+	log.Printf("[DEBUG] Resetting cache config on transit secret backend %q", backend)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"size": 0,
+	})
+	if err != nil {
+		return fmt.Errorf("error resetting cache config on transit secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Reset cache config on transit secret backend %q", backend)
+
+	return nil
+}
+
+func transitSecretCacheConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/cache-config"
+}
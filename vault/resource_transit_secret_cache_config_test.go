@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTransitSecretCacheConfig_basic(t *testing.T) {
+	backend := "transit-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitSecretCacheConfigConfig_basic(backend, 500),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_cache_config.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_transit_secret_cache_config.test", "size", "500"),
+				),
+			},
+			{
+				Config: testAccTransitSecretCacheConfigConfig_basic(backend, 1000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_cache_config.test", "size", "1000"),
+				),
+			},
+			{
+				ResourceName:      "vault_transit_secret_cache_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTransitSecretCacheConfigConfig_basic(backend string, size int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "transit"
+  path = "%s"
+}
+
+resource "vault_transit_secret_cache_config" "test" {
+  backend = "${vault_mount.test.path}"
+  size    = %d
+}`, backend, size)
+}
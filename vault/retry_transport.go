@@ -0,0 +1,93 @@
+package vault
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// retryTransportStatusCodes are the status codes that requests are always
+// retried on: 429 when a rate limit quota is hit, and 472 and 473 of the
+// disaster recovery and performance standby nodes.
+var retryTransportStatusCodes = []int{http.StatusTooManyRequests, 472, 473}
+
+// retryTransport retries requests answered with one of the given status
+// codes. The retries are split between two layers: the Vault client retries
+// the 5xx responses, except 501, by itself, and this transport retries the
+// others, such as 429 when a rate limit quota is hit. It never retries the
+// responses the client retries, as each retry of the client would otherwise
+// run all the retries of the transport.
+type retryTransport struct {
+	transport   http.RoundTripper
+	statusCodes map[int]bool
+	maxRetries  int
+	waitMin     time.Duration
+	waitMax     time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// the body is replayed on every attempt
+	var body []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	for attempt := 0; ; attempt++ {
+		r := req.WithContext(req.Context())
+		if body != nil {
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := t.transport.RoundTrip(r)
+		if err != nil || !t.statusCodes[resp.StatusCode] || retriedByClient(resp.StatusCode) || attempt >= t.maxRetries {
+			return resp, err
+		}
+
+		wait := exponentialJitterBackoff(t.waitMin, t.waitMax, attempt, resp)
+		if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && time.Duration(s)*time.Second > wait {
+			wait = time.Duration(s) * time.Second
+		}
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+
+		log.Printf("[DEBUG] Vault responded with status %d to %s %s, retrying in %s", resp.StatusCode, req.Method, req.URL.Path, wait)
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// retriedByClient tells whether the Vault client retries the responses with
+// the status code by itself, as retryablehttp.DefaultRetryPolicy does.
+func retriedByClient(statusCode int) bool {
+	return statusCode >= 500 && statusCode != http.StatusNotImplemented
+}
+
+// exponentialJitterBackoff is the retryablehttp.Backoff of the requests to
+// Vault. The wait doubles with each attempt, starting at min, and is
+// extended by up to half of it at random so that the requests of parallel
+// resources don't all retry at once. It never exceeds max.
+func exponentialJitterBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	wait := retryablehttp.DefaultBackoff(min, max, attemptNum, resp)
+	if wait/2 > 0 {
+		wait += time.Duration(rand.Int63n(int64(wait / 2)))
+	}
+	if wait > max {
+		wait = max
+	}
+	return wait
+}
@@ -0,0 +1,121 @@
+package vault
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("bad body on attempt %d: %q", attempts, body)
+		}
+		if attempts < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name             string
+		maxRetries       int
+		expectedStatus   int
+		expectedAttempts int
+	}{
+		{
+			name:             "Retried",
+			maxRetries:       2,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "RetriesExhausted",
+			maxRetries:       1,
+			expectedStatus:   http.StatusTooManyRequests,
+			expectedAttempts: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			attempts = 0
+			client := &http.Client{
+				Transport: &retryTransport{
+					transport:   http.DefaultTransport,
+					statusCodes: map[int]bool{http.StatusTooManyRequests: true},
+					maxRetries:  tc.maxRetries,
+					waitMin:     time.Millisecond,
+					waitMax:     2 * time.Millisecond,
+				},
+			}
+
+			resp, err := client.Post(server.URL, "text/plain", bytes.NewBufferString("payload"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.expectedStatus {
+				t.Errorf("bad status: want %d, got %d", tc.expectedStatus, resp.StatusCode)
+			}
+			if attempts != tc.expectedAttempts {
+				t.Errorf("bad number of attempts: want %d, got %d", tc.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_clientRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	// the 503 is retried by the Vault client, so the transport must not
+	// retry it too even when it is configured to.
+	client := &http.Client{
+		Transport: &retryTransport{
+			transport:   http.DefaultTransport,
+			statusCodes: map[int]bool{http.StatusServiceUnavailable: true},
+			maxRetries:  2,
+			waitMin:     time.Millisecond,
+			waitMax:     2 * time.Millisecond,
+		},
+	}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if attempts != 1 {
+		t.Errorf("bad number of attempts: want 1, got %d", attempts)
+	}
+}
+
+func TestExponentialJitterBackoff(t *testing.T) {
+	min := 100 * time.Millisecond
+	max := time.Second
+	for attempt, base := range []time.Duration{100, 200, 400, 800} {
+		base *= time.Millisecond
+		wait := exponentialJitterBackoff(min, max, attempt, nil)
+		if wait < base || wait > base+base/2 || wait > max {
+			t.Errorf("bad wait for attempt %d: %s", attempt, wait)
+		}
+	}
+	if wait := exponentialJitterBackoff(min, max, 10, nil); wait != max {
+		t.Errorf("bad wait for attempt 10: want %s, got %s", max, wait)
+	}
+}
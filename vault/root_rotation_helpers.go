@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// rootRotationFields are the fields of the automated rotation of the root
+// credentials of a secrets engine, which Vault Enterprise 1.19 added to the
+// configuration endpoints of the engines.
+var rootRotationFields = []string{
+	"rotation_period",
+	"rotation_schedule",
+	"rotation_window",
+	"disable_automated_rotation",
+}
+
+// withRootRotationSchema adds the fields of the automated rotation of the
+// root credentials to the schema of a secrets engine configuration.
+func withRootRotationSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["rotation_period"] = &schema.Schema{
+		Type:          schema.TypeInt,
+		Optional:      true,
+		Description:   "Number of seconds between the automated rotations of the root credentials. Requires Vault Enterprise 1.19 or later.",
+		ConflictsWith: []string{"rotation_schedule"},
+	}
+	s["rotation_schedule"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "Cron-style schedule of the automated rotations of the root credentials. Requires Vault Enterprise 1.19 or later.",
+		ConflictsWith: []string{"rotation_period"},
+	}
+	s["rotation_window"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "Number of seconds after the time of rotation_schedule during which the rotation may happen. Requires Vault Enterprise 1.19 or later.",
+	}
+	s["disable_automated_rotation"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Cancel the upcoming automated rotations of the root credentials, keeping their schedule. Requires Vault Enterprise 1.19 or later.",
+	}
+	return s
+}
+
+// setRootRotationData adds the fields of the automated rotation of the root
+// credentials that are set, or were unset, to the data written to the
+// configuration endpoint of a secrets engine. client must be the client of
+// the provider, as the clones of clientWithTimeout have no known version and
+// would skip the version check.
+func setRootRotationData(client *api.Client, d *schema.ResourceData, data map[string]interface{}) error {
+	for _, k := range rootRotationFields {
+		v, ok := d.GetOk(k)
+		if !ok && !d.HasChange(k) {
+			continue
+		}
+		if ok {
+			if err := checkVaultEnterprise(client, "1.19.0", k); err != nil {
+				return err
+			}
+		}
+		data[k] = v
+	}
+	return nil
+}
+
+// readRootRotationData sets the fields of the automated rotation of the root
+// credentials from the configuration of a secrets engine. Older versions of
+// Vault don't return them.
+func readRootRotationData(d *schema.ResourceData, data map[string]interface{}) {
+	for _, k := range rootRotationFields {
+		if v, ok := data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+}
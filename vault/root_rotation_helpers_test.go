@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestSetRootRotationData(t *testing.T) {
+	client, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := withRootRotationSchema(map[string]*schema.Schema{})
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"rotation_schedule": "0 * * * SAT",
+		"rotation_window":   3600,
+	})
+
+	data := map[string]interface{}{}
+	if err := setRootRotationData(client, d, data); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"rotation_schedule": "0 * * * SAT",
+		"rotation_window":   3600,
+	}
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("expected %v, got %v", expected, data)
+	}
+
+	for _, tc := range []struct {
+		version string
+		valid   bool
+	}{
+		{version: "1.19.0+ent", valid: true},
+		{version: "1.18.3+ent", valid: false},
+		{version: "1.19.0", valid: false},
+	} {
+		setVaultVersion(client, version.Must(version.NewVersion(tc.version)))
+		err := setRootRotationData(client, d, map[string]interface{}{})
+		if tc.valid && err != nil {
+			t.Errorf("expected Vault %s to support the automated root rotation, got %s", tc.version, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("expected Vault %s not to support the automated root rotation", tc.version)
+		}
+	}
+}
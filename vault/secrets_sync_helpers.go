@@ -0,0 +1,45 @@
+package vault
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/vault/api"
+)
+
+const secretsSyncDestinationsPath = "sys/sync/destinations"
+
+func secretsSyncDestinationPath(destType, name string) string {
+	return fmt.Sprintf("%s/%s/%s", secretsSyncDestinationsPath, destType, name)
+}
+
+// patchRequest updates the fields of data at path with a JSON merge patch,
+// which the secrets sync endpoints use for updates instead of writes.
+func patchRequest(client *api.Client, path string, data map[string]interface{}) (*api.Secret, error) {
+	r := client.NewRequest("PATCH", "/v1/"+path)
+	// the headers of the request are shared with the client.
+	headers := http.Header{}
+	for k, v := range r.Headers {
+		headers[k] = v
+	}
+	headers.Set("Content-Type", "application/merge-patch+json")
+	r.Headers = headers
+	if err := r.SetJSONBody(data); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.RawRequest(r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := api.ParseSecret(resp.Body)
+	if err == io.EOF {
+		return nil, nil
+	}
+	return secret, err
+}
@@ -0,0 +1,122 @@
+package vault
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+)
+
+func TestPatchRequest(t *testing.T) {
+	var method, contentType string
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		contentType = r.Header.Get("Content-Type")
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := patchRequest(client, "sys/sync/config", map[string]interface{}{"disabled": true}); err != nil {
+		t.Fatal(err)
+	}
+	if method != "PATCH" || contentType != "application/merge-patch+json" {
+		t.Errorf("expected a merge patch request, got %s with %q", method, contentType)
+	}
+	if expected := map[string]interface{}{"disabled": true}; !reflect.DeepEqual(body, expected) {
+		t.Errorf("expected %v, got %v", expected, body)
+	}
+	if v := client.Headers().Get("Content-Type"); v != "" {
+		t.Errorf("expected the headers of the client to be left alone, got %q", v)
+	}
+}
+
+func TestSecretsSyncAssociationParseID(t *testing.T) {
+	destType, name, mount, secretName, err := secretsSyncAssociationParseID("type=gh::name=repo::mount=kv/apps::secret_name=team/app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if destType != "gh" || name != "repo" || mount != "kv/apps" || secretName != "team/app" {
+		t.Errorf("unexpected parts %q, %q, %q and %q", destType, name, mount, secretName)
+	}
+	if _, _, _, _, err := secretsSyncAssociationParseID("gh/repo"); err == nil {
+		t.Errorf("expected an invalid ID to fail")
+	}
+}
+
+func TestSecretsSyncAssociationRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/sys/mounts/kvv2":
+			w.Write([]byte(`{"data": {"type": "kv", "accessor": "kv_1234"}}`))
+		case "/v1/sys/sync/destinations/gh/repo/associations":
+			w.Write([]byte(`{"data": {
+				"associated_secrets": {
+					"kv_1234/db": {
+						"accessor": "kv_1234",
+						"secret_name": "db",
+						"sync_status": "SYNCED",
+						"updated_at": "2026-10-15T10:00:00Z"
+					},
+					"kv_5678/db": {
+						"accessor": "kv_5678",
+						"secret_name": "db",
+						"sync_status": "UNSYNCED",
+						"updated_at": "2026-10-14T10:00:00Z"
+					}
+				}
+			}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		id     string
+		found  bool
+		status string
+	}{
+		{id: "type=gh::name=repo::mount=kvv2::secret_name=db", found: true, status: "SYNCED"},
+		{id: "type=gh::name=repo::mount=kvv2::secret_name=other", found: false},
+		{id: "type=gh::name=repo::mount=missing::secret_name=db", found: false},
+	} {
+		d := secretsSyncAssociationResource().TestResourceData()
+		d.SetId(tc.id)
+		if err := secretsSyncAssociationRead(d, client); err != nil {
+			t.Fatalf("%s: %s", tc.id, err)
+		}
+		if found := d.Id() != ""; found != tc.found {
+			t.Errorf("%s: expected found to be %t, got %t", tc.id, tc.found, found)
+			continue
+		}
+		if !tc.found {
+			continue
+		}
+		if v := d.Get("sync_status").(string); v != tc.status {
+			t.Errorf("%s: expected sync_status %q, got %q", tc.id, tc.status, v)
+		}
+		if v := d.Get("mount").(string); v != "kvv2" {
+			t.Errorf("%s: expected mount %q, got %q", tc.id, "kvv2", v)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// stateUpgradeFunc upgrades the state of a resource from one schema version
+// to the next. It must modify the state in place: on refresh, the state
+// returned by MigrateState is discarded and the state passed to it is used.
+type stateUpgradeFunc func(s *terraform.InstanceState) (*terraform.InstanceState, error)
+
+// stateUpgraders returns the MigrateState function of a resource whose state
+// is upgraded from schema version v to v+1 by upgraders[v]. The state of an
+// older version is upgraded by each of the following upgraders in turn, so
+// the SchemaVersion of the resource must be len(upgraders).
+func stateUpgraders(name string, upgraders ...stateUpgradeFunc) schema.StateMigrateFunc {
+	return func(v int, s *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+		if s.Empty() {
+			log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+			return s, nil
+		}
+
+		if v < 0 || v >= len(upgraders) {
+			return s, fmt.Errorf("unexpected schema version: %d", v)
+		}
+
+		for ; v < len(upgraders); v++ {
+			log.Printf("[INFO] Found %s state v%d; migrating to v%d", name, v, v+1)
+			var err error
+			if s, err = upgraders[v](s); err != nil {
+				return s, err
+			}
+		}
+		return s, nil
+	}
+}
+
+// stateRenameAttribute renames the attribute from of the state, along with
+// the elements of a list, set or map, to to.
+func stateRenameAttribute(s *terraform.InstanceState, from, to string) {
+	for k, v := range s.Attributes {
+		if k == from || strings.HasPrefix(k, from+".") {
+			delete(s.Attributes, k)
+			s.Attributes[to+strings.TrimPrefix(k, from)] = v
+		}
+	}
+}
+
+// stateListToSet converts the elements of the string list attribute name of
+// the state to the elements of a set of strings, e.g. when the attribute is
+// changed from a TypeList to a TypeSet, so that they are keyed by their hash
+// instead of their index.
+func stateListToSet(s *terraform.InstanceState, name string) {
+	prefix := name + "."
+	elements := map[string]bool{}
+	for k, v := range s.Attributes {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		if _, err := strconv.Atoi(strings.TrimPrefix(k, prefix)); err == nil {
+			elements[v] = true
+			delete(s.Attributes, k)
+		}
+	}
+	for v := range elements {
+		s.Attributes[prefix+strconv.Itoa(schema.HashString(v))] = v
+	}
+	if _, ok := s.Attributes[prefix+"#"]; ok {
+		s.Attributes[prefix+"#"] = strconv.Itoa(len(elements))
+	}
+}
@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestStateUpgraders(t *testing.T) {
+	migrate := stateUpgraders("test",
+		func(s *terraform.InstanceState) (*terraform.InstanceState, error) {
+			stateRenameAttribute(s, "policy_names", "policies")
+			return s, nil
+		},
+		func(s *terraform.InstanceState) (*terraform.InstanceState, error) {
+			stateListToSet(s, "policies")
+			return s, nil
+		},
+	)
+
+	cases := map[string]struct {
+		StateVersion int
+		Attributes   map[string]string
+		Expected     map[string]string
+	}{
+		"from v0": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"name":           "test",
+				"policy_names.#": "3",
+				"policy_names.0": "dev",
+				"policy_names.1": "prod",
+				"policy_names.2": "dev",
+			},
+			Expected: map[string]string{
+				"name":       "test",
+				"policies.#": "2",
+				"policies." + strconv.Itoa(schema.HashString("dev")):  "dev",
+				"policies." + strconv.Itoa(schema.HashString("prod")): "prod",
+			},
+		},
+		"from v1": {
+			StateVersion: 1,
+			Attributes: map[string]string{
+				"name":       "test",
+				"policies.#": "1",
+				"policies.0": "dev",
+			},
+			Expected: map[string]string{
+				"name":       "test",
+				"policies.#": "1",
+				"policies." + strconv.Itoa(schema.HashString("dev")): "dev",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         "test",
+			Attributes: tc.Attributes,
+		}
+		if _, err := migrate(tc.StateVersion, is, nil); err != nil {
+			t.Fatalf("Unexpected error for migration %q: %+v", tn, err)
+		}
+		if !reflect.DeepEqual(is.Attributes, tc.Expected) {
+			t.Fatalf("Expected attributes %#v for %q, got %#v", tc.Expected, tn, is.Attributes)
+		}
+	}
+
+	if _, err := migrate(2, &terraform.InstanceState{ID: "test", Attributes: map[string]string{"name": "test"}}, nil); err == nil {
+		t.Fatal("Expected an error for an unknown schema version")
+	}
+}
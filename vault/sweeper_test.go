@@ -0,0 +1,160 @@
+package vault
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-vault/testutil"
+)
+
+// TestMain starts a Vault dev server in Docker for the acceptance tests when
+// VAULT_ADDR isn't set. The image can be set with VAULT_ACC_IMAGE, e.g. to
+// testutil.EnterpriseImage. Otherwise, and with the -sweep flag, it runs the
+// tests or sweepers against the Vault in VAULT_ADDR.
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if os.Getenv(resource.TestEnvVar) == "" || os.Getenv("VAULT_ADDR") != "" || flag.Lookup("sweep").Value.String() != "" {
+		resource.TestMain(m)
+		return
+	}
+
+	image := os.Getenv("VAULT_ACC_IMAGE")
+	if image == "" {
+		image = testutil.DevImage
+	}
+	server, err := testutil.StartVault(image)
+	if err != nil {
+		log.Fatalf("[ERROR] %s", err)
+	}
+	os.Setenv("VAULT_ADDR", server.Address)
+	os.Setenv("VAULT_TOKEN", server.Token)
+
+	code := m.Run()
+	if err := server.Stop(); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+	os.Exit(code)
+}
+
+// The sweepers remove the mounts, policies and identity objects left over by
+// failed acceptance tests from the Vault in VAULT_ADDR with make sweep. They
+// only remove the objects whose name matches testutil.IsTestName.
+func init() {
+	resource.AddTestSweepers("vault_mount", &resource.Sweeper{
+		Name: "vault_mount",
+		F:    sweepMounts,
+	})
+	resource.AddTestSweepers("vault_auth_backend", &resource.Sweeper{
+		Name: "vault_auth_backend",
+		F:    sweepAuthBackends,
+	})
+	resource.AddTestSweepers("vault_policy", &resource.Sweeper{
+		Name: "vault_policy",
+		F:    sweepPolicies,
+	})
+	resource.AddTestSweepers("vault_identity_group", &resource.Sweeper{
+		Name: "vault_identity_group",
+		F:    sweepListedPaths("identity/group/name"),
+	})
+	resource.AddTestSweepers("vault_token_auth_backend_role", &resource.Sweeper{
+		Name: "vault_token_auth_backend_role",
+		F:    sweepListedPaths("auth/token/roles"),
+	})
+}
+
+func sweepMounts(region string) error {
+	client, err := testutil.NewClient()
+	if err != nil {
+		return err
+	}
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error listing mounts: %s", err)
+	}
+	for path := range mounts {
+		if !testutil.IsTestName(path) {
+			continue
+		}
+		log.Printf("[INFO] Unmounting %q", path)
+		if err := client.Sys().Unmount(path); err != nil {
+			return fmt.Errorf("error unmounting %q: %s", path, err)
+		}
+	}
+	return nil
+}
+
+func sweepAuthBackends(region string) error {
+	client, err := testutil.NewClient()
+	if err != nil {
+		return err
+	}
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return fmt.Errorf("error listing auth backends: %s", err)
+	}
+	for path := range auths {
+		if !testutil.IsTestName(path) {
+			continue
+		}
+		log.Printf("[INFO] Disabling auth backend %q", path)
+		if err := client.Sys().DisableAuth(path); err != nil {
+			return fmt.Errorf("error disabling auth backend %q: %s", path, err)
+		}
+	}
+	return nil
+}
+
+func sweepPolicies(region string) error {
+	client, err := testutil.NewClient()
+	if err != nil {
+		return err
+	}
+	policies, err := client.Sys().ListPolicies()
+	if err != nil {
+		return fmt.Errorf("error listing policies: %s", err)
+	}
+	for _, name := range policies {
+		if !testutil.IsTestName(name) {
+			continue
+		}
+		log.Printf("[INFO] Deleting policy %q", name)
+		if err := client.Sys().DeletePolicy(name); err != nil {
+			return fmt.Errorf("error deleting policy %q: %s", name, err)
+		}
+	}
+	return nil
+}
+
+// sweepListedPaths returns a sweeper deleting the keys listed at path.
+func sweepListedPaths(path string) resource.SweeperFunc {
+	return func(region string) error {
+		client, err := testutil.NewClient()
+		if err != nil {
+			return err
+		}
+		secret, err := client.Logical().List(path)
+		if err != nil {
+			return fmt.Errorf("error listing %q: %s", path, err)
+		}
+		if secret == nil || secret.Data["keys"] == nil {
+			return nil
+		}
+		for _, key := range secret.Data["keys"].([]interface{}) {
+			name := key.(string)
+			if !testutil.IsTestName(name) {
+				continue
+			}
+			keyPath := path + "/" + strings.Trim(name, "/")
+			log.Printf("[INFO] Deleting %q", keyPath)
+			if _, err := client.Logical().Delete(keyPath); err != nil {
+				return fmt.Errorf("error deleting %q: %s", keyPath, err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,23 @@
+package vault
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// clientWithTimeout returns a copy of client whose requests time out after
+// timeout instead of the request timeout of the provider. It is used for the
+// operations of the resources that have configurable timeouts, e.g. key
+// generation or the verification of a database connection.
+func clientWithTimeout(client *api.Client, timeout time.Duration) (*api.Client, error) {
+	c, err := client.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("error cloning the Vault client: %s", err)
+	}
+	c.SetToken(client.Token())
+	c.SetHeaders(client.Headers())
+	c.SetClientTimeout(timeout)
+	return c, nil
+}
@@ -0,0 +1,54 @@
+package vault
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+)
+
+func TestClientWithTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "test-token" {
+			t.Errorf("unexpected token %q", r.Header.Get("X-Vault-Token"))
+		}
+		if r.Header.Get("X-Vault-Namespace") != "test-namespace" {
+			t.Errorf("unexpected namespace %q", r.Header.Get("X-Vault-Namespace"))
+		}
+		if r.URL.Path == "/v1/secret/slow" {
+			time.Sleep(500 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"foo": "bar"}}`))
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	config.MaxRetries = 0
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetToken("test-token")
+	client.SetNamespace("test-namespace")
+
+	c, err := clientWithTimeout(client, 100*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret, err := c.Logical().Read("secret/fast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret.Data["foo"] != "bar" {
+		t.Fatalf("unexpected data %#v", secret.Data)
+	}
+
+	if _, err := c.Logical().Read("secret/slow"); err == nil {
+		t.Fatal("expected the request to time out")
+	}
+}
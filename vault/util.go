@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/helper/parseutil"
 )
 
 func jsonDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
@@ -25,6 +29,40 @@ func jsonDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	return reflect.DeepEqual(oldJSON, newJSON)
 }
 
+// durationDaysRegex matches the durations in days, e.g. 30d, which Vault
+// accepts since 1.2.
+var durationDaysRegex = regexp.MustCompile(`^\d+d$`)
+
+// parseDuration parses a duration of Vault, given as a number of seconds, a
+// number of days, e.g. 30d, or a duration string, e.g. 1h30m.
+func parseDuration(value string) (time.Duration, error) {
+	if durationDaysRegex.MatchString(value) {
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	return parseutil.ParseDurationSecond(value)
+}
+
+// durationDiffSuppress suppresses the diff between two equal durations, e.g.
+// a configured 1h and the 3600 seconds Vault returns for it.
+func durationDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+	oldDuration, err := parseDuration(old)
+	if err != nil {
+		return false
+	}
+	newDuration, err := parseDuration(new)
+	if err != nil {
+		return false
+	}
+	return oldDuration == newDuration
+}
+
 func toStringArray(input []interface{}) []string {
 	output := make([]string, len(input))
 
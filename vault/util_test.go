@@ -55,3 +55,24 @@ func TestExpiredTokenError(t *testing.T) {
 		t.Errorf("Shouldn't be expired")
 	}
 }
+
+func TestDurationDiffSuppress(t *testing.T) {
+	cases := []struct {
+		Old, New string
+		Suppress bool
+	}{
+		{"3600", "1h", true},
+		{"3600", "3600", true},
+		{"5400", "1h30m", true},
+		{"2592000", "30d", true},
+		{"3600", "2h", false},
+		{"", "1h", false},
+		{"3600", "one hour", false},
+	}
+
+	for _, tc := range cases {
+		if suppress := durationDiffSuppress("ttl", tc.Old, tc.New, nil); suppress != tc.Suppress {
+			t.Errorf("expected the diff from %q to %q to be suppressed: %t", tc.Old, tc.New, tc.Suppress)
+		}
+	}
+}
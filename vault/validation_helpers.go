@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// validateMountPath validates the path of a secret or auth mount, which Vault
+// rejects or mounts somewhere else when it starts or ends with a slash or
+// contains spaces.
+var validateMountPath = validateAll(validateNoLeadingSlash, validateNoTrailingSlash, validateNoSpaces)
+
+// validateAll returns a ValidateFunc running all validators and returning
+// their warnings and errors.
+func validateAll(validators ...schema.SchemaValidateFunc) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errs []error) {
+		for _, validator := range validators {
+			w, e := validator(v, k)
+			ws = append(ws, w...)
+			errs = append(errs, e...)
+		}
+		return
+	}
+}
+
+func validateNoLeadingSlash(v interface{}, k string) (ws []string, errs []error) {
+	if strings.HasPrefix(v.(string), "/") {
+		errs = append(errs, fmt.Errorf("%s cannot start with '/'", k))
+	}
+	return
+}
+
+func validateNoTrailingSlash(v interface{}, k string) (ws []string, errs []error) {
+	if strings.HasSuffix(v.(string), "/") {
+		errs = append(errs, fmt.Errorf("%s cannot end in '/'", k))
+	}
+	return
+}
+
+func validateNoSpaces(v interface{}, k string) (ws []string, errs []error) {
+	if strings.ContainsAny(v.(string), " \t\n") {
+		errs = append(errs, fmt.Errorf("%s cannot contain spaces", k))
+	}
+	return
+}
+
+// validateIdentityName validates the name of an identity entity or group,
+// which Vault uses in the path of the lookups by name, e.g.
+// identity/group/name/:name.
+func validateIdentityName(v interface{}, k string) (ws []string, errs []error) {
+	value := v.(string)
+	switch {
+	case value == "":
+		errs = append(errs, fmt.Errorf("%s cannot be empty", k))
+	case strings.Contains(value, "/"):
+		errs = append(errs, fmt.Errorf("%s cannot contain '/'", k))
+	case strings.TrimSpace(value) != value:
+		errs = append(errs, fmt.Errorf("%s cannot start or end with spaces", k))
+	}
+	return
+}
+
+// validateDuration validates a TTL or other duration of Vault, given as a
+// number of seconds, a number of days or a duration string, e.g. 1h30m.
+func validateDuration(v interface{}, k string) (ws []string, errs []error) {
+	value := v.(string)
+	if _, err := parseDuration(value); err != nil {
+		errs = append(errs, fmt.Errorf("%s must be a number of seconds or a duration such as 1h30m, got %q", k, value))
+	}
+	return
+}
@@ -0,0 +1,39 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestValidationHelpers(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Validator schema.SchemaValidateFunc
+		Value     string
+		Valid     bool
+	}{
+		{"mount path", validateMountPath, "aws", true},
+		{"nested mount path", validateMountPath, "team/aws", true},
+		{"mount path with leading slash", validateMountPath, "/aws", false},
+		{"mount path with trailing slash", validateMountPath, "aws/", false},
+		{"mount path with spaces", validateMountPath, "my aws", false},
+		{"identity name", validateIdentityName, "Team Admins", true},
+		{"empty identity name", validateIdentityName, "", false},
+		{"identity name with slash", validateIdentityName, "team/admins", false},
+		{"identity name with trailing space", validateIdentityName, "admins ", false},
+		{"seconds", validateDuration, "3600", true},
+		{"duration", validateDuration, "1h30m", true},
+		{"days", validateDuration, "30d", true},
+		{"empty duration", validateDuration, "", true},
+		{"duration without unit", validateDuration, "1h30", false},
+		{"invalid duration", validateDuration, "one hour", false},
+	}
+
+	for _, tc := range cases {
+		_, errs := tc.Validator(tc.Value, "field")
+		if valid := len(errs) == 0; valid != tc.Valid {
+			t.Errorf("%s: expected %q to be valid: %t, got errors %v", tc.Name, tc.Value, tc.Valid, errs)
+		}
+	}
+}
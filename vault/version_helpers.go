@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/vault/api"
+)
+
+// vaultVersions are the Vault server versions of the configured providers,
+// detected from sys/seal-status or given with vault_version_override.
+var vaultVersions = struct {
+	sync.Mutex
+	versions map[*api.Client]*version.Version
+}{versions: map[*api.Client]*version.Version{}}
+
+func validateVaultVersion(v interface{}, k string) (ws []string, errs []error) {
+	if _, err := version.NewVersion(v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q must be a version such as \"1.11.0\": %s", k, err))
+	}
+	return
+}
+
+func getVaultVersion(client *api.Client) *version.Version {
+	vaultVersions.Lock()
+	defer vaultVersions.Unlock()
+	return vaultVersions.versions[client]
+}
+
+func setVaultVersion(client *api.Client, v *version.Version) {
+	vaultVersions.Lock()
+	vaultVersions.versions[client] = v
+	vaultVersions.Unlock()
+}
+
+// checkVaultVersion returns an error if the Vault server of the client is
+// known to be older than minimum, which the feature needs. When the
+// version is unknown, the request is left to Vault to reject.
+func checkVaultVersion(client *api.Client, minimum, feature string) error {
+	v := getVaultVersion(client)
+	if v == nil {
+		return nil
+	}
+
+	if v.LessThan(version.Must(version.NewVersion(minimum))) {
+		return fmt.Errorf("%s requires Vault %s or later, the server is running %s", feature, minimum, v)
+	}
+	return nil
+}
+
+// checkVaultEnterprise returns an error if the Vault server of the client is
+// known not to be Vault Enterprise, or to be older than minimum, which the
+// feature needs. Vault Enterprise reports its edition in the metadata of its
+// version, e.g. 1.15.0+ent.
+func checkVaultEnterprise(client *api.Client, minimum, feature string) error {
+	v := getVaultVersion(client)
+	if v == nil {
+		return nil
+	}
+
+	if !isEnterpriseVersion(v) || v.LessThan(version.Must(version.NewVersion(minimum))) {
+		return fmt.Errorf("%s requires Vault Enterprise %s or later, the server is running Vault %s", feature, minimum, v)
+	}
+	return nil
+}
+
+func isEnterpriseVersion(v *version.Version) bool {
+	for _, edition := range []string{"ent", "prem", "pro"} {
+		if strings.HasPrefix(v.Metadata(), edition) {
+			return true
+		}
+	}
+	return false
+}
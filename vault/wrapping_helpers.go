@@ -0,0 +1,157 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// withWrappingSchema adds the fields used to request response wrapping to the
+// schema of a resource or data source that reads a credential from Vault.
+// When wrapping_ttl is set only the single-use wrapping token is stored in
+// the state, the credential itself has to be unwrapped by its consumer.
+func withWrappingSchema(s map[string]*schema.Schema, forceNew bool) map[string]*schema.Schema {
+	s["wrapping_ttl"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     forceNew,
+		Description:  "If set, the credential is response-wrapped for this duration and only the wrapping token is stored in the state.",
+		ValidateFunc: validateWrappingTTL,
+	}
+	s["wrapping_token"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The single-use token that can be used to unwrap the credential.",
+	}
+	s["wrapping_accessor"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The accessor of the wrapping token.",
+	}
+	return s
+}
+
+func validateWrappingTTL(v interface{}, k string) (ws []string, errs []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q must be a duration such as \"60s\" or \"5m\": %s", k, err))
+	}
+	return
+}
+
+// wrappedRequest sends a request to Vault asking for the response to be
+// wrapped for the given TTL. The wrapping lookup function of the client is
+// shared by every resource, so the TTL is set on the request instead.
+func wrappedRequest(client *api.Client, method, path string, data map[string]interface{}, wrapTTL string) (*api.Secret, error) {
+	r := client.NewRequest(method, "/v1/"+path)
+	r.WrapTTL = wrapTTL
+	if data != nil {
+		if err := r.SetJSONBody(data); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := client.RawRequest(r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if resp != nil && resp.StatusCode == 404 {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := api.ParseSecret(resp.Body)
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if secret != nil && secret.WrapInfo == nil {
+		return nil, fmt.Errorf("no wrapping information in the response for %q", path)
+	}
+	return secret, nil
+}
+
+// setWrappingInfo stores the wrapping token and its accessor in the state.
+func setWrappingInfo(d *schema.ResourceData, secret *api.Secret) {
+	var token, accessor string
+	if secret != nil && secret.WrapInfo != nil {
+		token = secret.WrapInfo.Token
+		accessor = secret.WrapInfo.Accessor
+	}
+	d.Set("wrapping_token", token)
+	d.Set("wrapping_accessor", accessor)
+}
+
+// withWrappingCheckSchema adds the fields used to check a wrapping token
+// before it is trusted to the schema of a data source that takes one.
+func withWrappingCheckSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["expected_creation_path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "If set, the token must have been created by a request to this path.",
+	}
+	s["max_creation_ttl"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "If set, the token must have been created with a TTL of at most this many seconds.",
+	}
+	return s
+}
+
+// wrappingTokenInfo is the information about a wrapping token returned by
+// sys/wrapping/lookup.
+type wrappingTokenInfo struct {
+	CreationPath string
+	CreationTime string
+	CreationTTL  int
+}
+
+// lookupWrappingToken reads the information about a wrapping token, without
+// unwrapping it.
+func lookupWrappingToken(client *api.Client, token string) (*wrappingTokenInfo, error) {
+	secret, err := client.Logical().Write("sys/wrapping/lookup", map[string]interface{}{
+		"token": token,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error looking up the wrapping token: %s", err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("no information returned for the wrapping token")
+	}
+
+	info := &wrappingTokenInfo{}
+	info.CreationPath, _ = secret.Data["creation_path"].(string)
+	info.CreationTime, _ = secret.Data["creation_time"].(string)
+	if v, ok := secret.Data["creation_ttl"].(json.Number); ok {
+		ttl, err := v.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("expected creation_ttl %q to be a number", v)
+		}
+		info.CreationTTL = int(ttl)
+	}
+	return info, nil
+}
+
+// checkWrappingToken returns an error when the information about a wrapping
+// token doesn't match expected_creation_path and max_creation_ttl, e.g.
+// because the token was wrapped by someone else than its expected creator.
+func checkWrappingToken(d *schema.ResourceData, info *wrappingTokenInfo) error {
+	if expected := strings.Trim(d.Get("expected_creation_path").(string), "/"); expected != "" {
+		if path := strings.Trim(info.CreationPath, "/"); path != expected {
+			return fmt.Errorf("the wrapping token was created by %q, expected %q", path, expected)
+		}
+	}
+	if maxTTL := d.Get("max_creation_ttl").(int); maxTTL > 0 && info.CreationTTL > maxTTL {
+		return fmt.Errorf("the wrapping token was created with a TTL of %d seconds, expected at most %d", info.CreationTTL, maxTTL)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestCheckWrappingToken(t *testing.T) {
+	s := withWrappingCheckSchema(map[string]*schema.Schema{})
+	info := &wrappingTokenInfo{
+		CreationPath: "auth/approle/role/app/secret-id",
+		CreationTTL:  300,
+	}
+	for _, tc := range []struct {
+		path  string
+		ttl   int
+		valid bool
+	}{
+		{valid: true},
+		{path: "auth/approle/role/app/secret-id", valid: true},
+		{path: "/auth/approle/role/app/secret-id/", ttl: 300, valid: true},
+		{path: "secret/data/app", valid: false},
+		{ttl: 60, valid: false},
+	} {
+		d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+			"expected_creation_path": tc.path,
+			"max_creation_ttl":       tc.ttl,
+		})
+		err := checkWrappingToken(d, info)
+		if tc.valid && err != nil {
+			t.Errorf("expected path %q and TTL %d to be valid, got %s", tc.path, tc.ttl, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("expected path %q and TTL %d to be invalid", tc.path, tc.ttl)
+		}
+	}
+}